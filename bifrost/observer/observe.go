@@ -166,7 +166,8 @@ func (o *Observer) sendDeck() {
 		}
 		// retried txIn will be filtered already, doesn't need to filter it again
 		if !deck.Filtered {
-			deck.TxArray = o.filterObservations(deck.Chain, deck.TxArray, deck.MemPool)
+			// pending inbounds are observed, as they will only be sent as not finalised
+			deck.TxArray = o.filterObservations(deck.Chain, deck.TxArray, deck.MemPool && !deck.Pending)
 			deck.TxArray = o.filterBinanceMemoFlag(deck.Chain, deck.TxArray)
 			deck.ConfirmationRequired = chainClient.GetConfirmationCount(deck)
 		}
@@ -175,10 +176,18 @@ func (o *Observer) sendDeck() {
 			Filtered:             true,
 			MemPool:              deck.MemPool,
 			SentUnFinalised:      deck.SentUnFinalised,
+			Pending:              deck.Pending,
 			ConfirmationRequired: deck.ConfirmationRequired,
 		}
 
-		if !chainClient.ConfirmationCountReady(deck) {
+		if deck.Pending {
+			// pending tx is only sent as not finalised, the finalised observation will be
+			// sent once the tx is included in a block
+			result := o.chunkifyAndSendToThorchain(deck, chainClient, false)
+			if len(result.TxArray) > 0 {
+				newTxIn.TxArray = append(newTxIn.TxArray, result.TxArray...)
+			}
+		} else if !chainClient.ConfirmationCountReady(deck) {
 			// TxIn doesn't have enough confirmation , add it back to queue, and try it later
 			newTxIn.TxArray = append(newTxIn.TxArray, deck.TxArray...)
 			// send not finalised tx to THORChain, so THORChain can aware this inbound tx
@@ -216,6 +225,7 @@ func (o *Observer) chunkifyAndSendToThorchain(deck types.TxIn, chainClient chain
 		Filtered:             true,
 		MemPool:              deck.MemPool,
 		SentUnFinalised:      deck.SentUnFinalised,
+		Pending:              deck.Pending,
 		ConfirmationRequired: deck.ConfirmationRequired,
 	}
 	deck.Finalised = finalised
@@ -257,6 +267,9 @@ func (o *Observer) processTxIns() {
 				if in.Filtered != txIn.Filtered {
 					continue
 				}
+				if in.Pending != txIn.Pending {
+					continue
+				}
 				// at the moment BNB chain has very short block time , so allow multiple BNB block to bundle together , but not BTC
 				if !in.Chain.Equals(common.BNBChain) && len(in.TxArray) > 0 && len(txIn.TxArray) > 0 {
 					if in.TxArray[0].BlockHeight != txIn.TxArray[0].BlockHeight {
@@ -291,6 +304,7 @@ func (o *Observer) chunkify(txIn types.TxIn) (result []types.TxIn) {
 			Filtered:             txIn.Filtered,
 			Finalised:            txIn.Finalised,
 			SentUnFinalised:      txIn.SentUnFinalised,
+			Pending:              txIn.Pending,
 			ConfirmationRequired: txIn.ConfirmationRequired,
 		}
 		if len(txIn.TxArray) > maxTxArrayLen {
@@ -618,12 +632,19 @@ func (o *Observer) getThorchainTxIns(txIn types.TxIn) (stypes.ObservedTxs, error
 		if txIn.Finalised {
 			height += txIn.ConfirmationRequired
 		}
+		finaliseHeight := item.BlockHeight + txIn.ConfirmationRequired
+		// pending tx must not be considered as final by THORChain, even on chains that
+		// don't require confirmation counting
+		if txIn.Pending && finaliseHeight <= height {
+			finaliseHeight = height + 1
+		}
 		tx := stypes.NewObservedTx(
 			common.NewTx(txID, sender, to, item.Coins.NoneEmpty(), item.Gas, item.Memo),
 			height,
 			item.ObservedVaultPubKey,
-			item.BlockHeight+txIn.ConfirmationRequired)
+			finaliseHeight)
 		tx.KeysignMs = o.tssKeysignMetricMgr.GetTssKeysignMetric(item.Tx)
+		tx.Pending = txIn.Pending
 		tx.Aggregator = item.Aggregator
 		tx.AggregatorTarget = item.AggregatorTarget
 		tx.AggregatorTargetLimit = item.AggregatorTargetLimit
@@ -538,3 +538,45 @@ func (s *ObserverSuite) TestGetSaversMemo(c *C) {
 	memo = obs.getSaversMemo(common.BTCChain, btcSaversTx)
 	c.Assert(memo, Equals, "+:BTC/BTC")
 }
+
+func (s *ObserverSuite) TestGetThorchainTxInsPending(c *C) {
+	pubkeyMgr, err := pubkeymanager.NewPubKeyManager(s.bridge, s.m)
+	c.Assert(err, IsNil)
+	obs, err := NewObserver(pubkeyMgr, map[common.Chain]chainclients.ChainClient{
+		common.BNBChain: s.b,
+	}, s.bridge, s.m, "", metrics.NewTssKeysignMetricMgr())
+	c.Assert(obs, NotNil)
+	c.Assert(err, IsNil)
+
+	txIn := types.TxIn{
+		Chain: common.BNBChain,
+		TxArray: []types.TxInItem{
+			{
+				BlockHeight:         1024,
+				Tx:                  thorchain.GetRandomTxHash().String(),
+				Memo:                "ADD:BNB.BNB",
+				Sender:              thorchain.GetRandomBNBAddress().String(),
+				To:                  thorchain.GetRandomBNBAddress().String(),
+				Coins:               common.NewCoins(common.NewCoin(common.BNBAsset, cosmos.NewUint(1024))),
+				ObservedVaultPubKey: thorchain.GetRandomPubKey(),
+			},
+		},
+	}
+
+	// chain without confirmation counting, the observation is final
+	txs, err := obs.getThorchainTxIns(txIn)
+	c.Assert(err, IsNil)
+	c.Assert(txs, HasLen, 1)
+	c.Assert(txs[0].IsFinal(), Equals, true)
+	c.Assert(txs[0].Pending, Equals, false)
+
+	// pending tx is never final
+	txIn.Pending = true
+	txs, err = obs.getThorchainTxIns(txIn)
+	c.Assert(err, IsNil)
+	c.Assert(txs, HasLen, 1)
+	c.Assert(txs[0].BlockHeight, Equals, int64(1024))
+	c.Assert(txs[0].FinaliseHeight, Equals, int64(1025))
+	c.Assert(txs[0].IsFinal(), Equals, false)
+	c.Assert(txs[0].Pending, Equals, true)
+}
@@ -189,7 +189,7 @@ func (s *BitcoinSuite) TestGetBlock(c *C) {
 }
 
 func (s *BitcoinSuite) TestFetchTxs(c *C) {
-	txs, err := s.client.FetchTxs(0, 0)
+	txs, err := s.client.utxoClient.FetchTxs(0, 0)
 	c.Assert(err, IsNil)
 	c.Assert(txs.Chain, Equals, common.BTCChain)
 	c.Assert(txs.Count, Equals, "102")
@@ -782,116 +782,17 @@ func (s *BitcoinSuite) TestOnObservedTxIn(c *C) {
 	c.Assert(blockMeta, NotNil)
 }
 
-func (s *BitcoinSuite) TestProcessReOrg(c *C) {
-	// can't get previous block meta should not error
-	var result btcjson.GetBlockVerboseTxResult
-	blockContent, err := os.ReadFile("../../../../test/fixtures/btc/block.json")
-	c.Assert(err, IsNil)
-	c.Assert(json.Unmarshal(blockContent, &result), IsNil)
-	// should not trigger re-org process
-	reorgedItems, err := s.client.processReorg(&result)
-	c.Assert(err, IsNil)
-	c.Assert(reorgedItems, IsNil)
-
-	// add one UTXO which will trigger the re-org process next
-	previousHeight := result.Height - 1
-	blockMeta := utxo.NewBlockMeta(ttypes.GetRandomTxHash().String(), previousHeight, ttypes.GetRandomTxHash().String())
-	hash := "27de3e1865c098cd4fded71bae1e8236fd27ce5dce6e524a9ac5cd1a17b5c241"
-	blockMeta.AddCustomerTransaction(hash)
-	c.Assert(s.client.temporalStorage.SaveBlockMeta(previousHeight, blockMeta), IsNil)
-	s.client.globalErrataQueue = make(chan types.ErrataBlock, 1)
-	reorgedItems, err = s.client.processReorg(&result)
-	c.Assert(err, IsNil)
-	c.Assert(reorgedItems, NotNil)
-	// make sure there is errata block in the queue
-	c.Assert(s.client.globalErrataQueue, HasLen, 1)
-	blockMeta, err = s.client.temporalStorage.GetBlockMeta(previousHeight)
-	c.Assert(err, IsNil)
-	c.Assert(blockMeta, NotNil)
-}
-
 func (s *BitcoinSuite) TestGetMemPool(c *C) {
-	txIns, err := s.client.getMemPool(1024)
+	txIns, err := s.client.utxoClient.FetchMemPool(1024)
 	c.Assert(err, IsNil)
 	c.Assert(txIns.TxArray, HasLen, 1)
 
 	// process it again , the tx will be ignored
-	txIns, err = s.client.getMemPool(1024)
+	txIns, err = s.client.utxoClient.FetchMemPool(1024)
 	c.Assert(err, IsNil)
 	c.Assert(txIns.TxArray, HasLen, 0)
 }
 
-func (s *BitcoinSuite) TestConfirmationCountReady(c *C) {
-	c.Assert(s.client.ConfirmationCountReady(types.TxIn{
-		Chain:    common.BTCChain,
-		TxArray:  nil,
-		Filtered: true,
-		MemPool:  false,
-	}), Equals, true)
-	pkey := ttypes.GetRandomPubKey()
-	c.Assert(s.client.ConfirmationCountReady(types.TxIn{
-		Chain: common.BTCChain,
-		TxArray: []types.TxInItem{
-			{
-				BlockHeight: 2,
-				Tx:          "24ed2d26fd5d4e0e8fa86633e40faf1bdfc8d1903b1cd02855286312d48818a2",
-				Sender:      "bc1q0s4mg25tu6termrk8egltfyme4q7sg3h0e56p3",
-				To:          "bc1q2gjc0rnhy4nrxvuklk6ptwkcs9kcr59mcl2q9j",
-				Coins: common.Coins{
-					common.NewCoin(common.BTCAsset, cosmos.NewUint(123456)),
-				},
-				Memo:                "MEMO",
-				ObservedVaultPubKey: pkey,
-			},
-		},
-		Filtered: true,
-		MemPool:  true,
-	}), Equals, true)
-	s.client.currentBlockHeight.Store(3)
-	c.Assert(s.client.ConfirmationCountReady(types.TxIn{
-		Chain: common.BTCChain,
-		TxArray: []types.TxInItem{
-			{
-				BlockHeight: 2,
-				Tx:          "24ed2d26fd5d4e0e8fa86633e40faf1bdfc8d1903b1cd02855286312d48818a2",
-				Sender:      "bc1q0s4mg25tu6termrk8egltfyme4q7sg3h0e56p3",
-				To:          "bc1q2gjc0rnhy4nrxvuklk6ptwkcs9kcr59mcl2q9j",
-				Coins: common.Coins{
-					common.NewCoin(common.BTCAsset, cosmos.NewUint(123456)),
-				},
-				Memo:                "MEMO",
-				ObservedVaultPubKey: pkey,
-			},
-		},
-		Filtered:             true,
-		MemPool:              false,
-		ConfirmationRequired: 0,
-	}), Equals, true)
-
-	c.Assert(s.client.ConfirmationCountReady(types.TxIn{
-		Chain: common.BTCChain,
-		TxArray: []types.TxInItem{
-			{
-				BlockHeight: 2,
-				Tx:          "24ed2d26fd5d4e0e8fa86633e40faf1bdfc8d1903b1cd02855286312d48818a2",
-				Sender:      "bc1q0s4mg25tu6termrk8egltfyme4q7sg3h0e56p3",
-				To:          "bc1q2gjc0rnhy4nrxvuklk6ptwkcs9kcr59mcl2q9j",
-				Coins: common.Coins{
-					common.NewCoin(common.BTCAsset, cosmos.NewUint(12345600000)),
-				},
-				Gas: common.Gas{
-					common.NewCoin(common.BTCAsset, cosmos.NewUint(40000)),
-				},
-				Memo:                "MEMO",
-				ObservedVaultPubKey: pkey,
-			},
-		},
-		Filtered:             true,
-		MemPool:              false,
-		ConfirmationRequired: 5,
-	}), Equals, false)
-}
-
 func (s *BitcoinSuite) TestGetConfirmationCount(c *C) {
 	pkey := ttypes.GetRandomPubKey()
 	// no tx in item , confirmation count should be 0
@@ -1170,13 +1071,13 @@ func (s *BitcoinSuite) TestGetOutput(c *C) {
 
 func (s *BitcoinSuite) TestIsValidUTXO(c *C) {
 	// normal pay to pubkey hash segwit
-	c.Assert(s.client.isValidUTXO("00140653096f54ae1ae2d73291d15854aef08ebcfa8c"), Equals, true)
+	c.Assert(s.client.IsValidUTXO("00140653096f54ae1ae2d73291d15854aef08ebcfa8c"), Equals, true)
 	// pubkey hash , bitcoin client doesn't use it
-	c.Assert(s.client.isValidUTXO("76a91415fb126815935f6ae83a206d7d82f1065bc63e2588ac"), Equals, true)
+	c.Assert(s.client.IsValidUTXO("76a91415fb126815935f6ae83a206d7d82f1065bc63e2588ac"), Equals, true)
 
-	c.Assert(s.client.isValidUTXO("a914e51a3dd98ded55718ad2cf2ce7c8ff056394445787"), Equals, true)
-	c.Assert(s.client.isValidUTXO("00483045022100995187373cabc9ef02e5dd2770519704054bff6e3b42f8eeeb1f08a40db527b50220380d4d1f471087c35ebdde4251a0c8fa38db688600020a189d2b19343d079c100147304402205c8a886fece4c40c96c47ee51cf8e32ff75251375a47c4ec0cec9193a8a747620220703045742cdec7a19e16aa071a4fe4333a6c1b587783b864d0a64ef87783b13a014c695221039b3fa7e3dd5f9caab777f0dd15a03f1011063a2bf205f96ad2b01540506109432103e7e00ea57b70cfd9493f1d7e482a2bfe4c785d8e9bef25eb1fd3a528bc452e072103f01a388aecf967af2d21a8578635e745a3990afdfde8099ac44bab3ecd9c042153ae"), Equals, false)
-	c.Assert(s.client.isValidUTXO("51210281feb90c058c3436f8bc361930ae99fcfb530a699cdad141d7244bfcad521a1f51ae"), Equals, false)
-	c.Assert(s.client.isValidUTXO("5121037953dbf08030f67352134992643d033417eaa6fcfb770c038f364ff40d7615882100bd2fda4cf456d64386a0756f580101a607c25bd8d6814693bdf16e2a7ba3e45c52ae"), Equals, false)
-	c.Assert(s.client.isValidUTXO("524104d81fd577272bbe73308c93009eec5dc9fc319fc1ee2e7066e17220a5d47a18314578be2faea34b9f1f8ca078f8621acd4bc22897b03daa422b9bf56646b342a24104ec3afff0b2b66e8152e9018fe3be3fc92b30bf886b3487a525997d00fd9da2d012dce5d5275854adc3106572a5d1e12d4211b228429f5a7b2f7ba92eb0475bb14104b49b496684b02855bc32f5daefa2e2e406db4418f3b86bca5195600951c7d918cdbe5e6d3736ec2abf2dd7610995c3086976b2c0c7b4e459d10b34a316d5a5e753ae"), Equals, false)
+	c.Assert(s.client.IsValidUTXO("a914e51a3dd98ded55718ad2cf2ce7c8ff056394445787"), Equals, true)
+	c.Assert(s.client.IsValidUTXO("00483045022100995187373cabc9ef02e5dd2770519704054bff6e3b42f8eeeb1f08a40db527b50220380d4d1f471087c35ebdde4251a0c8fa38db688600020a189d2b19343d079c100147304402205c8a886fece4c40c96c47ee51cf8e32ff75251375a47c4ec0cec9193a8a747620220703045742cdec7a19e16aa071a4fe4333a6c1b587783b864d0a64ef87783b13a014c695221039b3fa7e3dd5f9caab777f0dd15a03f1011063a2bf205f96ad2b01540506109432103e7e00ea57b70cfd9493f1d7e482a2bfe4c785d8e9bef25eb1fd3a528bc452e072103f01a388aecf967af2d21a8578635e745a3990afdfde8099ac44bab3ecd9c042153ae"), Equals, false)
+	c.Assert(s.client.IsValidUTXO("51210281feb90c058c3436f8bc361930ae99fcfb530a699cdad141d7244bfcad521a1f51ae"), Equals, false)
+	c.Assert(s.client.IsValidUTXO("5121037953dbf08030f67352134992643d033417eaa6fcfb770c038f364ff40d7615882100bd2fda4cf456d64386a0756f580101a607c25bd8d6814693bdf16e2a7ba3e45c52ae"), Equals, false)
+	c.Assert(s.client.IsValidUTXO("524104d81fd577272bbe73308c93009eec5dc9fc319fc1ee2e7066e17220a5d47a18314578be2faea34b9f1f8ca078f8621acd4bc22897b03daa422b9bf56646b342a24104ec3afff0b2b66e8152e9018fe3be3fc92b30bf886b3487a525997d00fd9da2d012dce5d5275854adc3106572a5d1e12d4211b228429f5a7b2f7ba92eb0475bb14104b49b496684b02855bc32f5daefa2e2e406db4418f3b86bca5195600951c7d918cdbe5e6d3736ec2abf2dd7610995c3086976b2c0c7b4e459d10b34a316d5a5e753ae"), Equals, false)
 }
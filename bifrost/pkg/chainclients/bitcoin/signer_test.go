@@ -14,12 +14,9 @@ import (
 	"time"
 
 	"github.com/btcsuite/btcd/btcec"
-	"github.com/btcsuite/btcd/btcjson"
 	"github.com/cosmos/cosmos-sdk/crypto/hd"
 	cKeys "github.com/cosmos/cosmos-sdk/crypto/keyring"
 	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
-	"github.com/syndtr/goleveldb/leveldb"
-	"github.com/syndtr/goleveldb/leveldb/storage"
 	ctypes "gitlab.com/thorchain/binance-sdk/common/types"
 	. "gopkg.in/check.v1"
 
@@ -40,7 +37,6 @@ type BitcoinSignerSuite struct {
 	bridge thorclient.ThorchainBridge
 	cfg    config.BifrostChainConfiguration
 	m      *metrics.Metrics
-	db     *leveldb.DB
 	keys   *thorclient.Keys
 }
 
@@ -104,6 +100,8 @@ func (s *BitcoinSignerSuite) SetUpTest(c *C) {
 				httpTestHandler(c, rw, "../../../../test/fixtures/btc/getnetworkinfo.json")
 			case "getbestblockhash":
 				httpTestHandler(c, rw, "../../../../test/fixtures/btc/getbestblockhash.json")
+			case "getblockcount":
+				httpTestHandler(c, rw, "../../../../test/fixtures/btc/blockcount.json")
 			case "getblock":
 				httpTestHandler(c, rw, "../../../../test/fixtures/btc/block.json")
 			case "getrawtransaction":
@@ -134,18 +132,11 @@ func (s *BitcoinSignerSuite) SetUpTest(c *C) {
 	c.Assert(err, IsNil)
 	s.client, err = NewClient(s.keys, s.cfg, nil, s.bridge, s.m)
 	c.Assert(err, IsNil)
-	storage := storage.NewMemStorage()
-	db, err := leveldb.Open(storage, nil)
-	c.Assert(err, IsNil)
-	s.db = db
-	s.client.temporalStorage, err = utxo.NewTemporalStorage(db, 0)
-	c.Assert(err, IsNil)
 	c.Assert(s.client, NotNil)
 }
 
 func (s *BitcoinSignerSuite) TearDownTest(c *C) {
 	s.server.Close()
-	c.Assert(s.db.Close(), IsNil)
 }
 
 func (s *BitcoinSignerSuite) TestGetBTCPrivateKey(c *C) {
@@ -309,25 +300,23 @@ func (s *BitcoinSignerSuite) TestBroadcastTx(c *C) {
 }
 
 func (s *BitcoinSignerSuite) TestIsSelfTransaction(c *C) {
-	c.Check(s.client.isSelfTransaction("66d2d6b5eb564972c59e4797683a1225a02515a41119f0a8919381236b63e948"), Equals, false)
+	c.Check(s.client.utxoClient.IsSelfTransaction("66d2d6b5eb564972c59e4797683a1225a02515a41119f0a8919381236b63e948"), Equals, false)
 	bm := utxo.NewBlockMeta("", 1024, "")
 	hash := "66d2d6b5eb564972c59e4797683a1225a02515a41119f0a8919381236b63e948"
 	bm.AddSelfTransaction(hash)
 	c.Assert(s.client.temporalStorage.SaveBlockMeta(1024, bm), IsNil)
-	c.Check(s.client.isSelfTransaction("66d2d6b5eb564972c59e4797683a1225a02515a41119f0a8919381236b63e948"), Equals, true)
+	c.Check(s.client.utxoClient.IsSelfTransaction("66d2d6b5eb564972c59e4797683a1225a02515a41119f0a8919381236b63e948"), Equals, true)
 }
 
 func (s *BitcoinSignerSuite) TestEstimateTxSize(c *C) {
-	size := s.client.estimateTxSize("OUT:2180B871F2DEA2546E1403DBFE9C26B062ABAFFD979CF3A65F2B4D2230105CF1", []btcjson.ListUnspentResult{
+	size := s.client.estimateTxSize("OUT:2180B871F2DEA2546E1403DBFE9C26B062ABAFFD979CF3A65F2B4D2230105CF1", []utxo.UnspentOutput{
 		{
-			TxID:      "66d2d6b5eb564972c59e4797683a1225a02515a41119f0a8919381236b63e948",
-			Vout:      0,
-			Spendable: true,
+			TxID: "66d2d6b5eb564972c59e4797683a1225a02515a41119f0a8919381236b63e948",
+			Vout: 0,
 		},
 		{
-			TxID:      "c5946215d82d5870ba2b1e8f245d8aa1446783975aa3a592cf55589fccbf285f",
-			Vout:      0,
-			Spendable: true,
+			TxID: "c5946215d82d5870ba2b1e8f245d8aa1446783975aa3a592cf55589fccbf285f",
+			Vout: 0,
 		},
 	})
 	c.Assert(size, Equals, int64(255))
@@ -1,16 +1,12 @@
 package bitcoincash
 
 import (
-	"context"
 	"encoding/hex"
 	"encoding/json"
 	"errors"
 	"fmt"
 	"math/big"
-	"strconv"
 	"strings"
-	"sync"
-	"time"
 
 	"github.com/cosmos/cosmos-sdk/crypto/codec"
 	"github.com/gcash/bchd/bchec"
@@ -22,16 +18,10 @@ import (
 	"github.com/rs/zerolog/log"
 	txscript "gitlab.com/thorchain/bifrost/bchd-txscript"
 	tssp "gitlab.com/thorchain/tss/go-tss/tss"
-	"go.uber.org/atomic"
-	"golang.org/x/sync/errgroup"
-	"golang.org/x/sync/semaphore"
 
-	"gitlab.com/thorchain/thornode/bifrost/pkg/chainclients/shared/runners"
-	"gitlab.com/thorchain/thornode/bifrost/pkg/chainclients/shared/signercache"
 	"gitlab.com/thorchain/thornode/bifrost/pkg/chainclients/shared/utxo"
 	mem "gitlab.com/thorchain/thornode/x/thorchain/memo"
 
-	"gitlab.com/thorchain/thornode/bifrost/blockscanner"
 	btypes "gitlab.com/thorchain/thornode/bifrost/blockscanner/types"
 	"gitlab.com/thorchain/thornode/bifrost/metrics"
 	"gitlab.com/thorchain/thornode/bifrost/thorclient"
@@ -45,51 +35,41 @@ import (
 
 // BlockCacheSize the number of block meta that get store in storage.
 const (
-	BlockCacheSize      = 144
-	MaximumConfirmation = 99999999
-	MaxAsgardAddresses  = 100
+	BlockCacheSize = 144
 	// EstimateAverageTxSize for THORChain the estimate tx size is hard code to 250 here , as most of time it will spend 1 input, have 3 output
 	EstimateAverageTxSize = 1500
-	MaxMempoolScanPerTry  = 500
 )
 
 // Client observes bitcoin cash chain and allows to sign and broadcast tx
 type Client struct {
-	logger                  zerolog.Logger
-	cfg                     config.BifrostChainConfiguration
-	m                       *metrics.Metrics
-	client                  *rpcclient.Client
-	chain                   common.Chain
-	privateKey              *bchec.PrivateKey
-	blockScanner            *blockscanner.BlockScanner
-	temporalStorage         *utxo.TemporalStorage
-	ksWrapper               *KeySignWrapper
-	bridge                  thorclient.ThorchainBridge
-	globalErrataQueue       chan<- types.ErrataBlock
-	globalSolvencyQueue     chan<- types.Solvency
-	nodePubKey              common.PubKey
-	currentBlockHeight      *atomic.Int64
-	asgardAddresses         []common.Address
-	lastAsgard              time.Time
-	minRelayFeeSats         uint64
-	tssKeySigner            *tss.KeySign
-	wg                      *sync.WaitGroup
-	lastFeeRate             uint64
-	signerLock              *sync.Mutex
-	vaultSignerLocks        map[string]*sync.Mutex
-	consolidateInProgress   *atomic.Bool
-	signerCacheManager      *signercache.CacheManager
-	stopchan                chan struct{}
-	lastSolvencyCheckHeight int64
+	logger          zerolog.Logger
+	cfg             config.BifrostChainConfiguration
+	m               *metrics.Metrics
+	client          *rpcclient.Client
+	rpcEndpoint     *utxo.RPCEndpoint
+	chain           common.Chain
+	privateKey      *bchec.PrivateKey
+	temporalStorage *utxo.TemporalStorage
+	utxoClient      *utxo.Client
+	ksWrapper       *KeySignWrapper
+	bridge          thorclient.ThorchainBridge
+	nodePubKey      common.PubKey
+	minRelayFeeSats uint64
+	tssKeySigner    *tss.KeySign
+	lastFeeRate     uint64
 }
 
 // NewClient generates a new Client
 func NewClient(thorKeys *thorclient.Keys, cfg config.BifrostChainConfiguration, server *tssp.TssServer, bridge thorclient.ThorchainBridge, m *metrics.Metrics) (*Client, error) {
+	rpcEndpoint, err := utxo.NewRPCEndpoint(cfg)
+	if err != nil {
+		return nil, fmt.Errorf("fail to create rpc endpoint: %w", err)
+	}
 	client, err := rpcclient.New(&rpcclient.ConnConfig{
-		Host:         cfg.RPCHost,
+		Host:         rpcEndpoint.Host,
 		User:         cfg.UserName,
 		Pass:         cfg.Password,
-		DisableTLS:   cfg.DisableTLS,
+		DisableTLS:   rpcEndpoint.DisableTLS,
 		HTTPPostMode: cfg.HTTPostMode,
 	}, nil)
 	if err != nil {
@@ -123,72 +103,50 @@ func NewClient(thorKeys *thorclient.Keys, cfg config.BifrostChainConfiguration,
 	}
 
 	c := &Client{
-		logger:                log.Logger.With().Str("module", "bitcoincash").Logger(),
-		cfg:                   cfg,
-		m:                     m,
-		chain:                 cfg.ChainID,
-		client:                client,
-		privateKey:            bchPrivateKey,
-		ksWrapper:             ksWrapper,
-		bridge:                bridge,
-		nodePubKey:            nodePubKey,
-		minRelayFeeSats:       1000, // 1000 sats is the default minimal relay fee
-		tssKeySigner:          tssKm,
-		wg:                    &sync.WaitGroup{},
-		signerLock:            &sync.Mutex{},
-		vaultSignerLocks:      make(map[string]*sync.Mutex),
-		stopchan:              make(chan struct{}),
-		consolidateInProgress: atomic.NewBool(false),
-		currentBlockHeight:    atomic.NewInt64(0),
-	}
-
-	var path string // if not set later, will in memory storage
-	if len(c.cfg.BlockScanner.DBPath) > 0 {
-		path = fmt.Sprintf("%s/%s", c.cfg.BlockScanner.DBPath, c.cfg.BlockScanner.ChainID)
-	}
-	storage, err := blockscanner.NewBlockScannerStorage(path, c.cfg.ScannerLevelDB)
-	if err != nil {
-		return c, fmt.Errorf("fail to create blockscanner storage: %w", err)
-	}
-
-	c.blockScanner, err = blockscanner.NewBlockScanner(c.cfg.BlockScanner, storage, m, bridge, c)
-	if err != nil {
-		return c, fmt.Errorf("fail to create block scanner: %w", err)
+		logger:          log.Logger.With().Str("module", "bitcoincash").Logger(),
+		cfg:             cfg,
+		m:               m,
+		chain:           cfg.ChainID,
+		client:          client,
+		rpcEndpoint:     rpcEndpoint,
+		privateKey:      bchPrivateKey,
+		ksWrapper:       ksWrapper,
+		bridge:          bridge,
+		nodePubKey:      nodePubKey,
+		minRelayFeeSats: 1000, // 1000 sats is the default minimal relay fee
+		tssKeySigner:    tssKm,
 	}
 
-	c.temporalStorage, err = utxo.NewTemporalStorage(storage.GetInternalDb(), c.cfg.MempoolTxIDCacheSize)
+	c.utxoClient, err = utxo.NewClient(c, cfg, utxo.Settings{
+		BlockCacheSize:        BlockCacheSize,
+		EstimateAverageTxSize: EstimateAverageTxSize,
+	}, bridge, m, nodePubKey, c.logger)
 	if err != nil {
-		return c, fmt.Errorf("fail to create utxo accessor: %w", err)
+		return c, fmt.Errorf("fail to create utxo client: %w", err)
 	}
+	c.temporalStorage = c.utxoClient.GetTemporalStorage()
 
 	if err := c.registerAddressInWalletAsWatch(c.nodePubKey); err != nil {
 		return nil, fmt.Errorf("fail to register (%s): %w", c.nodePubKey, err)
 	}
-	signerCacheManager, err := signercache.NewSignerCacheManager(storage.GetInternalDb())
-	if err != nil {
-		return nil, fmt.Errorf("fail to create signer cache manager,err: %w", err)
-	}
-	c.signerCacheManager = signerCacheManager
-	c.updateNetworkInfo()
+	c.UpdateNetworkInfo()
 	return c, nil
 }
 
 // Start starts the block scanner
 func (c *Client) Start(globalTxsQueue chan types.TxIn, globalErrataQueue chan types.ErrataBlock, globalSolvencyQueue chan types.Solvency) {
-	c.globalErrataQueue = globalErrataQueue
-	c.globalSolvencyQueue = globalSolvencyQueue
 	c.tssKeySigner.Start()
-	c.blockScanner.Start(globalTxsQueue)
-	c.wg.Add(1)
-	go runners.SolvencyCheckRunner(c.GetChain(), c, c.bridge, c.stopchan, c.wg, constants.ThorchainBlockTime)
+	c.utxoClient.Start(globalTxsQueue, globalErrataQueue, globalSolvencyQueue)
 }
 
 // Stop stops the block scanner
 func (c *Client) Stop() {
 	c.tssKeySigner.Stop()
-	c.blockScanner.Stop()
-	close(c.stopchan)
-	c.wg.Wait()
+	// wait for the block scanner and consolidate utxo to exit
+	c.utxoClient.Stop()
+	if err := c.rpcEndpoint.Close(); err != nil {
+		c.logger.Err(err).Msg("fail to close rpc endpoint")
+	}
 }
 
 // GetConfig - get the chain configuration
@@ -196,8 +154,9 @@ func (c *Client) GetConfig() config.BifrostChainConfiguration {
 	return c.cfg
 }
 
+// IsBlockScannerHealthy returns true if the block scanner is healthy
 func (c *Client) IsBlockScannerHealthy() bool {
-	return c.blockScanner.IsHealthy()
+	return c.utxoClient.IsBlockScannerHealthy()
 }
 
 // GetChain returns BCH Chain
@@ -220,8 +179,8 @@ func (c *Client) GetAddress(poolPubKey common.PubKey) string {
 	return addr.String()
 }
 
-// getUTXOs send a request to bitcond RPC endpoint to query all the UTXO
-func (c *Client) getUTXOs(minConfirm, maximumConfirm int, pkey common.PubKey) ([]btcjson.ListUnspentResult, error) {
+// ListUnspent send a request to the daemon RPC endpoint to query all the UTXO of the given pubkey
+func (c *Client) ListUnspent(minConfirm, maximumConfirm int, pkey common.PubKey) ([]utxo.UnspentOutput, error) {
 	bchAddress, err := pkey.GetAddress(common.BCHChain)
 	if err != nil {
 		return nil, fmt.Errorf("fail to get BCH Address for pubkey(%s): %w", pkey, err)
@@ -230,429 +189,143 @@ func (c *Client) getUTXOs(minConfirm, maximumConfirm int, pkey common.PubKey) ([
 	if err != nil {
 		return nil, fmt.Errorf("fail to decode BCH address(%s): %w", bchAddress.String(), err)
 	}
-	return c.client.ListUnspentMinMaxAddresses(minConfirm, maximumConfirm, []bchutil.Address{
+	results, err := c.client.ListUnspentMinMaxAddresses(minConfirm, maximumConfirm, []bchutil.Address{
 		addr,
 	})
+	if err != nil {
+		return nil, err
+	}
+	utxos := make([]utxo.UnspentOutput, 0, len(results))
+	for _, item := range results {
+		utxos = append(utxos, utxo.UnspentOutput{
+			TxID:          item.TxID,
+			Vout:          item.Vout,
+			Address:       item.Address,
+			ScriptPubKey:  item.ScriptPubKey,
+			Amount:        item.Amount,
+			Confirmations: item.Confirmations,
+		})
+	}
+	return utxos, nil
 }
 
 // GetAccount returns account with balance for an address
 func (c *Client) GetAccount(pkey common.PubKey, height *big.Int) (common.Account, error) {
-	if height != nil {
-		c.logger.Error().Msg("height was provided but will be ignored")
-	}
-
-	acct := common.Account{}
-	if pkey.IsEmpty() {
-		return acct, errors.New("pubkey can't be empty")
-	}
-	utxos, err := c.getUTXOs(0, MaximumConfirmation, pkey)
-	if err != nil {
-		return acct, fmt.Errorf("fail to get UTXOs: %w", err)
-	}
-	total := 0.0
-	for _, item := range utxos {
-		if !c.isValidUTXO(item.ScriptPubKey) {
-			continue
-		}
-		if item.Confirmations == 0 {
-			// pending tx that is still  in mempool, only count yggdrasil send to itself or from asgard
-			if !c.isSelfTransaction(item.TxID) && !c.isAsgardAddress(item.Address) {
-				continue
-			}
-		}
-		total += item.Amount
-	}
-	totalAmt, err := bchutil.NewAmount(total)
-	if err != nil {
-		return acct, fmt.Errorf("fail to convert total amount: %w", err)
-	}
-	return common.NewAccount(0, 0, common.Coins{
-		common.NewCoin(common.BCHAsset, cosmos.NewUint(uint64(totalAmt))),
-	}, false), nil
+	return c.utxoClient.GetAccount(pkey, height)
 }
 
 func (c *Client) GetAccountByAddress(string, *big.Int) (common.Account, error) {
 	return common.Account{}, nil
 }
 
-func (c *Client) getAsgardAddress() ([]common.Address, error) {
-	if time.Since(c.lastAsgard) < constants.ThorchainBlockTime && c.asgardAddresses != nil {
-		return c.asgardAddresses, nil
-	}
-	newAddresses, err := utxo.GetAsgardAddress(c.chain, MaxAsgardAddresses, c.bridge)
-	if err != nil {
-		return nil, fmt.Errorf("fail to get asgards : %w", err)
-	}
-	if len(newAddresses) > 0 { // ensure we don't overwrite with empty list
-		c.asgardAddresses = newAddresses
-	}
-	c.lastAsgard = time.Now()
-	return c.asgardAddresses, nil
-}
-
-func (c *Client) isAsgardAddress(addressToCheck string) bool {
-	asgards, err := c.getAsgardAddress()
-	if err != nil {
-		c.logger.Err(err).Msg("fail to get asgard addresses")
-		return false
-	}
-	for _, addr := range asgards {
-		if strings.EqualFold(addr.String(), addressToCheck) {
-			return true
-		}
-	}
-	return false
+// GetNetworkFeeRate returns the last fee rate reported to THORChain
+func (c *Client) GetNetworkFeeRate() uint64 {
+	return c.lastFeeRate
 }
 
 // OnObservedTxIn gets called from observer when we have a valid observation
 // For bitcoin cash chain client we want to save the utxo we can spend later to sign
 func (c *Client) OnObservedTxIn(txIn types.TxInItem, blockHeight int64) {
-	hash, err := chainhash.NewHashFromStr(txIn.Tx)
-	if err != nil {
-		c.logger.Error().Err(err).Str("txID", txIn.Tx).Msg("fail to add spendable utxo to storage")
-		return
-	}
-	blockMeta, err := c.temporalStorage.GetBlockMeta(blockHeight)
-	if err != nil {
-		c.logger.Err(err).Msgf("fail to get block meta on block height(%d)", blockHeight)
-		return
-	}
-	if blockMeta == nil {
-		blockMeta = utxo.NewBlockMeta("", blockHeight, "")
-	}
-	if _, err := c.temporalStorage.TrackObservedTx(txIn.Tx); err != nil {
-		c.logger.Err(err).Msgf("fail to add hash (%s) to observed tx cache", txIn.Tx)
-	}
-	if c.isAsgardAddress(txIn.Sender) {
-		c.logger.Debug().Msgf("add hash %s as self transaction,block height:%d", hash.String(), blockHeight)
-		blockMeta.AddSelfTransaction(hash.String())
-	} else {
-		// add the transaction to block meta
-		blockMeta.AddCustomerTransaction(hash.String())
-	}
-	if err := c.temporalStorage.SaveBlockMeta(blockHeight, blockMeta); err != nil {
-		c.logger.Err(err).Msgf("fail to save block meta to storage,block height(%d)", blockHeight)
-	}
-	// update the signer cache
-	m, err := mem.ParseMemo(common.LatestVersion, txIn.Memo)
-	if err != nil {
-		// Debug log only as ParseMemo error is expected for THORName inbounds.
-		c.logger.Debug().Err(err).Msgf("fail to parse memo: %s", txIn.Memo)
-		return
-	}
-	if !m.IsOutbound() {
-		return
-	}
-	if m.GetTxID().IsEmpty() {
-		return
-	}
-	if err := c.signerCacheManager.SetSigned(txIn.CacheHash(c.GetChain(), m.GetTxID().String()), txIn.Tx); err != nil {
-		c.logger.Err(err).Msg("fail to update signer cache")
-	}
+	c.utxoClient.OnObservedTxIn(txIn, blockHeight)
 }
 
-func (c *Client) processReorg(block *btcjson.GetBlockVerboseTxResult) ([]types.TxIn, error) {
-	previousHeight := block.Height - 1
-	prevBlockMeta, err := c.temporalStorage.GetBlockMeta(previousHeight)
-	if err != nil {
-		return nil, fmt.Errorf("fail to get block meta of height(%d) : %w", previousHeight, err)
-	}
-	if prevBlockMeta == nil {
-		return nil, nil
-	}
-	// the block's previous hash need to be the same as the block hash chain client recorded in block meta
-	// blockMetas[PreviousHeight].BlockHash == Block.PreviousHash
-	if strings.EqualFold(prevBlockMeta.BlockHash, block.PreviousHash) {
-		return nil, nil
-	}
-
-	c.logger.Info().Msgf("re-org detected, current block height:%d ,previous block hash is : %s , however block meta at height: %d, block hash is %s", block.Height, block.PreviousHash, prevBlockMeta.Height, prevBlockMeta.BlockHash)
-	blockHeights, err := c.reConfirmTx()
+// GetBlock returns the block at the given height
+func (c *Client) GetBlock(height int64) (*utxo.Block, error) {
+	block, err := c.getBlock(height)
 	if err != nil {
-		c.logger.Err(err).Msgf("fail to reprocess all txs")
-	}
-	var txIns []types.TxIn
-	for _, item := range blockHeights {
-		c.logger.Info().Msgf("rescan block height: %d", item)
-		b, err := c.getBlock(item)
-		if err != nil {
-			c.logger.Err(err).Msgf("fail to get block from RPC for height:%d", item)
-			continue
-		}
-		txIn, err := c.extractTxs(b)
-		if err != nil {
-			c.logger.Err(err).Msgf("fail to extract txIn from block")
-			continue
-		}
-
-		if len(txIn.TxArray) == 0 {
-			continue
+		if rpcErr, ok := err.(*btcjson.RPCError); ok && rpcErr.Code == btcjson.ErrRPCInvalidParameter {
+			return nil, btypes.ErrUnavailableBlock
 		}
-		txIns = append(txIns, txIn)
+		return nil, err
 	}
-	return txIns, nil
+	txHashes := make([]string, len(block.Tx))
+	for idx, tx := range block.Tx {
+		txHashes[idx] = tx.Hash
+	}
+	return &utxo.Block{
+		Height:       block.Height,
+		Hash:         block.Hash,
+		PreviousHash: block.PreviousHash,
+		TxHashes:     txHashes,
+		Data:         block,
+	}, nil
 }
 
-// reConfirmTx will be kicked off only when chain client detected a re-org on bitcoin cash chain
-// it will read through all the block meta data from local storage , and go through all the UTXOs.
-// For each UTXO , it will send a RPC request to bitcoin cash chain , double check whether the TX exist or not
-// if the tx still exist , then it is all good, if a transaction previous we detected , however doesn't exist anymore , that means
-// the transaction had been removed from chain,  chain client should report to thorchain
-func (c *Client) reConfirmTx() ([]int64, error) {
-	blockMetas, err := c.temporalStorage.GetBlockMetas()
-	if err != nil {
-		return nil, fmt.Errorf("fail to get block metas from local storage: %w", err)
+// GetBlockTxIns returns the transactions of the given block that are in the THORChain format
+func (c *Client) GetBlockTxIns(block *utxo.Block) []types.TxInItem {
+	result, ok := block.Data.(*btcjson.GetBlockVerboseTxResult)
+	if !ok {
+		c.logger.Error().Msgf("unexpected block data type: %T", block.Data)
+		return nil
 	}
-	var rescanBlockHeights []int64
-	for _, blockMeta := range blockMetas {
-		var errataTxs []types.ErrataTx
-		for _, tx := range blockMeta.CustomerTransactions {
-			h, err := chainhash.NewHashFromStr(tx)
-			if err != nil {
-				c.logger.Info().Msgf("%s invalid transaction hash", tx)
-				continue
-			}
-			if c.confirmTx(h) {
-				c.logger.Info().Msgf("block height: %d, tx: %s still exist", blockMeta.Height, tx)
-				continue
-			}
-			// this means the tx doesn't exist in chain ,thus should errata it
-			errataTxs = append(errataTxs, types.ErrataTx{
-				TxID:  common.TxID(tx),
-				Chain: common.BCHChain,
-			})
-			blockMeta.RemoveCustomerTransaction(tx)
-		}
-		if len(errataTxs) > 0 {
-			c.globalErrataQueue <- types.ErrataBlock{
-				Height: blockMeta.Height,
-				Txs:    errataTxs,
-			}
-		}
-		// Let's get the block again to fix the block hash
-		r, err := c.getBlock(blockMeta.Height)
+	txInItems := make([]types.TxInItem, 0, len(result.Tx))
+	for idx := range result.Tx {
+		txInItem, err := c.getTxIn(&result.Tx[idx], result.Height, false)
 		if err != nil {
-			c.logger.Err(err).Msgf("fail to get block verbose tx result: %d", blockMeta.Height)
-		}
-		if !strings.EqualFold(blockMeta.BlockHash, r.Hash) {
-			rescanBlockHeights = append(rescanBlockHeights, blockMeta.Height)
-		}
-		blockMeta.PreviousHash = r.PreviousHash
-		blockMeta.BlockHash = r.Hash
-		if err := c.temporalStorage.SaveBlockMeta(blockMeta.Height, blockMeta); err != nil {
-			c.logger.Err(err).Msgf("fail to save block meta of height: %d ", blockMeta.Height)
+			c.logger.Debug().Err(err).Msg("fail to get TxInItem")
+			continue
 		}
+		txInItems = append(txInItems, txInItem)
 	}
-	return rescanBlockHeights, nil
-}
-
-// confirmTx check a tx is valid on chain post reorg
-func (c *Client) confirmTx(txHash *chainhash.Hash) bool {
-	// GetRawTransaction, it should check transaction in mempool as well
-	_, err := c.client.GetRawTransaction(txHash)
-	if err == nil {
-		// exist , all good
-		return true
-	}
-	c.logger.Err(err).Msgf("fail to get tx (%s) from chain", txHash)
-	// double check mempool
-	_, err = c.client.GetMempoolEntry(txHash.String())
-	if err != nil {
-		c.logger.Err(err).Msgf("fail to get tx(%s) from mempool", txHash)
-		return false
-	}
-	return true
-}
-
-func (c *Client) removeFromMemPoolCache(hash string) {
-	if err := c.temporalStorage.UntrackMempoolTx(hash); err != nil {
-		c.logger.Err(err).Msgf("fail to remove %s from mempool cache", hash)
-	}
-}
-
-func (c *Client) tryAddToMemPoolCache(hash string) bool {
-	exist, err := c.temporalStorage.TrackMempoolTx(hash)
-	if err != nil {
-		c.logger.Err(err).Msgf("fail to add mempool hash to key value store")
-	}
-	return exist
+	return txInItems
 }
 
-func (c *Client) getMemPool(height int64) (types.TxIn, error) {
+// GetMempoolTxIDs returns the hashes of the transactions in the mempool
+func (c *Client) GetMempoolTxIDs() ([]string, error) {
 	hashes, err := c.client.GetRawMempool()
 	if err != nil {
-		return types.TxIn{}, fmt.Errorf("fail to get tx hashes from mempool: %w", err)
-	}
-	txIn := types.TxIn{
-		Chain:   c.GetChain(),
-		MemPool: true,
-	}
-	maxWorker := c.cfg.ParallelMempoolScan
-	if maxWorker == 0 {
-		// set default worker to 5
-		maxWorker = 5
-	}
-	sem := semaphore.NewWeighted(int64(maxWorker))
-	g, _ := errgroup.WithContext(context.Background())
-	total := 0
-	lock := &sync.Mutex{}
-	for _, h := range hashes {
-		// this hash had been processed before , ignore it
-		if !c.tryAddToMemPoolCache(h.String()) {
-			c.logger.Debug().Msgf("%s had been processed , ignore", h.String())
-			continue
-		}
-		// closure
-		txHash := h
-		g.Go(func() error {
-			ctx := context.TODO()
-			if err := sem.Acquire(ctx, 1); err != nil {
-				return fmt.Errorf("fail to acquire semaphore: %w", err)
-			}
-			defer sem.Release(1)
-			c.logger.Debug().Msgf("process hash %s", txHash.String())
-			result, err := c.client.GetRawTransactionVerbose(txHash)
-			if err != nil {
-				// if the client fail to get the transaction , it could be the transaction removed , of the local node fail to
-				// respond with the transaction, it is safe to ignore it , and continue with the rest
-				c.logger.Err(err).Msgf("fail to get raw transaction verbose with hash(%s)", txHash.String())
-				return nil
-			}
-			txInItem, err := c.getTxIn(result, height, true)
-			if err != nil {
-				c.logger.Debug().Err(err).Msg("fail to get TxInItem")
-				return nil
-			}
-			if txInItem.IsEmpty() {
-				return nil
-			}
-			if txInItem.Coins.IsEmpty() {
-				return nil
-			}
-			lock.Lock()
-			defer lock.Unlock()
-			txIn.TxArray = append(txIn.TxArray, txInItem)
-			return nil
-		})
-		total++
-		// even it didn't finish scan all the mempool tx, but still yield here , so as block scanner can continue to scan a committed block
-		if total >= MaxMempoolScanPerTry {
-			break
-		}
+		return nil, err
 	}
-	if err := g.Wait(); err != nil {
-		c.logger.Err(err).Msgf("fail to scan mempool")
+	txIDs := make([]string, len(hashes))
+	for idx, h := range hashes {
+		txIDs[idx] = h.String()
 	}
-	txIn.Count = strconv.Itoa(len(txIn.TxArray))
-	return txIn, nil
-}
-
-// FetchMemPool retrieves txs from mempool
-func (c *Client) FetchMemPool(height int64) (types.TxIn, error) {
-	return c.getMemPool(height)
+	return txIDs, nil
 }
 
-// FetchTxs retrieves txs for a block height
-func (c *Client) FetchTxs(height, chainHeight int64) (types.TxIn, error) {
-	txIn := types.TxIn{
-		Chain:   common.BCHChain,
-		TxArray: nil,
-	}
-	block, err := c.getBlock(height)
+// GetMempoolTxIn returns the given mempool transaction in the THORChain format
+func (c *Client) GetMempoolTxIn(txID string, height int64) (types.TxInItem, error) {
+	txHash, err := chainhash.NewHashFromStr(txID)
 	if err != nil {
-		if rpcErr, ok := err.(*btcjson.RPCError); ok && rpcErr.Code == btcjson.ErrRPCInvalidParameter {
-			// this means the tx had been broadcast to chain, it must be another signer finished quicker then us
-			return txIn, btypes.ErrUnavailableBlock
-		}
-		return txIn, fmt.Errorf("fail to get block: %w", err)
+		return types.TxInItem{}, fmt.Errorf("fail to parse tx hash(%s): %w", txID, err)
 	}
-
-	// if somehow the block is not valid
-	if block.Hash == "" && block.PreviousHash == "" {
-		return txIn, fmt.Errorf("fail to get block: %w", err)
-	}
-	c.currentBlockHeight.Store(height)
-	reScannedTxs, err := c.processReorg(block)
+	result, err := c.client.GetRawTransactionVerbose(txHash)
 	if err != nil {
-		c.logger.Err(err).Msg("fail to process bitcoin cash re-org")
-	}
-	if len(reScannedTxs) > 0 {
-		for _, item := range reScannedTxs {
-			if len(item.TxArray) == 0 {
-				continue
-			}
-			txIn.TxArray = append(txIn.TxArray, item.TxArray...)
-		}
+		return types.TxInItem{}, fmt.Errorf("fail to get raw transaction verbose with hash(%s): %w", txID, err)
 	}
+	return c.getTxIn(result, height, true)
+}
 
-	blockMeta, err := c.temporalStorage.GetBlockMeta(block.Height)
+// IsTxOnChain check a tx is valid on chain post reorg
+func (c *Client) IsTxOnChain(txID string) (bool, error) {
+	txHash, err := chainhash.NewHashFromStr(txID)
 	if err != nil {
-		return types.TxIn{}, fmt.Errorf("fail to get block meta from storage: %w", err)
+		return false, err
 	}
-	if blockMeta == nil {
-		blockMeta = utxo.NewBlockMeta(block.PreviousHash, block.Height, block.Hash)
-	} else {
-		blockMeta.PreviousHash = block.PreviousHash
-		blockMeta.BlockHash = block.Hash
-	}
-
-	if err := c.temporalStorage.SaveBlockMeta(block.Height, blockMeta); err != nil {
-		return types.TxIn{}, fmt.Errorf("fail to save block meta into storage: %w", err)
-	}
-	pruneHeight := height - BlockCacheSize
-	if pruneHeight > 0 {
-		defer func() {
-			if err := c.temporalStorage.PruneBlockMeta(pruneHeight, c.canDeleteBlock); err != nil {
-				c.logger.Err(err).Msgf("fail to prune block meta, height(%d)", pruneHeight)
-			}
-		}()
+	// GetRawTransaction, it should check transaction in mempool as well
+	_, err = c.client.GetRawTransaction(txHash)
+	if err == nil {
+		// exist , all good
+		return true, nil
 	}
-
-	txInBlock, err := c.extractTxs(block)
+	c.logger.Err(err).Msgf("fail to get tx (%s) from chain", txHash)
+	// double check mempool
+	_, err = c.client.GetMempoolEntry(txHash.String())
 	if err != nil {
-		return types.TxIn{}, fmt.Errorf("fail to extract txs from block: %w", err)
-	}
-	if len(txInBlock.TxArray) > 0 {
-		txIn.TxArray = append(txIn.TxArray, txInBlock.TxArray...)
-	}
-	c.updateNetworkInfo()
-
-	// report network fee and solvency if within flexibility blocks of tip
-	if chainHeight-height <= c.cfg.BlockScanner.ObservationFlexibilityBlocks {
-		if err := c.sendNetworkFee(height); err != nil {
-			c.logger.Err(err).Msg("fail to send network fee")
-		}
-		if c.IsBlockScannerHealthy() {
-			if err := c.ReportSolvency(height); err != nil {
-				c.logger.Err(err).Msg("fail to send solvency to THORChain")
-			}
-		}
+		c.logger.Err(err).Msgf("fail to get tx(%s) from mempool", txHash)
+		return false, nil
 	}
-
-	txIn.Count = strconv.Itoa(len(txIn.TxArray))
-	if !c.consolidateInProgress.Load() {
-		// try to consolidate UTXOs
-		c.wg.Add(1)
-		c.consolidateInProgress.Store(true)
-		go c.consolidateUTXOs()
-	}
-	return txIn, nil
+	return true, nil
 }
 
-func (c *Client) canDeleteBlock(blockMeta *utxo.BlockMeta) bool {
-	if blockMeta == nil {
-		return true
-	}
-	for _, tx := range blockMeta.SelfTransactions {
-		if result, err := c.client.GetMempoolEntry(tx); err == nil && result != nil {
-			c.logger.Info().Msgf("tx: %s still in mempool , block can't be deleted", tx)
-			return false
-		}
-	}
-	return true
+// IsTxInMempool returns true when the given transaction is in the mempool
+func (c *Client) IsTxInMempool(txID string) bool {
+	result, err := c.client.GetMempoolEntry(txID)
+	return err == nil && result != nil
 }
 
-func (c *Client) updateNetworkInfo() {
+// UpdateNetworkInfo refreshes the minimum relay fee
+func (c *Client) UpdateNetworkInfo() {
 	infoResult, err := c.client.RawRequest("getnetworkinfo", []json.RawMessage{})
 	if err != nil {
 		c.logger.Err(err).Msg("fail to get network info")
@@ -674,7 +347,9 @@ func (c *Client) updateNetworkInfo() {
 	c.minRelayFeeSats = uint64(amt.ToUnit(bchutil.AmountSatoshi))
 }
 
-func (c *Client) sendNetworkFee(height int64) error {
+// SendNetworkFee reports the average fee rate of the given block to THORChain
+func (c *Client) SendNetworkFee(block *utxo.Block) error {
+	height := block.Height
 	heightJSON, err := json.Marshal(height)
 	if err != nil {
 		return fmt.Errorf("fail to get block stats: %w", err)
@@ -719,7 +394,13 @@ func (c *Client) sendNetworkFee(height int64) error {
 	return nil
 }
 
-func (c *Client) isValidUTXO(hexPubKey string) bool {
+// ShouldReportSolvency based on the given block height , should the client report solvency to THORNode
+func (c *Client) ShouldReportSolvency(height, lastSolvencyCheckHeight int64) bool {
+	return height-lastSolvencyCheckHeight > 1
+}
+
+// IsValidUTXO returns true when the given hex encoded script pub key pays to a single address
+func (c *Client) IsValidUTXO(hexPubKey string) bool {
 	buf, err := hex.DecodeString(hexPubKey)
 	if err != nil {
 		c.logger.Err(err).Msgf("fail to decode hex string,%s", hexPubKey)
@@ -749,12 +430,11 @@ func (c *Client) getBlock(height int64) (*btcjson.GetBlockVerboseTxResult, error
 }
 
 func (c *Client) isRBFEnabled(tx *btcjson.TxRawResult) bool {
-	for _, vin := range tx.Vin {
-		if vin.Sequence < (0xffffffff - 1) {
-			return true
-		}
+	sequences := make([]uint32, len(tx.Vin))
+	for idx, vin := range tx.Vin {
+		sequences[idx] = vin.Sequence
 	}
-	return false
+	return utxo.IsRBFEnabled(sequences...)
 }
 
 func (c *Client) getTxIn(tx *btcjson.TxRawResult, height int64, isMemPool bool) (types.TxInItem, error) {
@@ -790,9 +470,9 @@ func (c *Client) getTxIn(tx *btcjson.TxRawResult, height int64, isMemPool bool)
 		return types.TxInItem{}, fmt.Errorf("fail to get output from tx: %w", err)
 	}
 	to := c.stripAddress(output.ScriptPubKey.Addresses[0])
-	if c.isAsgardAddress(to) {
+	if c.utxoClient.IsAsgardAddress(to) {
 		// Only inbound UTXO need to be validated against multi-sig
-		if !c.isValidUTXO(output.ScriptPubKey.Hex) {
+		if !c.IsValidUTXO(output.ScriptPubKey.Hex) {
 			return types.TxInItem{}, fmt.Errorf("invalid utxo")
 		}
 	}
@@ -828,48 +508,6 @@ func (c *Client) stripAddress(addr string) string {
 	return split[0]
 }
 
-// extractTxs extracts txs from a block to type TxIn
-func (c *Client) extractTxs(block *btcjson.GetBlockVerboseTxResult) (types.TxIn, error) {
-	txIn := types.TxIn{
-		Chain:   c.GetChain(),
-		MemPool: false,
-	}
-	var txItems []types.TxInItem
-	for idx, tx := range block.Tx {
-		// mempool transaction get committed to block , thus remove it from mempool cache
-		c.removeFromMemPoolCache(tx.Hash)
-		txInItem, err := c.getTxIn(&block.Tx[idx], block.Height, false)
-		if err != nil {
-			c.logger.Debug().Err(err).Msg("fail to get TxInItem")
-			continue
-		}
-		if txInItem.IsEmpty() {
-			continue
-		}
-		if txInItem.Coins.IsEmpty() {
-			continue
-		}
-		if txInItem.Coins[0].Amount.LT(c.chain.DustThreshold()) {
-			continue
-		}
-		exist, err := c.temporalStorage.TrackObservedTx(txInItem.Tx)
-		if err != nil {
-			c.logger.Err(err).Msgf("fail to determinate whether hash(%s) had been observed before", txInItem.Tx)
-		}
-		if !exist {
-			c.logger.Info().Msgf("tx: %s had been report before, ignore", txInItem.Tx)
-			if err := c.temporalStorage.UntrackObservedTx(txInItem.Tx); err != nil {
-				c.logger.Err(err).Msgf("fail to remove observed tx from cache: %s", txInItem.Tx)
-			}
-			continue
-		}
-		txItems = append(txItems, txInItem)
-	}
-	txIn.TxArray = txItems
-	txIn.Count = strconv.Itoa(len(txItems))
-	return txIn, nil
-}
-
 // ignoreTx checks if we can already ignore a tx according to preset rules
 //
 // we expect array of "vout" for a BCH to have this format
@@ -1044,7 +682,8 @@ func (c *Client) RegisterPublicKey(pkey common.PubKey) error {
 	return c.registerAddressInWalletAsWatch(pkey)
 }
 
-func (c *Client) getCoinbaseValue(blockHeight int64) (int64, error) {
+// GetCoinbaseValue returns the block reward and fees of the block at the given height, in sats
+func (c *Client) GetCoinbaseValue(blockHeight int64) (int64, error) {
 	hash, err := c.client.GetBlockHash(blockHeight)
 	if err != nil {
 		return 0, fmt.Errorf("fail to get block hash:%w", err)
@@ -1069,113 +708,12 @@ func (c *Client) getCoinbaseValue(blockHeight int64) (int64, error) {
 	return 0, fmt.Errorf("fail to get coinbase value")
 }
 
-// getBlockRequiredConfirmation find out how many confirmation the given txIn need to have before it can be send to THORChain
-func (c *Client) getBlockRequiredConfirmation(txIn types.TxIn, height int64) (int64, error) {
-	totalTxValue := txIn.GetTotalTransactionValue(common.BCHAsset, c.asgardAddresses)
-	totalFeeAndSubsidy, err := c.getCoinbaseValue(height)
-	if err != nil {
-		c.logger.Err(err).Msg("fail to get coinbase value")
-	}
-	if totalFeeAndSubsidy == 0 {
-		cbValue, err := bchutil.NewAmount(c.chain.DefaultCoinbase())
-		if err != nil {
-			return 0, fmt.Errorf("fail to get default coinbase value: %w", err)
-		}
-		totalFeeAndSubsidy = int64(cbValue)
-	}
-	confirm := totalTxValue.QuoUint64(uint64(totalFeeAndSubsidy)).Uint64()
-	c.logger.Info().Msgf("totalTxValue:%s,total fee and Subsidy:%d,confirmation:%d", totalTxValue, totalFeeAndSubsidy, confirm)
-	return int64(confirm), nil
-}
-
+// GetConfirmationCount return the number of blocks the tx need to wait before processing in THORChain
 func (c *Client) GetConfirmationCount(txIn types.TxIn) int64 {
-	if len(txIn.TxArray) == 0 {
-		return 0
-	}
-	// MemPool items doesn't need confirmation
-	if txIn.MemPool {
-		return 0
-	}
-	blockHeight := txIn.TxArray[0].BlockHeight
-	confirm, err := c.getBlockRequiredConfirmation(txIn, blockHeight)
-	c.logger.Info().Msgf("confirmation required: %d", confirm)
-	if err != nil {
-		c.logger.Err(err).Msg("fail to get block confirmation ")
-		return 0
-	}
-	return confirm
+	return c.utxoClient.GetConfirmationCount(txIn)
 }
 
 // ConfirmationCountReady will be called by observer before send the txIn to thorchain
-// confirmation counting is on block level , refer to https://medium.com/coinmonks/1confvalue-a-simple-pow-confirmation-rule-of-thumb-a8d9c6c483dd for detail
 func (c *Client) ConfirmationCountReady(txIn types.TxIn) bool {
-	if len(txIn.TxArray) == 0 {
-		return true
-	}
-	// MemPool items doesn't need confirmation
-	if txIn.MemPool {
-		return true
-	}
-	blockHeight := txIn.TxArray[0].BlockHeight
-	confirm := txIn.ConfirmationRequired
-	c.logger.Info().Msgf("confirmation required: %d", confirm)
-	// every tx in txIn already have at least 1 confirmation
-	return (c.currentBlockHeight.Load() - blockHeight) >= confirm
-}
-
-// getVaultSignerLock , with consolidate UTXO process add into bifrost , there are two entry points for SignTx , one is from signer , signing the outbound tx
-// from state machine, the other one will be consolidate utxo process
-// this keep a lock per vault pubkey , the goal is each vault we only have one key sign in flight at a time, however different vault can do key sign in parallel
-// assume there are multiple asgards(A,B) , and local yggdrasil vault , when A is signing , B and local yggdrasil vault should be able to sign as well
-// however if A already has a key sign in flight , bifrost should not kick off another key sign in parallel, otherwise we might double spend some UTXOs
-func (c *Client) getVaultSignerLock(vaultPubKey string) *sync.Mutex {
-	c.signerLock.Lock()
-	defer c.signerLock.Unlock()
-	l, ok := c.vaultSignerLocks[vaultPubKey]
-	if !ok {
-		newLock := &sync.Mutex{}
-		c.vaultSignerLocks[vaultPubKey] = newLock
-		return newLock
-	}
-	return l
-}
-
-func (c *Client) ReportSolvency(bchBlockHeight int64) error {
-	if !c.ShouldReportSolvency(bchBlockHeight) {
-		return nil
-	}
-	asgardVaults, err := c.bridge.GetAsgards()
-	if err != nil {
-		return fmt.Errorf("fail to get asgards,err: %w", err)
-	}
-	for _, asgard := range asgardVaults {
-		acct, err := c.GetAccount(asgard.PubKey, nil)
-		if err != nil {
-			c.logger.Err(err).Msgf("fail to get account balance")
-			continue
-		}
-
-		if runners.IsVaultSolvent(acct, asgard, cosmos.NewUint(3*EstimateAverageTxSize*c.lastFeeRate)) && c.IsBlockScannerHealthy() {
-			// when vault is solvent , don't need to report solvency
-			continue
-		}
-
-		select {
-		case c.globalSolvencyQueue <- types.Solvency{
-			Height: bchBlockHeight,
-			Chain:  common.BCHChain,
-			PubKey: asgard.PubKey,
-			Coins:  acct.Coins,
-		}:
-		case <-time.After(constants.ThorchainBlockTime):
-			c.logger.Info().Msgf("fail to send solvency info to THORChain, timeout")
-		}
-	}
-	c.lastSolvencyCheckHeight = bchBlockHeight
-	return nil
-}
-
-// ShouldReportSolvency based on the given block height , should the client report solvency to THORNode
-func (c *Client) ShouldReportSolvency(height int64) bool {
-	return height-c.lastSolvencyCheckHeight > 1
+	return c.utxoClient.ConfirmationCountReady(txIn)
 }
@@ -6,9 +6,9 @@ import (
 	"github.com/gcash/bchd/bchec"
 	"github.com/rs/zerolog"
 	"github.com/rs/zerolog/log"
-	"github.com/tendermint/tendermint/crypto/secp256k1"
 	txscript "gitlab.com/thorchain/bifrost/bchd-txscript"
 
+	"gitlab.com/thorchain/thornode/bifrost/pkg/chainclients/shared/utxo"
 	"gitlab.com/thorchain/thornode/bifrost/tss"
 	"gitlab.com/thorchain/thornode/common"
 )
@@ -38,9 +38,7 @@ func NewKeySignWrapper(privateKey *bchec.PrivateKey, tssKeyManager tss.Thorchain
 
 // GetBech32AccountPubKey convert the given private key to
 func GetBech32AccountPubKey(key *bchec.PrivateKey) (common.PubKey, error) {
-	buf := key.PubKey().SerializeCompressed()
-	pk := secp256k1.PubKey(buf)
-	return common.NewPubKeyFromCrypto(pk)
+	return utxo.GetBech32AccountPubKey(key.PubKey().SerializeCompressed())
 }
 
 // GetSignable based on the given poolPubKey
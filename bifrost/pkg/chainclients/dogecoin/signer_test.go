@@ -18,9 +18,6 @@ import (
 	cKeys "github.com/cosmos/cosmos-sdk/crypto/keyring"
 	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
 	"github.com/eager7/dogd/btcec"
-	"github.com/eager7/dogd/btcjson"
-	"github.com/syndtr/goleveldb/leveldb"
-	"github.com/syndtr/goleveldb/leveldb/storage"
 	ctypes "gitlab.com/thorchain/binance-sdk/common/types"
 	. "gopkg.in/check.v1"
 
@@ -41,7 +38,6 @@ type DogecoinSignerSuite struct {
 	bridge thorclient.ThorchainBridge
 	cfg    config.BifrostChainConfiguration
 	m      *metrics.Metrics
-	db     *leveldb.DB
 	keys   *thorclient.Keys
 }
 
@@ -105,6 +101,8 @@ func (s *DogecoinSignerSuite) SetUpTest(c *C) {
 				httpTestHandler(c, rw, "../../../../test/fixtures/doge/getnetworkinfo.json")
 			case "getbestblockhash":
 				httpTestHandler(c, rw, "../../../../test/fixtures/doge/getbestblockhash.json")
+			case "getblockcount":
+				httpTestHandler(c, rw, "../../../../test/fixtures/doge/blockcount.json")
 			case "getblock":
 				httpTestHandler(c, rw, "../../../../test/fixtures/doge/block.json")
 			case "getrawtransaction":
@@ -131,18 +129,11 @@ func (s *DogecoinSignerSuite) SetUpTest(c *C) {
 	s.bridge, err = thorclient.NewThorchainBridge(cfg, s.m, s.keys)
 	c.Assert(err, IsNil)
 	s.client, _ = NewClient(s.keys, s.cfg, nil, s.bridge, s.m)
-	storage := storage.NewMemStorage()
-	db, err := leveldb.Open(storage, nil)
-	c.Assert(err, IsNil)
-	s.client.temporalStorage, err = utxo.NewTemporalStorage(db, 0)
-	s.db = db
-	c.Assert(err, IsNil)
 	c.Assert(s.client, NotNil)
 }
 
 func (s *DogecoinSignerSuite) TearDownTest(c *C) {
 	s.server.Close()
-	c.Assert(s.db.Close(), IsNil)
 }
 
 func (s *DogecoinSignerSuite) TestGetDOGEPrivateKey(c *C) {
@@ -306,25 +297,23 @@ func (s *DogecoinSignerSuite) TestBroadcastTx(c *C) {
 }
 
 func (s *DogecoinSignerSuite) TestIsSelfTransaction(c *C) {
-	c.Check(s.client.isSelfTransaction("66d2d6b5eb564972c59e4797683a1225a02515a41119f0a8919381236b63e948"), Equals, false)
+	c.Check(s.client.utxoClient.IsSelfTransaction("66d2d6b5eb564972c59e4797683a1225a02515a41119f0a8919381236b63e948"), Equals, false)
 	bm := utxo.NewBlockMeta("", 1024, "")
 	hash := "66d2d6b5eb564972c59e4797683a1225a02515a41119f0a8919381236b63e948"
 	bm.AddSelfTransaction(hash)
 	c.Assert(s.client.temporalStorage.SaveBlockMeta(1024, bm), IsNil)
-	c.Check(s.client.isSelfTransaction("66d2d6b5eb564972c59e4797683a1225a02515a41119f0a8919381236b63e948"), Equals, true)
+	c.Check(s.client.utxoClient.IsSelfTransaction("66d2d6b5eb564972c59e4797683a1225a02515a41119f0a8919381236b63e948"), Equals, true)
 }
 
 func (s *DogecoinSignerSuite) TestEstimateTxSize(c *C) {
-	size := s.client.estimateTxSize("OUT:2180B871F2DEA2546E1403DBFE9C26B062ABAFFD979CF3A65F2B4D2230105CF1", []btcjson.ListUnspentResult{
+	size := s.client.estimateTxSize("OUT:2180B871F2DEA2546E1403DBFE9C26B062ABAFFD979CF3A65F2B4D2230105CF1", []utxo.UnspentOutput{
 		{
-			TxID:      "66d2d6b5eb564972c59e4797683a1225a02515a41119f0a8919381236b63e948",
-			Vout:      0,
-			Spendable: true,
+			TxID: "66d2d6b5eb564972c59e4797683a1225a02515a41119f0a8919381236b63e948",
+			Vout: 0,
 		},
 		{
-			TxID:      "c5946215d82d5870ba2b1e8f245d8aa1446783975aa3a592cf55589fccbf285f",
-			Vout:      0,
-			Spendable: true,
+			TxID: "c5946215d82d5870ba2b1e8f245d8aa1446783975aa3a592cf55589fccbf285f",
+			Vout: 0,
 		},
 	})
 	c.Assert(size, Equals, int64(417))
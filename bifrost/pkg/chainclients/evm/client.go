@@ -22,6 +22,7 @@ import (
 
 	"gitlab.com/thorchain/thornode/bifrost/blockscanner"
 	"gitlab.com/thorchain/thornode/bifrost/metrics"
+	"gitlab.com/thorchain/thornode/bifrost/pkg/chainclients/shared/asgard"
 	"gitlab.com/thorchain/thornode/bifrost/pkg/chainclients/shared/evm"
 	"gitlab.com/thorchain/thornode/bifrost/pkg/chainclients/shared/runners"
 	"gitlab.com/thorchain/thornode/bifrost/pkg/chainclients/shared/signercache"
@@ -54,6 +55,8 @@ type EVMClient struct {
 	vaultABI                *abi.ABI
 	pubkeyMgr               pubkeymanager.PubKeyValidator
 	poolMgr                 thorclient.PoolManager
+	asgardAddresses         []common.Address
+	lastAsgard              time.Time
 	tssKeySigner            *tss.KeySign
 	wg                      *sync.WaitGroup
 	stopchan                chan struct{}
@@ -109,14 +112,11 @@ func NewEVMClient(
 	}
 
 	// create rpc clients
-	rpcClient, err := evm.NewEthRPC(cfg.RPCHost, cfg.BlockScanner.HTTPRequestTimeout, cfg.ChainID.String())
+	ethClient, err := dialRPC(cfg)
 	if err != nil {
-		return nil, fmt.Errorf("fail to create ETH rpc host(%s): %w", cfg.RPCHost, err)
-	}
-	ethClient, err := ethclient.Dial(cfg.RPCHost)
-	if err != nil {
-		return nil, fmt.Errorf("fail to dial ETH rpc host(%s): %w", cfg.RPCHost, err)
+		return nil, err
 	}
+	rpcClient := evm.NewEthRPCWithClient(cfg.RPCHost, ethClient, cfg.BlockScanner.HTTPRequestTimeout, cfg.ChainID.String())
 
 	// get chain id
 	chainID, err := getChainID(ethClient, cfg.BlockScanner.HTTPRequestTimeout)
@@ -212,6 +212,7 @@ func NewEVMClient(
 
 // Start starts the chain client with the given queues.
 func (c *EVMClient) Start(globalTxsQueue chan stypes.TxIn, globalErrataQueue chan stypes.ErrataBlock, globalSolvencyQueue chan stypes.Solvency) {
+	c.evmScanner.globalErrataQueue = globalErrataQueue
 	c.globalSolvencyQueue = globalSolvencyQueue
 	c.tssKeySigner.Start()
 	c.blockScanner.Start(globalTxsQueue)
@@ -348,7 +349,7 @@ func (c *EVMClient) GetBalances(addr string, height *big.Int) (common.Coins, err
 		}
 		asset := c.cfg.ChainID.GetGasAsset()
 		if !strings.EqualFold(token.Address, evm.NativeTokenAddr) {
-			asset, err = common.NewAsset(fmt.Sprintf("EVM.%s-%s", token.Symbol, token.Address))
+			asset, err = common.NewAsset(fmt.Sprintf("%s.%s-%s", c.cfg.ChainID, token.Symbol, token.Address))
 			if err != nil {
 				return nil, err
 			}
@@ -516,6 +517,7 @@ func (c *EVMClient) buildOutboundTx(txOutItem stypes.TxOutItem, memo mem.Memo, n
 	// compare the gas rate prescribed by THORChain against the price it can get from the chain
 	// ensure signer always pay enough higher gas price
 	// GasRate from thorchain is in 1e8, need to convert to Wei
+	// with dynamic fees enabled the gas rate is used as the max fee per gas
 	gasRate := convertThorchainAmountToWei(big.NewInt(txOutItem.GasRate))
 	if gasRate.Cmp(c.GetGasPrice()) < 0 {
 		gasRate = c.GetGasPrice()
@@ -582,19 +584,59 @@ func (c *EVMClient) buildOutboundTx(txOutItem stypes.TxOutItem, memo mem.Memo, n
 			estimatedGas = big.NewInt(0).Div(gasOut, gasRate).Uint64()
 			c.logger.Info().Str("memo", txOutItem.Memo).Uint64("estimatedGas", estimatedGas).Int64("gasRate", gasRate.Int64()).Msg("override estimate gas with max")
 		}
-		createdTx = etypes.NewTransaction(nonce, ecommon.HexToAddress(contractAddr.String()), evmValue, estimatedGas, gasRate, txData)
+		createdTx = c.newOutboundTx(nonce, ecommon.HexToAddress(contractAddr.String()), evmValue, estimatedGas, gasRate, txOutItem.MaxGas, txData)
 	} else {
 		if estimatedGas > uint64(c.cfg.BlockScanner.MaxGasFee) {
 			// the estimated gas unit is more than the maximum , so bring down the gas rate
 			maxGasWei := big.NewInt(1).Mul(big.NewInt(c.cfg.BlockScanner.MaxGasFee), gasRate)
 			gasRate = big.NewInt(1).Div(maxGasWei, big.NewInt(int64(estimatedGas)))
 		}
-		createdTx = etypes.NewTransaction(nonce, ecommon.HexToAddress(contractAddr.String()), evmValue, estimatedGas, gasRate, txData)
+		createdTx = c.newOutboundTx(nonce, ecommon.HexToAddress(contractAddr.String()), evmValue, estimatedGas, gasRate, txOutItem.MaxGas, txData)
 	}
 
 	return createdTx, nil
 }
 
+// newOutboundTx creates the outbound transaction, when dynamic fees are enabled this is
+// an EIP-1559 transaction paying at least the gas rate and up to the MaxGas THORChain
+// allows for the gas limit as max fee per gas, otherwise a legacy transaction paying the
+// gas rate.
+func (c *EVMClient) newOutboundTx(nonce uint64, to ecommon.Address, value *big.Int, gas uint64, gasRate *big.Int, maxGas common.Gas, data []byte) *etypes.Transaction {
+	if !c.cfg.BlockScanner.DynamicFees {
+		return etypes.NewTransaction(nonce, to, value, gas, gasRate, data)
+	}
+
+	// the max fee is the per unit share of the MaxGas, never less than the gas rate which
+	// may have been floored at the network gas price
+	gasFeeCap := new(big.Int).Set(gasRate)
+	maxGasWei := big.NewInt(0)
+	for _, coin := range maxGas {
+		maxGasWei.Add(maxGasWei, convertThorchainAmountToWei(coin.Amount.BigInt()))
+	}
+	if gas > 0 {
+		maxGasRate := maxGasWei.Div(maxGasWei, new(big.Int).SetUint64(gas))
+		if maxGasRate.Cmp(gasFeeCap) > 0 {
+			gasFeeCap = maxGasRate
+		}
+	}
+
+	// the tip can never exceed the max fee
+	gasTipCap := new(big.Int).Set(c.evmScanner.GetGasTipCap())
+	if gasTipCap.Cmp(gasFeeCap) > 0 {
+		gasTipCap.Set(gasFeeCap)
+	}
+	return etypes.NewTx(&etypes.DynamicFeeTx{
+		ChainID:   c.evmScanner.eipSigner.ChainID(),
+		Nonce:     nonce,
+		GasTipCap: gasTipCap,
+		GasFeeCap: gasFeeCap,
+		Gas:       gas,
+		To:        &to,
+		Value:     value,
+		Data:      data,
+	})
+}
+
 // --------------------------------- sign ---------------------------------
 
 // SignTx returns the signed transaction.
@@ -731,6 +773,11 @@ func (c *EVMClient) BroadcastTx(txOutItem stypes.TxOutItem, hexTx []byte) (strin
 
 // OnObservedTxIn is called when a new observed tx is received.
 func (c *EVMClient) OnObservedTxIn(txIn stypes.TxInItem, blockHeight int64) {
+	// record the tx for re-org detection on chains without instant finality
+	if c.cfg.BlockScanner.ReorgDetection {
+		c.evmScanner.onObservedTxIn(txIn, blockHeight)
+	}
+
 	m, err := mem.ParseMemo(common.LatestVersion, txIn.Memo)
 	if err != nil {
 		// Debug log only as ParseMemo error is expected for THORName inbounds.
@@ -750,24 +797,130 @@ func (c *EVMClient) OnObservedTxIn(txIn stypes.TxInItem, blockHeight int64) {
 
 // GetConfirmationCount returns the confirmation count for the given tx.
 func (c *EVMClient) GetConfirmationCount(txIn stypes.TxIn) int64 {
-	switch c.cfg.ChainID {
-	case common.AVAXChain, common.BSCChain: // instant finality
+	// chains with instant finality don't count confirmations
+	if !c.cfg.BlockScanner.ConfirmationCounting {
 		return 0
-	default:
-		c.logger.Fatal().Msgf("unsupported chain: %s", c.cfg.ChainID)
+	}
+	if len(txIn.TxArray) == 0 {
 		return 0
 	}
+	// mempool items don't need confirmation
+	if txIn.MemPool {
+		return 0
+	}
+	confirm, err := c.getBlockRequiredConfirmation(txIn)
+	if err != nil {
+		c.logger.Err(err).Msg("fail to get block confirmation")
+		return 0
+	}
+	c.logger.Debug().Int64("confirm", confirm).Msg("confirmation required")
+	return confirm
 }
 
 // ConfirmationCountReady returns true if the confirmation count is ready.
 func (c *EVMClient) ConfirmationCountReady(txIn stypes.TxIn) bool {
-	switch c.cfg.ChainID {
-	case common.AVAXChain, common.BSCChain: // instant finality
+	// chains with instant finality don't count confirmations
+	if !c.cfg.BlockScanner.ConfirmationCounting {
+		return true
+	}
+	if len(txIn.TxArray) == 0 {
 		return true
-	default:
-		c.logger.Fatal().Msgf("unsupported chain: %s", c.cfg.ChainID)
-		return false
 	}
+	// mempool items don't need confirmation
+	if txIn.MemPool {
+		return true
+	}
+	blockHeight := txIn.TxArray[0].BlockHeight
+	c.logger.Info().Int64("confirm", txIn.ConfirmationRequired).Msg("confirmation required")
+	// every tx in txIn already have at least 1 confirmation
+	return (c.evmScanner.currentBlockHeight - blockHeight) >= txIn.ConfirmationRequired
+}
+
+// getBlockRequiredConfirmation returns the number of confirmations the given txIn needs
+// before it can be sent to thorchain, based on the value relative to the block reward.
+func (c *EVMClient) getBlockRequiredConfirmation(txIn stypes.TxIn) (int64, error) {
+	asgards, err := c.getAsgardAddress()
+	if err != nil {
+		c.logger.Err(err).Msg("fail to get asgard addresses")
+		asgards = c.asgardAddresses
+	}
+	totalTxValue := c.getTotalTransactionValue(txIn, asgards)
+	totalTxValueInWei := convertThorchainAmountToWei(totalTxValue.BigInt())
+	totalFeeAndSubsidy := big.NewInt(ethBlockRewardAndFee)
+	confirm := cosmos.NewUintFromBigInt(totalTxValueInWei).MulUint64(2).Quo(cosmos.NewUintFromBigInt(totalFeeAndSubsidy)).Uint64()
+	c.logger.Info().
+		Stringer("value", totalTxValueInWei).
+		Stringer("reward", totalFeeAndSubsidy).
+		Uint64("confirm", confirm).
+		Msg("block required confirmation")
+	if confirm < 2 {
+		// in ETH PoS (post merge) reorgs are harder to do but can occur. In
+		// looking at 1k reorg blocks, 10 were reorg'ed at a height of 2, and
+		// the rest were one (none were three or larger). While the odds of
+		// getting reorg'ed are small (as it can only happen for very small
+		// trades), the additional delay to swappers is also small (12 secs or
+		// so). Thus, the determination by thorsec, 9R and devs were to set the
+		// new min conf is 2.
+		return 2, nil
+	}
+	return int64(confirm), nil
+}
+
+// getTotalTransactionValue returns the value of the txs in the gas asset, excluding txs
+// sent by the given addresses or our own vaults.
+func (c *EVMClient) getTotalTransactionValue(txIn stypes.TxIn, excludeFrom []common.Address) cosmos.Uint {
+	total := cosmos.ZeroUint()
+	if len(txIn.TxArray) == 0 {
+		return total
+	}
+	for _, item := range txIn.TxArray {
+		fromAsgard := false
+		for _, fromAddress := range excludeFrom {
+			if strings.EqualFold(fromAddress.String(), item.Sender) {
+				fromAsgard = true
+				break
+			}
+		}
+		if fromAsgard {
+			continue
+		}
+		// if from address is yggdrasil , exclude the value from confirmation counting
+		ok, _ := c.pubkeyMgr.IsValidPoolAddress(item.Sender, c.cfg.ChainID)
+		if ok {
+			continue
+		}
+		for _, coin := range item.Coins {
+			if coin.IsEmpty() {
+				continue
+			}
+			amount := coin.Amount
+			if !coin.Asset.Equals(c.cfg.ChainID.GetGasAsset()) {
+				var err error
+				amount, err = c.poolMgr.GetValue(coin.Asset, c.cfg.ChainID.GetGasAsset(), coin.Amount)
+				if err != nil {
+					c.logger.Err(err).Stringer("asset", coin.Asset).Msg("fail to get value")
+					continue
+				}
+			}
+			total = total.Add(amount)
+		}
+	}
+	return total
+}
+
+func (c *EVMClient) getAsgardAddress() ([]common.Address, error) {
+	if time.Since(c.lastAsgard) < constants.ThorchainBlockTime && c.asgardAddresses != nil {
+		return c.asgardAddresses, nil
+	}
+	newAddresses, err := asgard.GetAddresses(c.cfg.ChainID, maxAsgardAddresses, c.bridge)
+	if err != nil {
+		return nil, fmt.Errorf("fail to get asgards : %w", err)
+	}
+	if len(newAddresses) > 0 { // ensure we don't overwrite with empty list
+		c.asgardAddresses = newAddresses
+	}
+	c.lastAsgard = time.Now()
+	return c.asgardAddresses, nil
 }
 
 // --------------------------------- solvency ---------------------------------
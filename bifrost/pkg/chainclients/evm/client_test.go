@@ -13,6 +13,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/crypto/codec"
 	"github.com/cosmos/cosmos-sdk/crypto/hd"
 	cKeys "github.com/cosmos/cosmos-sdk/crypto/keyring"
+	etypes "github.com/ethereum/go-ethereum/core/types"
 	. "gopkg.in/check.v1"
 
 	"gitlab.com/thorchain/thornode/bifrost/metrics"
@@ -49,7 +50,7 @@ func GetMetricForTest(c *C) *metrics.Metrics {
 			ListenPort:   9000,
 			ReadTimeout:  time.Second,
 			WriteTimeout: time.Second,
-			Chains:       common.Chains{common.AVAXChain},
+			Chains:       common.Chains{common.AVAXChain, common.ETHChain},
 		})
 		c.Assert(m, NotNil)
 		c.Assert(err, IsNil)
@@ -81,6 +82,10 @@ func (s *EVMSuite) SetUpTest(c *C) {
 						Chain:  common.AVAXChain,
 						Router: "0x17aB05351fC94a1a67Bf3f56DdbB941aE6c63E25",
 					},
+					{
+						Chain:  common.ETHChain,
+						Router: "0xE65e9d372F8cAcc7b6dfcd4af6507851Ed31bb44",
+					},
 				},
 			})
 			buf, err := json.MarshalIndent(pubKeysVault, "", "	")
@@ -126,6 +131,22 @@ func (s *EVMSuite) SetUpTest(c *C) {
 				_, err := rw.Write([]byte(`{"jsonrpc":"2.0","id":1,"result":"0x1"}`))
 				c.Assert(err, IsNil)
 			}
+			if rpcRequest.Method == "eth_sendRawTransaction" {
+				_, err := rw.Write([]byte(`{"jsonrpc":"2.0","id":1,"result":"0x88df016429689c079f3b2f6ad39fa052532c56795b733da78a91ebe6a713944b"}`))
+				c.Assert(err, IsNil)
+			}
+			if rpcRequest.Method == "eth_getTransactionByHash" {
+				var hashes []string
+				c.Assert(json.Unmarshal(rpcRequest.Params, &hashes), IsNil)
+				switch hashes[0] {
+				case "0x88df016429689c079f3b2f6ad39fa052532c56795b733da78a91ebe6a713944b": // pending
+					_, err := rw.Write([]byte(`{"jsonrpc":"2.0","id":1,"result":{"nonce":"0x2","gasPrice":"0x1","gas":"0x13990","to":"0xe65e9d372f8cacc7b6dfcd4af6507851ed31bb44","value":"0x22b1c8c1227a00000","input":"0x1fece7b4000000000000000000000000f6da288748ec4c77642f6c5543717539b3ae001b00000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000008000000000000000000000000000000000000000000000000000000000000000045345454400000000000000000000000000000000000000000000000000000000","v":"0xa96","r":"0x4fed375d064158c79dd0ee1e35cbfbe6e19ed7c0005763ca1edc10121124d1fd","s":"0x56d194669c9188176ed87e96b4bd2e2b3869cdb959c1153a557e3d8d8d48c12c","hash":"0x81604fe8c8df8b5e32daafa00acd06ec97281ed3056ab368cf57e2dcacd7e2d1"}}`))
+					c.Assert(err, IsNil)
+				case "0x96395fbdb39e33293999dc1a0a3b87c8a9e51185e177760d1482c2155bb35b87": // committed
+					_, err := rw.Write([]byte(`{"jsonrpc":"2.0","id":1,"result":{"blockHash":"0x96395fbdb39e33293999dc1a0a3b87c8a9e51185e177760d1482c2155bb35b87","blockNumber":"0x32","from":"0xfabb9cc6ec839b1214bb11c53377a56a6ed81762","gas":"0x26fca","gasPrice":"0x1","hash":"0xc416a0332b4346f8090818981d1b2bf491d67b22cfec44ed8ec9a897b3631db2","input":"0x1fece7b40000000000000000000000008d8f3199e684c76f25eeb9c0ce922d15bf72dfa200000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000008000000000000000000000000000000000000000000000000000000000000000384144443a4554482e4554483a7474686f7231777a3738716d726b706c726468793337747730746e766e30746b6d35707164367a64703235370000000000000000","nonce":"0x0","to":"0xe65e9d372f8cacc7b6dfcd4af6507851ed31bb44","transactionIndex":"0x0","value":"0x58d15e176280000","v":"0xa96","r":"0x3c5e5945cadf1429bdb3e45a74003d86d62dd4091d9664f0c8163a951fe6f10e","s":"0x1b86c85a84b0a76ea5d75ff08ea667f7f1e1883245b70deb4d4c347de3585ece"}}`))
+					c.Assert(err, IsNil)
+				}
+			}
 			if rpcRequest.Method == "eth_estimateGas" {
 				_, err := rw.Write([]byte(`{"jsonrpc":"2.0","id":1,"result":"0x493df"}`))
 				c.Assert(err, IsNil)
@@ -627,3 +648,233 @@ func (s *EVMSuite) TestSignEVMTx(c *C) {
 	c.Assert(err, IsNil)
 	c.Assert(result, NotNil)
 }
+
+func (s *EVMSuite) TestSignEVMDynamicFeeTx(c *C) {
+	pubkeyMgr, err := pubkeymanager.NewPubKeyManager(s.bridge, s.m)
+	c.Assert(err, IsNil)
+	poolMgr := thorclient.NewPoolMgr(s.bridge)
+	e, err := NewEVMClient(s.thorKeys, config.BifrostChainConfiguration{
+		ChainID: common.AVAXChain,
+		RPCHost: "http://" + s.server.Listener.Addr().String(),
+		BlockScanner: config.BifrostBlockScannerConfiguration{
+			RPCHost:            "http://" + s.server.Listener.Addr().String(),
+			StartBlockHeight:   1, // avoids querying thorchain for block height
+			HTTPRequestTimeout: time.Second,
+			DynamicFees:        true,
+		},
+	}, nil, s.bridge, s.m, pubkeyMgr, poolMgr)
+	c.Assert(err, IsNil)
+	c.Assert(e, NotNil)
+	c.Assert(pubkeyMgr.Start(), IsNil)
+	defer func() { c.Assert(pubkeyMgr.Stop(), IsNil) }()
+	pubkeys := pubkeyMgr.GetPubKeys()
+	addr, err := pubkeys[len(pubkeys)-1].GetAddress(common.AVAXChain)
+	c.Assert(err, IsNil)
+
+	txOut := stypes.TxOutItem{
+		Chain:       common.AVAXChain,
+		ToAddress:   addr,
+		VaultPubKey: e.localPubKey,
+		Coins: common.Coins{
+			common.NewCoin(common.AVAXAsset, cosmos.NewUint(1e18)),
+		},
+		MaxGas: common.Gas{
+			common.NewCoin(common.AVAXAsset, cosmos.NewUint(MaxContractGas)),
+		},
+		GasRate: 1,
+		Memo:    "OUT:4D91ADAFA69765E7805B5FF2F3A0BA1DBE69E37A1CFCD20C48B99C528AA3EE87",
+	}
+
+	// outbound is signed as a dynamic fee tx with the suggested tip
+	e.evmScanner.gasTipCap = big.NewInt(2000000000)
+	result, _, _, err := e.SignTx(txOut, 1)
+	c.Assert(err, IsNil)
+	c.Assert(result, NotNil)
+	tx := &etypes.Transaction{}
+	c.Assert(tx.UnmarshalJSON(result), IsNil)
+	c.Assert(tx.Type(), Equals, uint8(etypes.DynamicFeeTxType))
+	c.Assert(tx.GasTipCap().Int64(), Equals, int64(2000000000))
+	c.Assert(tx.GasFeeCap().Cmp(tx.GasTipCap()) >= 0, Equals, true)
+
+	// the max fee never spends more than the MaxGas
+	maxGasWei := convertThorchainAmountToWei(big.NewInt(MaxContractGas))
+	maxFee := new(big.Int).Mul(tx.GasFeeCap(), new(big.Int).SetUint64(tx.Gas()))
+	c.Assert(maxFee.Cmp(maxGasWei) <= 0, Equals, true)
+
+	// the tip is capped at the max fee
+	e.evmScanner.gasTipCap = big.NewInt(1000000000000)
+	txOut.Memo = "REFUND:4D91ADAFA69765E7805B5FF2F3A0BA1DBE69E37A1CFCD20C48B99C528AA3EE87"
+	result, _, _, err = e.SignTx(txOut, 1)
+	c.Assert(err, IsNil)
+	c.Assert(result, NotNil)
+	tx = &etypes.Transaction{}
+	c.Assert(tx.UnmarshalJSON(result), IsNil)
+	c.Assert(tx.Type(), Equals, uint8(etypes.DynamicFeeTxType))
+	c.Assert(tx.GasTipCap().Cmp(tx.GasFeeCap()), Equals, 0)
+
+	// the max fee is derived from the MaxGas when it allows more than the gas rate
+	asset, err := common.NewAsset("AVAX.TKN-0X3B7FA4DD21C6F9BA3CA375217EAD7CAB9D6BF483")
+	c.Assert(err, IsNil)
+	e.evmScanner.gasTipCap = big.NewInt(2000000000)
+	txOut.Coins = common.Coins{common.NewCoin(asset, cosmos.NewUint(1e18))}
+	txOut.MaxGas = common.Gas{common.NewCoin(common.AVAXAsset, cosmos.NewUint(common.One))}
+	txOut.Memo = "OUT:4D91ADAFA69765E7805B5FF2F3A0BA1DBE69E37A1CFCD20C48B99C528AA3EE87"
+	result, _, _, err = e.SignTx(txOut, 1)
+	c.Assert(err, IsNil)
+	c.Assert(result, NotNil)
+	tx = &etypes.Transaction{}
+	c.Assert(tx.UnmarshalJSON(result), IsNil)
+	maxGasWei = convertThorchainAmountToWei(big.NewInt(common.One))
+	c.Assert(tx.GasFeeCap().Cmp(new(big.Int).Div(maxGasWei, new(big.Int).SetUint64(tx.Gas()))), Equals, 0)
+	c.Assert(tx.GasFeeCap().Cmp(convertThorchainAmountToWei(big.NewInt(txOut.GasRate))) > 0, Equals, true)
+	c.Assert(tx.GasTipCap().Int64(), Equals, int64(2000000000))
+}
+
+func (s *EVMSuite) TestSignETHTx(c *C) {
+	pubkeyMgr, err := pubkeymanager.NewPubKeyManager(s.bridge, s.m)
+	c.Assert(err, IsNil)
+	poolMgr := thorclient.NewPoolMgr(s.bridge)
+	e, err := NewEVMClient(s.thorKeys, config.BifrostChainConfiguration{
+		ChainID: common.ETHChain,
+		RPCHost: "http://" + s.server.Listener.Addr().String(),
+		BlockScanner: config.BifrostBlockScannerConfiguration{
+			ChainID:              common.ETHChain,
+			RPCHost:              "http://" + s.server.Listener.Addr().String(),
+			StartBlockHeight:     1, // avoids querying thorchain for block height
+			HTTPRequestTimeout:   time.Second,
+			ReorgDetection:       true,
+			ConfirmationCounting: true,
+			GasPriceStrategy:     gasPriceStrategyPercentile,
+		},
+	}, nil, s.bridge, s.m, pubkeyMgr, poolMgr)
+	c.Assert(err, IsNil)
+	c.Assert(e, NotNil)
+	c.Assert(pubkeyMgr.Start(), IsNil)
+	defer func() { c.Assert(pubkeyMgr.Stop(), IsNil) }()
+	pubkeys := pubkeyMgr.GetPubKeys()
+	addr, err := pubkeys[len(pubkeys)-1].GetAddress(common.ETHChain)
+	c.Assert(err, IsNil)
+
+	// Not ETH chain
+	result, _, _, err := e.SignTx(stypes.TxOutItem{
+		Chain:       common.AVAXChain,
+		ToAddress:   addr,
+		VaultPubKey: e.localPubKey,
+		Memo:        "OUT:4D91ADAFA69765E7805B5FF2F3A0BA1DBE69E37A1CFCD20C48B99C528AA3EE87",
+	}, 1)
+	c.Assert(err, NotNil)
+	c.Assert(result, IsNil)
+
+	for _, memo := range []string{
+		"OUT:4D91ADAFA69765E7805B5FF2F3A0BA1DBE69E37A1CFCD20C48B99C528AA3EE87",
+		"REFUND:4D91ADAFA69765E7805B5FF2F3A0BA1DBE69E37A1CFCD20C48B99C528AA3EE87",
+		"MIGRATE:1024",
+	} {
+		result, _, _, err = e.SignTx(stypes.TxOutItem{
+			Chain:       common.ETHChain,
+			ToAddress:   addr,
+			VaultPubKey: e.localPubKey,
+			Coins: common.Coins{
+				common.NewCoin(common.ETHAsset, cosmos.NewUint(1e18)),
+			},
+			MaxGas: common.Gas{
+				common.NewCoin(common.ETHAsset, cosmos.NewUint(MaxContractGas)),
+			},
+			GasRate: 1,
+			Memo:    memo,
+		}, 1)
+		c.Assert(err, IsNil)
+		c.Assert(result, NotNil)
+
+		// outbounds are signed for the chain id of the node and sent to the ETH router
+		tx := &etypes.Transaction{}
+		c.Assert(tx.UnmarshalJSON(result), IsNil)
+		c.Assert(tx.ChainId().Int64(), Equals, int64(15))
+		c.Assert(tx.To().String(), Equals, "0xE65e9d372F8cAcc7b6dfcd4af6507851Ed31bb44")
+	}
+}
+
+func (s *EVMSuite) TestGetConfirmationCount(c *C) {
+	pubkeyMgr, err := pubkeymanager.NewPubKeyManager(s.bridge, s.m)
+	c.Assert(err, IsNil)
+	poolMgr := thorclient.NewPoolMgr(s.bridge)
+	e, err := NewEVMClient(s.thorKeys, config.BifrostChainConfiguration{
+		ChainID: common.ETHChain,
+		RPCHost: "http://" + s.server.Listener.Addr().String(),
+		BlockScanner: config.BifrostBlockScannerConfiguration{
+			ChainID:              common.ETHChain,
+			RPCHost:              "http://" + s.server.Listener.Addr().String(),
+			StartBlockHeight:     1, // avoids querying thorchain for block height
+			HTTPRequestTimeout:   time.Second,
+			ConfirmationCounting: true,
+		},
+	}, nil, s.bridge, s.m, pubkeyMgr, poolMgr)
+	c.Assert(err, IsNil)
+	c.Assert(e, NotNil)
+	c.Assert(pubkeyMgr.Start(), IsNil)
+	defer func() { c.Assert(pubkeyMgr.Stop(), IsNil) }()
+
+	asgardAddresses, err := e.getAsgardAddress()
+	c.Assert(err, IsNil)
+	c.Assert(asgardAddresses, NotNil)
+	pubkey := types2.GetRandomPubKey()
+	addr, err := pubkey.GetAddress(common.ETHChain)
+	c.Assert(err, IsNil)
+
+	c.Assert(e.GetConfirmationCount(stypes.TxIn{}), Equals, int64(0))
+	c.Assert(e.ConfirmationCountReady(stypes.TxIn{}), Equals, true)
+
+	// mempool txs don't need confirmation
+	txIn := stypes.TxIn{
+		Chain: common.ETHChain,
+		TxArray: []stypes.TxInItem{
+			{
+				BlockHeight:         1,
+				Tx:                  "4D91ADAFA69765E7805B5FF2F3A0BA1DBE69E37A1CFCD20C48B99C528AA3EE87",
+				Memo:                "Not Sure",
+				Sender:              addr.String(),
+				To:                  addr.String(),
+				ObservedVaultPubKey: pubkey,
+			},
+		},
+		MemPool: true,
+	}
+	c.Assert(e.GetConfirmationCount(txIn), Equals, int64(0))
+	c.Assert(e.ConfirmationCountReady(txIn), Equals, true)
+
+	// small txs need the minimum of 2 confirmations
+	txIn = stypes.TxIn{
+		Chain: common.ETHChain,
+		TxArray: []stypes.TxInItem{
+			{
+				BlockHeight: 1,
+				Tx:          "4D91ADAFA69765E7805B5FF2F3A0BA1DBE69E37A1CFCD20C48B99C528AA3EE87",
+				Memo:        "Not Sure",
+				Sender:      addr.String(),
+				To:          asgardAddresses[0].String(),
+				Coins: common.Coins{
+					common.NewCoin(common.ETHAsset, cosmos.NewUint(3e8)),
+				},
+				ObservedVaultPubKey: pubkey,
+			},
+		},
+	}
+	txIn.ConfirmationRequired = e.GetConfirmationCount(txIn)
+	c.Assert(txIn.ConfirmationRequired, Equals, int64(2))
+	e.evmScanner.currentBlockHeight = 2
+	c.Assert(e.ConfirmationCountReady(txIn), Equals, false)
+	e.evmScanner.currentBlockHeight = 3
+	c.Assert(e.ConfirmationCountReady(txIn), Equals, true)
+
+	// larger txs need confirmations proportional to the block reward
+	txIn.TxArray[0].Coins = common.Coins{
+		common.NewCoin(common.ETHAsset, cosmos.NewUint(30e8)),
+	}
+	c.Assert(e.GetConfirmationCount(txIn), Equals, int64(20))
+
+	// chains with instant finality don't count confirmations
+	e.cfg.BlockScanner.ConfirmationCounting = false
+	c.Assert(e.GetConfirmationCount(txIn), Equals, int64(0))
+	e.evmScanner.currentBlockHeight = 1
+	c.Assert(e.ConfirmationCountReady(txIn), Equals, true)
+}
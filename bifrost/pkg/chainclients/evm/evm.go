@@ -13,4 +13,35 @@ const (
 
 	defaultDecimals = 18 // evm chains consolidate all decimals to 18 (wei)
 	tenGwei         = 10000000000
+
+	// mempoolTxCacheSize is the number of observed pending tx hashes remembered to avoid
+	// observing the same pending deposit multiple times
+	mempoolTxCacheSize = 10000
+
+	// blockCacheSize is the number of block metas kept to detect re-orgs on chains
+	// without instant finality
+	blockCacheSize = 6000
+
+	maxAsgardAddresses   = 100
+	ethBlockRewardAndFee = 3 * 1e18
+)
+
+// gas price strategies of the block scanner, see config GasPriceStrategy
+const (
+	gasPriceStrategyMedian     = "median"
+	gasPriceStrategyPercentile = "percentile"
 )
+
+// quorumMethods are the rpc methods cross checked across two endpoints when rpc quorum is
+// enabled, these are the reads that determine observations.
+var quorumMethods = []string{
+	"eth_getBlockByNumber",
+	"eth_getTransactionReceipt",
+}
+
+// quorumIgnoreFields are the fields of quorum results which are not part of the block
+// and differ between node implementations, e.g. the total difficulty is dropped by some
+// clients since the merge.
+var quorumIgnoreFields = []string{
+	"totalDifficulty",
+}
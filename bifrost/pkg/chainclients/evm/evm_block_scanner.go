@@ -10,6 +10,7 @@ import (
 	"strconv"
 	"strings"
 	"sync"
+	"time"
 
 	_ "embed"
 
@@ -18,6 +19,7 @@ import (
 	ecommon "github.com/ethereum/go-ethereum/common"
 	etypes "github.com/ethereum/go-ethereum/core/types"
 	ethclient "github.com/ethereum/go-ethereum/ethclient"
+	lru "github.com/hashicorp/golang-lru"
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/rs/zerolog"
 	"github.com/rs/zerolog/log"
@@ -57,16 +59,21 @@ type EVMScanner struct {
 	ethClient            *ethclient.Client
 	ethRpc               *evm.EthRPC
 	blockMetaAccessor    evm.BlockMetaAccessor
+	globalErrataQueue    chan<- stypes.ErrataBlock
 	bridge               thorclient.ThorchainBridge
 	pubkeyMgr            pubkeymanager.PubKeyValidator
 	eipSigner            etypes.Signer
 	currentBlockHeight   int64
 	gasCache             []*big.Int
+	gasTipCap            *big.Int
+	gasTipCache          []*big.Int
+	baseFee              *big.Int
 	solvencyReporter     SolvencyReporter
 	whitelistTokens      []tokenlist.ERC20Token
 	whitelistContracts   []common.Address
 	signerCacheManager   *signercache.CacheManager
 	tokenManager         *evm.TokenManager
+	mempoolTxCache       *lru.Cache
 
 	vaultABI *abi.ABI
 	erc20ABI *abi.ABI
@@ -102,6 +109,11 @@ func NewEVMScanner(cfg config.BifrostBlockScannerConfiguration,
 	prefixBlockMeta := fmt.Sprintf("%s-blockmeta-", strings.ToLower(cfg.ChainID.String()))
 	prefixSignedMeta := fmt.Sprintf("%s-signedtx-", strings.ToLower(cfg.ChainID.String()))
 	prefixTokenMeta := fmt.Sprintf("%s-tokenmeta-", strings.ToLower(cfg.ChainID.String()))
+	if cfg.ChainID.Equals(common.ETHChain) {
+		// keep the prefix used by the legacy ethereum client, so pending signed txs are
+		// still unstuck after the upgrade
+		prefixSignedMeta = "signed-txitem-"
+	}
 
 	// create block meta accessor
 	blockMetaAccessor, err := evm.NewLevelDBBlockMetaAccessor(
@@ -117,9 +129,12 @@ func NewEVMScanner(cfg config.BifrostBlockScannerConfiguration,
 		return nil, fmt.Errorf("failed to create contract abi: %w", err)
 	}
 
-	// load token list
+	// load token list, all tokens in the list are whitelisted unless configured otherwise
 	allTokens := tokenlist.GetEVMTokenList(cfg.ChainID, common.LatestVersion).Tokens
 	var whitelistTokens []tokenlist.ERC20Token
+	if len(cfg.WhitelistTokens) == 0 {
+		whitelistTokens = allTokens
+	}
 	for _, addr := range cfg.WhitelistTokens {
 		// find matching token in token list
 		found := false
@@ -163,12 +178,28 @@ func NewEVMScanner(cfg config.BifrostBlockScannerConfiguration,
 
 	// load whitelist contracts for the chain
 	whitelistContracts := []common.Address{}
+	whitelistContracts = append(whitelistContracts, whitelistSmartContractAddress[cfg.ChainID]...)
 	for _, agg := range aggregators.DexAggregators(common.LatestVersion) {
 		if agg.Chain.Equals(cfg.ChainID) {
 			whitelistContracts = append(whitelistContracts, common.Address(agg.Address))
 		}
 	}
 
+	// create the cache of pending txs which have already been observed
+	mempoolTxCache, err := lru.New(mempoolTxCacheSize)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create mempool tx cache: %w", err)
+	}
+
+	gasPrice := big.NewInt(0)
+	switch cfg.GasPriceStrategy {
+	case "", gasPriceStrategyMedian:
+	case gasPriceStrategyPercentile:
+		gasPrice = big.NewInt(initialGasPrice)
+	default:
+		return nil, fmt.Errorf("unknown gas price strategy: %s", cfg.GasPriceStrategy)
+	}
+
 	return &EVMScanner{
 		cfg:                  cfg,
 		logger:               log.Logger.With().Stringer("chain", cfg.ChainID).Logger(),
@@ -176,7 +207,7 @@ func NewEVMScanner(cfg config.BifrostBlockScannerConfiguration,
 		ethRpc:               ethRpc,
 		db:                   storage,
 		m:                    m,
-		gasPrice:             big.NewInt(0),
+		gasPrice:             gasPrice,
 		lastReportedGasPrice: 0,
 		gasPriceChanged:      false,
 		blockMetaAccessor:    blockMetaAccessor,
@@ -186,11 +217,14 @@ func NewEVMScanner(cfg config.BifrostBlockScannerConfiguration,
 		eipSigner:            etypes.NewLondonSigner(chainID),
 		pubkeyMgr:            pubkeyMgr,
 		gasCache:             make([]*big.Int, 0),
+		gasTipCap:            big.NewInt(0),
+		gasTipCache:          make([]*big.Int, 0),
 		solvencyReporter:     solvencyReporter,
 		whitelistTokens:      whitelistTokens,
 		whitelistContracts:   whitelistContracts,
 		signerCacheManager:   signerCacheManager,
 		tokenManager:         tokenManager,
+		mempoolTxCache:       mempoolTxCache,
 	}, nil
 }
 
@@ -201,6 +235,11 @@ func (e *EVMScanner) GetGasPrice() *big.Int {
 	return e.gasPrice
 }
 
+// GetGasTipCap returns the current suggested priority fee for dynamic fee transactions.
+func (e *EVMScanner) GetGasTipCap() *big.Int {
+	return e.gasTipCap
+}
+
 // GetHeight returns the current block height.
 func (e *EVMScanner) GetHeight() (int64, error) {
 	height, err := e.ethRpc.GetBlockHeight()
@@ -215,9 +254,48 @@ func (e *EVMScanner) GetNonce(addr string) (uint64, error) {
 	return e.ethRpc.GetNonce(addr)
 }
 
-// FetchMemPool returns all transactions in the mempool.
-func (e *EVMScanner) FetchMemPool(_ int64) (stypes.TxIn, error) {
-	return stypes.TxIn{}, nil
+// FetchMemPool returns the router deposits pending in the mempool. These are only
+// observed as not finalised, the finalised observation is sent once the transaction is
+// included in a block. A pending tx has no block height or receipt yet, so it is observed
+// at the local scanner height with the gas limit as gas. THORChain reaches consensus on
+// observations that are not final without comparing the heights, and the finalised
+// observation, with the gas used, replaces it.
+func (e *EVMScanner) FetchMemPool(height int64) (stypes.TxIn, error) {
+	txs, err := e.ethRpc.GetPendingTransactions()
+	if err != nil {
+		return stypes.TxIn{}, fmt.Errorf("failed to get pending transactions: %w", err)
+	}
+
+	txIn := stypes.TxIn{
+		Chain:    e.cfg.ChainID,
+		Filtered: false,
+		MemPool:  true,
+		Pending:  true,
+	}
+	for _, tx := range txs {
+		// skip pending txs we have already observed
+		if e.mempoolTxCache.Contains(tx.Hash().Hex()) {
+			continue
+		}
+
+		txInItem, err := e.getTxInFromPendingTransaction(tx)
+		if err != nil {
+			e.logger.Error().Err(err).Str("hash", tx.Hash().Hex()).Msg("failed to parse pending tx")
+			continue
+		}
+		if txInItem == nil {
+			continue
+		}
+		txInItem.BlockHeight = height
+		txIn.TxArray = append(txIn.TxArray, *txInItem)
+		e.mempoolTxCache.Add(tx.Hash().Hex(), nil)
+	}
+
+	if len(txIn.TxArray) == 0 {
+		return stypes.TxIn{}, nil
+	}
+	txIn.Count = strconv.Itoa(len(txIn.TxArray))
+	return txIn, nil
 }
 
 // GetTokens returns all token meta data.
@@ -244,6 +322,23 @@ func (e *EVMScanner) FetchTxs(height, chainHeight int64) (stypes.TxIn, error) {
 		return stypes.TxIn{}, fmt.Errorf("failed to process block: %d, err:%w", height, err)
 	}
 
+	// chains without instant finality keep the block meta to detect re-orgs, it must be
+	// saved even if no transactions were found so the block hash is recorded
+	if e.cfg.ReorgDetection {
+		blockMeta := evmtypes.NewBlockMeta(block.Header(), txIn)
+		if err := e.blockMetaAccessor.SaveBlockMeta(blockMeta.Height, blockMeta); err != nil {
+			e.logger.Err(err).Int64("height", blockMeta.Height).Msg("failed to save block meta")
+		}
+		pruneHeight := height - blockCacheSize
+		if pruneHeight > 0 {
+			defer func() {
+				if err := e.blockMetaAccessor.PruneBlockMeta(pruneHeight); err != nil {
+					e.logger.Err(err).Int64("height", pruneHeight).Msg("failed to prune block meta")
+				}
+			}()
+		}
+	}
+
 	// skip reporting network fee and solvency if block more than flexibility blocks from tip
 	if chainHeight-height > e.cfg.ObservationFlexibilityBlocks {
 		return txIn, nil
@@ -272,17 +367,51 @@ func (e *EVMScanner) processBlock(block *etypes.Block) (stypes.TxIn, error) {
 		Finalised:       false,
 	}
 
-	// skip empty blocks
-	if block.Transactions().Len() == 0 {
-		return txIn, nil
-	}
-
 	// collect gas prices of txs in current block
 	var txsGas []*big.Int
+	var txsTip []*big.Int
+	baseFee := block.BaseFee()
 	for _, tx := range block.Transactions() {
-		txsGas = append(txsGas, tx.GasPrice())
+		if !e.cfg.DynamicFees || baseFee == nil {
+			txsGas = append(txsGas, tx.GasPrice())
+			continue
+		}
+
+		// dynamic fee txs pay the base fee plus the tip, capped at the max fee
+		tip, err := tx.EffectiveGasTip(baseFee)
+		if err != nil {
+			e.logger.Debug().Err(err).Stringer("txid", tx.Hash()).Msg("fail to get effective gas tip")
+			continue
+		}
+		txsTip = append(txsTip, tip)
+		txsGas = append(txsGas, new(big.Int).Add(baseFee, tip))
+	}
+	e.updateGasTipCap(txsTip)
+	e.baseFee = baseFee
+
+	switch e.cfg.GasPriceStrategy {
+	case gasPriceStrategyPercentile:
+		e.updateGasPriceV2(txsGas)
+	default:
+		e.updateGasPrice(txsGas)
+	}
+
+	// rescan the blocks affected by a re-org
+	if e.cfg.ReorgDetection {
+		reorgedTxIns, err := e.processReorg(block.Header())
+		if err != nil {
+			e.logger.Error().Err(err).Int64("height", block.Number().Int64()).Msg("failed to process reorg")
+			return txIn, err
+		}
+		for _, item := range reorgedTxIns {
+			txIn.TxArray = append(txIn.TxArray, item.TxArray...)
+		}
+	}
+
+	// skip empty blocks
+	if block.Transactions().Len() == 0 {
+		return txIn, nil
 	}
-	e.updateGasPrice(txsGas)
 
 	// collect all relevant transactions from the block
 	txInBlock, err := e.getTxIn(block)
@@ -390,7 +519,7 @@ func (a *EVMScanner) getTxInItem(tx *etypes.Transaction) (*stypes.TxInItem, erro
 	}
 
 	a.logger.Debug().Stringer("txid", tx.Hash()).Stringer("to", tx.To()).Msg("not a valid contract")
-	return a.getTxInFromTransaction(tx)
+	return a.getTxInFromTransaction(tx, receipt)
 }
 
 // --------------------------------- gas ---------------------------------
@@ -441,6 +570,80 @@ func (e *EVMScanner) updateGasPrice(prices []*big.Int) {
 	e.m.GetCounter(metrics.GasPriceChange(e.cfg.ChainID)).Inc()
 }
 
+// updateGasPriceV2 stores the mean plus 3x the standard deviation of the 25th percentile
+// gas price over the cached blocks as the gas price to report to thornode
+func (e *EVMScanner) updateGasPriceV2(prices []*big.Int) {
+	// skip empty blocks
+	if len(prices) == 0 {
+		return
+	}
+
+	// find the 25th percentile gas price in the block
+	sort.Slice(prices, func(i, j int) bool { return prices[i].Cmp(prices[j]) == -1 })
+	gasPrice := prices[len(prices)/4]
+
+	// add to the cache
+	e.gasCache = append(e.gasCache, gasPrice)
+	if len(e.gasCache) > e.cfg.GasCacheBlocks {
+		e.gasCache = e.gasCache[(len(e.gasCache) - e.cfg.GasCacheBlocks):]
+	}
+
+	// skip update unless cache is full
+	if len(e.gasCache) < e.cfg.GasCacheBlocks {
+		return
+	}
+
+	// compute the mean of the 25th percentiles in the cache
+	sum := new(big.Int)
+	for _, fee := range e.gasCache {
+		sum.Add(sum, fee)
+	}
+	mean := new(big.Int).Quo(sum, big.NewInt(int64(e.cfg.GasCacheBlocks)))
+
+	// compute the standard deviation of the 25th percentiles in cache
+	std := new(big.Int)
+	for _, fee := range e.gasCache {
+		v := new(big.Int).Sub(fee, mean)
+		v.Mul(v, v)
+		std.Add(std, v)
+	}
+	std.Quo(std, big.NewInt(int64(e.cfg.GasCacheBlocks)))
+	std.Sqrt(std)
+
+	// mean + 3x standard deviation of the 25th percentile fee over blocks
+	e.gasPrice = mean.Add(mean, std.Mul(std, big.NewInt(3)))
+
+	// record metrics
+	gasPriceFloat, _ := new(big.Float).SetInt64(e.gasPrice.Int64()).Float64()
+	e.m.GetGauge(metrics.GasPrice(e.cfg.ChainID)).Set(gasPriceFloat)
+	e.m.GetCounter(metrics.GasPriceChange(e.cfg.ChainID)).Inc()
+}
+
+// updateGasTipCap stores the median of the per block median priority fees over the
+// cached blocks as the tip used for dynamic fee transactions
+func (e *EVMScanner) updateGasTipCap(tips []*big.Int) {
+	// skip empty blocks
+	if len(tips) == 0 {
+		return
+	}
+
+	// find the median tip in the block
+	sort.Slice(tips, func(i, j int) bool { return tips[i].Cmp(tips[j]) == -1 })
+	tip := tips[len(tips)/2]
+
+	// add to the cache
+	e.gasTipCache = append(e.gasTipCache, tip)
+	if len(e.gasTipCache) > e.cfg.GasCacheBlocks {
+		e.gasTipCache = e.gasTipCache[(len(e.gasTipCache) - e.cfg.GasCacheBlocks):]
+	}
+
+	// compute the median of the median tips in the cache
+	medians := []*big.Int{}
+	medians = append(medians, e.gasTipCache...)
+	sort.Slice(medians, func(i, j int) bool { return medians[i].Cmp(medians[j]) == -1 })
+	e.gasTipCap = new(big.Int).Set(medians[len(medians)/2])
+}
+
 // reportNetworkFee reports current network fee to thornode
 func (e *EVMScanner) reportNetworkFee(height int64) {
 	gasPrice := e.GetGasPrice()
@@ -450,27 +653,171 @@ func (e *EVMScanner) reportNetworkFee(height int64) {
 		return
 	}
 
-	// skip fee if less than 1 resolution away from the last
-	feeDelta := new(big.Int).Sub(gasPrice, big.NewInt(int64(e.lastReportedGasPrice)))
-	feeDelta.Abs(feeDelta)
-	if e.lastReportedGasPrice != 0 && feeDelta.Cmp(big.NewInt(e.cfg.GasPriceResolution)) != 1 {
-		return
-	}
-
 	// gas price to 1e8
 	tcGasPrice := new(big.Int).Div(gasPrice, big.NewInt(common.One*100))
 
+	var reportedGasPrice uint64
+	switch e.cfg.GasPriceStrategy {
+	case gasPriceStrategyPercentile:
+		// post every change of the fee in 1e8, the reported fee is at least 1
+		if tcGasPrice.Sign() == 0 {
+			tcGasPrice.SetInt64(1)
+		}
+		if tcGasPrice.Uint64() == e.lastReportedGasPrice {
+			return
+		}
+		reportedGasPrice = tcGasPrice.Uint64()
+	default:
+		// skip fee if less than 1 resolution away from the last
+		feeDelta := new(big.Int).Sub(gasPrice, big.NewInt(int64(e.lastReportedGasPrice)))
+		feeDelta.Abs(feeDelta)
+		if e.lastReportedGasPrice != 0 && feeDelta.Cmp(big.NewInt(e.cfg.GasPriceResolution)) != 1 {
+			return
+		}
+		reportedGasPrice = gasPrice.Uint64()
+	}
+
 	// post to thorchain
 	if _, err := e.bridge.PostNetworkFee(height, e.cfg.ChainID, MaxContractGas, tcGasPrice.Uint64()); err != nil {
 		e.logger.Err(err).Msg("failed to post EVM chain single transfer fee to THORNode")
 	} else {
-		e.lastReportedGasPrice = gasPrice.Uint64()
+		e.lastReportedGasPrice = reportedGasPrice
+	}
+}
+
+// --------------------------------- reorg ---------------------------------
+
+// onObservedTxIn records the observed tx in the block meta, so it can be checked for
+// removal when a re-org is detected
+func (e *EVMScanner) onObservedTxIn(txIn stypes.TxInItem, blockHeight int64) {
+	blockMeta, err := e.blockMetaAccessor.GetBlockMeta(blockHeight)
+	if err != nil {
+		e.logger.Err(err).Int64("height", blockHeight).Msg("failed to get block meta")
+		return
+	}
+	if blockMeta == nil {
+		e.logger.Error().Int64("height", blockHeight).Msg("block meta is nil")
+		return
+	}
+	for _, item := range blockMeta.Transactions {
+		if item.Hash == txIn.Tx {
+			return
+		}
+	}
+
+	blockMeta.Transactions = append(blockMeta.Transactions, evmtypes.TransactionMeta{
+		Hash:        txIn.Tx,
+		BlockHeight: blockHeight,
+	})
+	if err := e.blockMetaAccessor.SaveBlockMeta(blockHeight, blockMeta); err != nil {
+		e.logger.Err(err).Int64("height", blockHeight).Msg("failed to save block meta")
+	}
+}
+
+// processReorg compares the parent hash of the block with the block hash in the block
+// meta of the previous height, and returns the txs of the rescanned blocks on a re-org
+func (e *EVMScanner) processReorg(block *etypes.Header) ([]stypes.TxIn, error) {
+	previousHeight := block.Number.Int64() - 1
+	prevBlockMeta, err := e.blockMetaAccessor.GetBlockMeta(previousHeight)
+	if err != nil {
+		return nil, fmt.Errorf("fail to get block meta of height(%d) : %w", previousHeight, err)
+	}
+	if prevBlockMeta == nil {
+		return nil, nil
+	}
+	// the block's previous hash need to be the same as the block hash chain client recorded in block meta
+	// blockMetas[PreviousHeight].BlockHash == Block.PreviousHash
+	if strings.EqualFold(prevBlockMeta.BlockHash, block.ParentHash.Hex()) {
+		return nil, nil
+	}
+
+	e.logger.Info().
+		Int64("height", block.Number.Int64()).
+		Str("parent_hash", block.ParentHash.Hex()).
+		Int64("meta_height", prevBlockMeta.Height).
+		Str("meta_hash", prevBlockMeta.BlockHash).
+		Msg("re-org detected")
+
+	heights, err := e.reprocessTxs()
+	if err != nil {
+		e.logger.Err(err).Msg("failed to reprocess all txs")
+	}
+	var txIns []stypes.TxIn
+	for _, item := range heights {
+		e.logger.Info().Int64("height", item).Msg("rescan block")
+		block, err := e.ethRpc.GetRPCBlock(item)
+		if err != nil {
+			e.logger.Err(err).Int64("height", item).Msg("failed to get block from RPC endpoint")
+			continue
+		}
+		if block.Transactions().Len() == 0 {
+			continue
+		}
+		txIn, err := e.getTxIn(block)
+		if err != nil {
+			e.logger.Err(err).Int64("height", item).Msg("failed to extract txs from block")
+			continue
+		}
+		if len(txIn.TxArray) > 0 {
+			txIns = append(txIns, txIn)
+		}
+	}
+	return txIns, nil
+}
+
+// reprocessTxs checks every tx recorded in the block metas still exists on chain, txs
+// that have been removed are sent to thorchain as errata. It returns the heights of the
+// blocks whose hash has changed and need to be rescanned.
+func (e *EVMScanner) reprocessTxs() ([]int64, error) {
+	blockMetas, err := e.blockMetaAccessor.GetBlockMetas()
+	if err != nil {
+		return nil, fmt.Errorf("fail to get block metas from local storage: %w", err)
+	}
+	var rescanBlockHeights []int64
+	for _, blockMeta := range blockMetas {
+		metaTxs := make([]evmtypes.TransactionMeta, 0)
+		var errataTxs []stypes.ErrataTx
+		for _, tx := range blockMeta.Transactions {
+			if e.ethRpc.CheckTransaction(tx.Hash) {
+				e.logger.Debug().Int64("height", blockMeta.Height).Str("txid", tx.Hash).Msg("tx still exists")
+				metaTxs = append(metaTxs, tx)
+				continue
+			}
+			// this means the tx doesn't exist in chain ,thus should errata it
+			errataTxs = append(errataTxs, stypes.ErrataTx{
+				TxID:  common.TxID(tx.Hash),
+				Chain: e.cfg.ChainID,
+			})
+		}
+		if len(errataTxs) > 0 {
+			e.globalErrataQueue <- stypes.ErrataBlock{
+				Height: blockMeta.Height,
+				Txs:    errataTxs,
+			}
+		}
+		// get the block again to fix the block hash
+		block, err := e.ethRpc.GetHeader(blockMeta.Height)
+		if err != nil {
+			e.logger.Err(err).Int64("height", blockMeta.Height).Msg("failed to get block header")
+			continue
+		}
+		if !strings.EqualFold(blockMeta.BlockHash, block.Hash().Hex()) {
+			// if the block hash is different as previously recorded , then the block should be rescanned
+			rescanBlockHeights = append(rescanBlockHeights, blockMeta.Height)
+		}
+		blockMeta.PreviousHash = block.ParentHash.Hex()
+		blockMeta.BlockHash = block.Hash().Hex()
+		blockMeta.Transactions = metaTxs
+		if err := e.blockMetaAccessor.SaveBlockMeta(blockMeta.Height, blockMeta); err != nil {
+			e.logger.Err(err).Int64("height", blockMeta.Height).Msg("failed to save block meta")
+		}
 	}
+	return rescanBlockHeights, nil
 }
 
 // --------------------------------- parse transaction ---------------------------------
 
-func (e *EVMScanner) getTxInFromTransaction(tx *etypes.Transaction) (*stypes.TxInItem, error) {
+func (e *EVMScanner) getTxInFromTransaction(tx *etypes.Transaction, receipt *etypes.Receipt) (*stypes.TxInItem, error) {
 	txInItem := &stypes.TxInItem{
 		Tx: tx.Hash().Hex()[2:], // drop the "0x" prefix
 	}
@@ -495,10 +842,7 @@ func (e *EVMScanner) getTxInFromTransaction(tx *etypes.Transaction) (*stypes.TxI
 
 	nativeValue := e.tokenManager.ConvertAmount(evm.NativeTokenAddr, tx.Value())
 	txInItem.Coins = append(txInItem.Coins, common.NewCoin(e.cfg.ChainID.GetGasAsset(), nativeValue))
-	txGasPrice := tx.GasPrice()
-	if txGasPrice.Cmp(big.NewInt(tenGwei)) < 0 {
-		txGasPrice = big.NewInt(tenGwei)
-	}
+	txGasPrice := getEffectiveGasPrice(tx, receipt, nil)
 	txInItem.Gas = common.MakeEVMGas(e.cfg.ChainID, txGasPrice, tx.Gas())
 	txInItem.Gas[0].Asset = e.cfg.ChainID.GetGasAsset()
 
@@ -579,17 +923,98 @@ func (e *EVMScanner) getTxInFromSmartContract(tx *etypes.Transaction, receipt *e
 			}
 		}
 	}
-	e.logger.Info().Str("tx hash", txInItem.Tx).Str("gas price", tx.GasPrice().String()).Uint64("gas used", receipt.GasUsed).Uint64("tx status", receipt.Status).Msg("txInItem parsed from smart contract")
+	txGasPrice := getEffectiveGasPrice(tx, receipt, nil)
+	e.logger.Info().Str("tx hash", txInItem.Tx).Str("gas price", txGasPrice.String()).Uint64("gas used", receipt.GasUsed).Uint64("tx status", receipt.Status).Msg("txInItem parsed from smart contract")
+	txInItem.Gas = common.MakeEVMGas(e.cfg.ChainID, txGasPrice, receipt.GasUsed)
+	if txInItem.Coins.IsEmpty() {
+		return nil, nil
+	}
+	return txInItem, nil
+}
+
+// routerDeposit is the call data of the router deposit and depositWithExpiry methods.
+type routerDeposit struct {
+	Vault      ecommon.Address
+	Asset      ecommon.Address
+	Amount     *big.Int
+	Memo       string
+	Expiration *big.Int
+}
+
+// getTxInFromPendingTransaction returns the txInItem for a router deposit which has not
+// been included in a block yet. There is no receipt to read the deposit event from, so
+// the deposit is parsed from the call data.
+func (e *EVMScanner) getTxInFromPendingTransaction(tx *etypes.Transaction) (*stypes.TxInItem, error) {
+	if tx.To() == nil || !e.isToValidContractAddress(tx.To(), false) {
+		return nil, nil
+	}
 
-	// under no circumstance EVM gas price will be less than 1 Gwei, unless it is in dev environment
-	txGasPrice := tx.GasPrice()
-	if txGasPrice.Cmp(big.NewInt(tenGwei)) < 0 {
-		txGasPrice = big.NewInt(tenGwei)
+	// only deposits are observed from the mempool
+	data := tx.Data()
+	if len(data) < 4 {
+		return nil, nil
 	}
-	txInItem.Gas = common.MakeEVMGas(e.cfg.ChainID, txGasPrice, receipt.GasUsed)
+	method, err := e.vaultABI.MethodById(data[:4])
+	if err != nil {
+		return nil, nil
+	}
+	if method.Name != "deposit" && method.Name != "depositWithExpiry" {
+		return nil, nil
+	}
+	args, err := method.Inputs.Unpack(data[4:])
+	if err != nil {
+		return nil, fmt.Errorf("failed to unpack %s call data: %w", method.Name, err)
+	}
+	var deposit routerDeposit
+	if err = method.Inputs.Copy(&deposit, args); err != nil {
+		return nil, fmt.Errorf("failed to copy %s arguments: %w", method.Name, err)
+	}
+
+	// the router will revert deposits after the expiration
+	if deposit.Expiration != nil && deposit.Expiration.Cmp(big.NewInt(time.Now().Unix())) <= 0 {
+		return nil, nil
+	}
+	if len([]byte(deposit.Memo)) > constants.MaxMemoSize {
+		return nil, nil
+	}
+
+	sender, err := e.eipSigner.Sender(tx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get sender: %w", err)
+	}
+	txInItem := &stypes.TxInItem{
+		Tx:     tx.Hash().Hex()[2:], // drop the "0x" prefix
+		Sender: strings.ToLower(sender.String()),
+		To:     deposit.Vault.String(),
+		Memo:   deposit.Memo,
+	}
+
+	// the router deposits the native value for the native asset, and ignores the amount
+	token := deposit.Asset.String()
+	amount := deposit.Amount
+	if evm.IsNative(token) {
+		amount = tx.Value()
+	}
+	if amount == nil || amount.Sign() <= 0 {
+		return nil, nil
+	}
+	asset, err := e.tokenManager.GetAssetFromTokenAddress(token)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get asset from token address: %w", err)
+	}
+	if asset.IsEmpty() {
+		return nil, nil
+	}
+	decimals := e.tokenManager.GetTokenDecimalsForTHORChain(token)
+	value := e.tokenManager.ConvertAmount(token, new(big.Int).Set(amount))
+	txInItem.Coins = common.Coins{common.NewCoin(asset, value).WithDecimals(decimals)}
 	if txInItem.Coins.IsEmpty() {
 		return nil, nil
 	}
+
+	// there is no receipt yet, so price the tx at the base fee of the latest block
+	txGasPrice := getEffectiveGasPrice(tx, nil, e.baseFee)
+	txInItem.Gas = common.MakeEVMGas(e.cfg.ChainID, txGasPrice, tx.Gas())
 	return txInItem, nil
 }
 
@@ -612,10 +1037,7 @@ func (e *EVMScanner) getTxInFromFailedTransaction(tx *etypes.Transaction, receip
 	if !ok || cif.IsEmpty() {
 		return nil
 	}
-	txGasPrice := tx.GasPrice()
-	if txGasPrice.Cmp(big.NewInt(tenGwei)) < 0 {
-		txGasPrice = big.NewInt(tenGwei)
-	}
+	txGasPrice := getEffectiveGasPrice(tx, receipt, nil)
 	txHash := tx.Hash().Hex()[2:]
 
 	return &stypes.TxInItem{
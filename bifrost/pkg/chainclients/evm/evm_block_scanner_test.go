@@ -20,10 +20,11 @@ import (
 	ethclient "github.com/ethereum/go-ethereum/ethclient"
 	"gitlab.com/thorchain/thornode/bifrost/blockscanner"
 	"gitlab.com/thorchain/thornode/bifrost/metrics"
-	"gitlab.com/thorchain/thornode/bifrost/pkg/chainclients/ethereum/types"
 	"gitlab.com/thorchain/thornode/bifrost/pkg/chainclients/shared/evm"
+	"gitlab.com/thorchain/thornode/bifrost/pkg/chainclients/shared/evm/types"
 	"gitlab.com/thorchain/thornode/bifrost/pubkeymanager"
 	"gitlab.com/thorchain/thornode/bifrost/thorclient"
+	stypes "gitlab.com/thorchain/thornode/bifrost/thorclient/types"
 	"gitlab.com/thorchain/thornode/cmd"
 	thorcommon "gitlab.com/thorchain/thornode/common"
 	"gitlab.com/thorchain/thornode/common/cosmos"
@@ -496,6 +497,99 @@ func (s *BlockScannerTestSuite) TestGetTxInItem(c *C) {
 	c.Assert(txInItem.Coins[0].Amount.Equal(cosmos.NewUint(24310000)), Equals, true)
 }
 
+func (s *BlockScannerTestSuite) TestFetchMemPool(c *C) {
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		switch {
+		case req.RequestURI == thorclient.PubKeysEndpoint:
+			httpTestHandler(c, rw, "../../../../test/fixtures/endpoints/vaults/pubKeys.json")
+		case req.RequestURI == thorclient.InboundAddressesEndpoint:
+			httpTestHandler(c, rw, "../../../../test/fixtures/endpoints/inbound_addresses/inbound_addresses.json")
+		case req.RequestURI == thorclient.AsgardVault:
+			httpTestHandler(c, rw, "../../../../test/fixtures/endpoints/vaults/asgard.json")
+		case strings.HasPrefix(req.RequestURI, thorclient.NodeAccountEndpoint):
+			httpTestHandler(c, rw, "../../../../test/fixtures/endpoints/nodeaccount/template.json")
+		default:
+			body, err := io.ReadAll(req.Body)
+			c.Assert(err, IsNil)
+			type RPCRequest struct {
+				JSONRPC string          `json:"jsonrpc"`
+				ID      interface{}     `json:"id"`
+				Method  string          `json:"method"`
+				Params  json.RawMessage `json:"params"`
+			}
+			var rpcRequest RPCRequest
+			err = json.Unmarshal(body, &rpcRequest)
+			if err != nil {
+				return
+			}
+			if rpcRequest.Method == "eth_chainId" {
+				_, err := rw.Write([]byte(`{"jsonrpc":"2.0","id":1,"result":"0xa868"}`))
+				c.Assert(err, IsNil)
+			}
+			if rpcRequest.Method == "eth_gasPrice" {
+				_, err := rw.Write([]byte(`{"jsonrpc":"2.0","id":1,"result":"0x1"}`))
+				c.Assert(err, IsNil)
+			}
+			if rpcRequest.Method == "txpool_content" {
+				// a router deposit and a router transfer out, only the deposit is observed
+				_, err := rw.Write([]byte(`{"jsonrpc":"2.0","id":1,"result":{"pending":{
+					"0x970e8128ab834e8eac17ab8e3812f010678cf791":{"3":` + string(depositEVMTx) + `},
+					"0xb8bc698bc9c1ed0df7efc37d7367886602361ee5":{"0":` + string(transferOutTx) + `}
+				},"queued":{}}}`))
+				c.Assert(err, IsNil)
+			}
+		}
+	}))
+	ethClient, err := ethclient.Dial(server.URL)
+	c.Assert(err, IsNil)
+	rpcClient, err := evm.NewEthRPC(server.URL, time.Second, "AVAX")
+	c.Assert(err, IsNil)
+	storage, err := blockscanner.NewBlockScannerStorage("", config.LevelDBOptions{})
+	c.Assert(err, IsNil)
+	u, err := url.Parse(server.URL)
+	c.Assert(err, IsNil)
+	bridge, err := thorclient.NewThorchainBridge(config.BifrostClientConfiguration{
+		ChainID:         "thorchain",
+		ChainHost:       u.Host,
+		SignerName:      "bob",
+		SignerPasswd:    "password",
+		ChainHomeFolder: "",
+	}, s.m, s.keys)
+	c.Assert(err, IsNil)
+	pkeyMgr, err := pubkeymanager.NewPubKeyManager(bridge, s.m)
+	c.Assert(err, IsNil)
+	c.Assert(pkeyMgr.Start(), IsNil)
+	defer func() {
+		c.Assert(pkeyMgr.Stop(), IsNil)
+	}()
+
+	bs, err := NewEVMScanner(getConfigForTest(server.URL), storage, big.NewInt(43112), ethClient, rpcClient, bridge, s.m, pkeyMgr, func(height int64) error {
+		return nil
+	}, nil)
+	c.Assert(err, IsNil)
+	c.Assert(bs, NotNil)
+
+	txIn, err := bs.FetchMemPool(10)
+	c.Assert(err, IsNil)
+	c.Assert(txIn.MemPool, Equals, true)
+	c.Assert(txIn.Pending, Equals, true)
+	c.Assert(txIn.TxArray, HasLen, 1)
+	txInItem := txIn.TxArray[0]
+	c.Assert(txInItem.BlockHeight, Equals, int64(10))
+	c.Assert(txInItem.Tx, Equals, "c5df10917683a31c361218577d5e13ee9d7e29f8b92415f337a318942bd2c875")
+	c.Assert(txInItem.Sender, Equals, "0x970e8128ab834e8eac17ab8e3812f010678cf791")
+	c.Assert(txInItem.To, Equals, "0x6F2744B3a9eba0C5929AAdc9e81183a48B9221FC")
+	c.Assert(txInItem.Memo, Equals, "ADD:AVAX.AVAX:tthor1uuds8pd92qnnq0udw0rpg0szpgcslc9p8lluej")
+	c.Assert(txInItem.Coins, HasLen, 1)
+	c.Assert(txInItem.Coins[0].Asset.String(), Equals, "AVAX.AVAX")
+	c.Assert(txInItem.Coins[0].Amount.Uint64(), Equals, cosmos.NewUint(200000000).Uint64())
+
+	// pending txs already observed are not observed again
+	txIn, err = bs.FetchMemPool(11)
+	c.Assert(err, IsNil)
+	c.Assert(txIn.TxArray, HasLen, 0)
+}
+
 // -------------------------------------------------------------------------------------
 // GasPriceV2
 // -------------------------------------------------------------------------------------
@@ -603,3 +697,237 @@ func (s *BlockScannerTestSuite) TestUpdateGasPrice(c *C) {
 	})
 	c.Assert(bs.gasPrice.String(), Equals, big.NewInt(TestGasPriceResolution).String())
 }
+
+func (s *BlockScannerTestSuite) TestGasPriceV2(c *C) {
+	storage, err := blockscanner.NewBlockScannerStorage("", config.LevelDBOptions{})
+	c.Assert(err, IsNil)
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		body, err := io.ReadAll(req.Body)
+		c.Assert(err, IsNil)
+		type RPCRequest struct {
+			JSONRPC string          `json:"jsonrpc"`
+			ID      interface{}     `json:"id"`
+			Method  string          `json:"method"`
+			Params  json.RawMessage `json:"params"`
+		}
+		var rpcRequest RPCRequest
+		err = json.Unmarshal(body, &rpcRequest)
+		c.Assert(err, IsNil)
+		if rpcRequest.Method == "eth_chainId" {
+			_, err := rw.Write([]byte(`{"jsonrpc":"2.0","id":1,"result":"0x539"}`))
+			c.Assert(err, IsNil)
+		}
+		if rpcRequest.Method == "eth_gasPrice" {
+			_, err := rw.Write([]byte(`{"jsonrpc":"2.0","id":1,"result":"0x1"}`))
+			c.Assert(err, IsNil)
+		}
+	}))
+	ethClient, err := ethclient.Dial(server.URL)
+	c.Assert(err, IsNil)
+	rpcClient, err := evm.NewEthRPC(server.URL, time.Second, "ETH")
+	c.Assert(err, IsNil)
+	pubKeyManager, err := pubkeymanager.NewPubKeyManager(s.bridge, s.m)
+	c.Assert(err, IsNil)
+	solvencyReporter := func(height int64) error {
+		return nil
+	}
+	conf := getConfigForTest("http://" + server.Listener.Addr().String())
+	conf.ChainID = thorcommon.ETHChain
+	conf.GasCacheBlocks = 40
+	conf.GasPriceStrategy = gasPriceStrategyPercentile
+	bs, err := NewEVMScanner(conf, storage, big.NewInt(int64(types.Mainnet)), ethClient, rpcClient, s.bridge, s.m, pubKeyManager, solvencyReporter, nil)
+	c.Assert(err, IsNil)
+	c.Assert(bs, NotNil)
+
+	// almost fill gas cache
+	for i := 0; i < 39; i++ {
+		bs.updateGasPriceV2([]*big.Int{big.NewInt(1), big.NewInt(2), big.NewInt(3), big.NewInt(4)})
+	}
+
+	// empty blocks should not count
+	bs.updateGasPriceV2([]*big.Int{})
+	c.Assert(len(bs.gasCache), Equals, 39)
+	c.Assert(bs.gasPrice.Cmp(big.NewInt(initialGasPrice)), Equals, 0)
+
+	// now we should get the average of the 25th percentile gas (2)
+	bs.updateGasPriceV2([]*big.Int{big.NewInt(1), big.NewInt(2), big.NewInt(3), big.NewInt(4)})
+	c.Assert(len(bs.gasCache), Equals, 40)
+	c.Assert(bs.gasPrice.Uint64(), Equals, big.NewInt(2).Uint64())
+
+	// add 20 more blocks with 2x the 25th percentile and we should get 6 (3 + 3x stddev)
+	for i := 0; i < 20; i++ {
+		bs.updateGasPriceV2([]*big.Int{big.NewInt(2), big.NewInt(4), big.NewInt(6), big.NewInt(8)})
+	}
+	c.Assert(len(bs.gasCache), Equals, 40)
+	c.Assert(bs.gasPrice.Uint64(), Equals, big.NewInt(6).Uint64())
+
+	// add 20 more blocks with 2x the 25th percentile and we should get 4
+	for i := 0; i < 20; i++ {
+		bs.updateGasPriceV2([]*big.Int{big.NewInt(2), big.NewInt(4), big.NewInt(6), big.NewInt(8)})
+	}
+	c.Assert(len(bs.gasCache), Equals, 40)
+	c.Assert(bs.gasPrice.Uint64(), Equals, big.NewInt(4).Uint64())
+
+	// add 20 more blocks with 2x the 25th percentile and we should get 12 (6 + 3x stddev)
+	for i := 0; i < 20; i++ {
+		bs.updateGasPriceV2([]*big.Int{big.NewInt(4), big.NewInt(8), big.NewInt(12), big.NewInt(16)})
+	}
+	c.Assert(len(bs.gasCache), Equals, 40)
+	c.Assert(bs.gasPrice.Uint64(), Equals, big.NewInt(12).Uint64())
+}
+
+func (s *BlockScannerTestSuite) TestUpdateGasTipCap(c *C) {
+	storage, err := blockscanner.NewBlockScannerStorage("", config.LevelDBOptions{})
+	c.Assert(err, IsNil)
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		_, err := rw.Write([]byte(`{"jsonrpc":"2.0","id":1,"result":"0x539"}`))
+		c.Assert(err, IsNil)
+	}))
+	ethClient, err := ethclient.Dial(server.URL)
+	c.Assert(err, IsNil)
+	rpcClient, err := evm.NewEthRPC(server.URL, time.Second, "ETH")
+	c.Assert(err, IsNil)
+	pubKeyManager, err := pubkeymanager.NewPubKeyManager(s.bridge, s.m)
+	c.Assert(err, IsNil)
+	solvencyReporter := func(height int64) error {
+		return nil
+	}
+	conf := getConfigForTest("http://" + server.Listener.Addr().String())
+	conf.GasCacheBlocks = 3
+	conf.DynamicFees = true
+	bs, err := NewEVMScanner(conf, storage, big.NewInt(int64(types.Mainnet)), ethClient, rpcClient, s.bridge, s.m, pubKeyManager, solvencyReporter, nil)
+	c.Assert(err, IsNil)
+	c.Assert(bs, NotNil)
+	c.Assert(bs.GetGasTipCap().Uint64(), Equals, uint64(0))
+
+	// the median tip of the block is used
+	bs.updateGasTipCap([]*big.Int{big.NewInt(1), big.NewInt(5), big.NewInt(3)})
+	c.Assert(bs.GetGasTipCap().Uint64(), Equals, uint64(3))
+
+	// empty blocks should not count
+	bs.updateGasTipCap([]*big.Int{})
+	c.Assert(len(bs.gasTipCache), Equals, 1)
+	c.Assert(bs.GetGasTipCap().Uint64(), Equals, uint64(3))
+
+	// the median of the cached block tips is used
+	bs.updateGasTipCap([]*big.Int{big.NewInt(10)})
+	bs.updateGasTipCap([]*big.Int{big.NewInt(1), big.NewInt(1), big.NewInt(2)})
+	c.Assert(len(bs.gasTipCache), Equals, 3)
+	c.Assert(bs.GetGasTipCap().Uint64(), Equals, uint64(3))
+
+	// the oldest block falls out of the cache
+	bs.updateGasTipCap([]*big.Int{big.NewInt(4)})
+	c.Assert(len(bs.gasTipCache), Equals, 3)
+	c.Assert(bs.GetGasTipCap().Uint64(), Equals, uint64(4))
+}
+
+func (s *BlockScannerTestSuite) TestProcessReOrg(c *C) {
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		switch {
+		case req.RequestURI == thorclient.PubKeysEndpoint:
+			httpTestHandler(c, rw, "../../../../test/fixtures/endpoints/vaults/pubKeys.json")
+		case req.RequestURI == thorclient.InboundAddressesEndpoint:
+			httpTestHandler(c, rw, "../../../../test/fixtures/endpoints/inbound_addresses/inbound_addresses.json")
+		case req.RequestURI == thorclient.AsgardVault:
+			httpTestHandler(c, rw, "../../../../test/fixtures/endpoints/vaults/asgard.json")
+		case strings.HasPrefix(req.RequestURI, thorclient.NodeAccountEndpoint):
+			httpTestHandler(c, rw, "../../../../test/fixtures/endpoints/nodeaccount/template.json")
+		default:
+			body, err := io.ReadAll(req.Body)
+			c.Assert(err, IsNil)
+			type RPCRequest struct {
+				JSONRPC string          `json:"jsonrpc"`
+				ID      interface{}     `json:"id"`
+				Method  string          `json:"method"`
+				Params  json.RawMessage `json:"params"`
+			}
+			var rpcRequest RPCRequest
+			err = json.Unmarshal(body, &rpcRequest)
+			c.Assert(err, IsNil)
+			if rpcRequest.Method == "eth_chainId" {
+				_, err := rw.Write([]byte(`{"jsonrpc":"2.0","id":1,"result":"0x1"}`))
+				c.Assert(err, IsNil)
+			}
+			if rpcRequest.Method == "eth_getBlockByNumber" {
+				_, err := rw.Write([]byte(`{"jsonrpc":"2.0","id":1,"result":{
+				"parentHash":"0x8b535592eb3192017a527bbf8e3596da86b3abea51d6257898b2ced9d3a83826",
+				"difficulty": "0x31962a3fc82b",
+				"extraData": "0x4477617266506f6f6c",
+				"gasLimit": "0x47c3d8",
+				"gasUsed": "0x0",
+				"hash": "0x78bfef68fccd4507f9f4804ba5c65eb2f928ea45b3383ade88aaa720f1209cba",
+				"logsBloom": "0x00000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000",
+				"miner": "0x2a65aca4d5fc5b5c859090a6c34d164135398226",
+				"nonce": "0xa5e8fb780cc2cd5e",
+				"number": "0x0",
+				"receiptsRoot": "0x56e81f171bcc55a6ff8345e692c0f86e5b48e01b996cadc001622fb5e363b421",
+				"sha3Uncles": "0x1dcc4de8dec75d7aab85b567b6ccd41ad312451b948a7413f0a142fd40d49347",
+				"size": "0x20e",
+				"stateRoot": "0xdc6ed0a382e50edfedb6bd296892690eb97eb3fc88fd55088d5ea753c48253dc",
+				"timestamp": "0x579f4981",
+				"totalDifficulty": "0x25cff06a0d96f4bee",
+				"transactions": [],
+				"transactionsRoot": "0x88df016429689c079f3b2f6ad39fa052532c56795b733da78a91ebe6a713944b",
+				"uncles": []}}`))
+				c.Assert(err, IsNil)
+			}
+		}
+	}))
+	ethClient, err := ethclient.Dial(server.URL)
+	c.Assert(err, IsNil)
+	rpcClient, err := evm.NewEthRPC(server.URL, time.Second, "ETH")
+	c.Assert(err, IsNil)
+	storage, err := blockscanner.NewBlockScannerStorage("", config.LevelDBOptions{})
+	c.Assert(err, IsNil)
+	bridge, err := thorclient.NewThorchainBridge(config.BifrostClientConfiguration{
+		ChainID:         "thorchain",
+		ChainHost:       server.Listener.Addr().String(),
+		SignerName:      "bob",
+		SignerPasswd:    "password",
+		ChainHomeFolder: "",
+	}, s.m, s.keys)
+	c.Assert(err, IsNil)
+	pkeyMgr, err := pubkeymanager.NewPubKeyManager(bridge, s.m)
+	c.Assert(err, IsNil)
+	c.Assert(pkeyMgr.Start(), IsNil)
+	defer func() {
+		c.Assert(pkeyMgr.Stop(), IsNil)
+	}()
+	conf := getConfigForTest(server.URL)
+	conf.ChainID = thorcommon.ETHChain
+	conf.ReorgDetection = true
+	bs, err := NewEVMScanner(conf, storage, big.NewInt(int64(types.Mainnet)), ethClient, rpcClient, bridge, s.m, pkeyMgr, func(height int64) error {
+		return nil
+	}, nil)
+	c.Assert(err, IsNil)
+	c.Assert(bs, NotNil)
+
+	block, err := CreateBlock(0)
+	c.Assert(err, IsNil)
+	blockNew, err := CreateBlock(1)
+	c.Assert(err, IsNil)
+
+	// no block meta recorded for the previous height, nothing to do
+	reorgedBlocks, err := bs.processReorg(blockNew)
+	c.Assert(err, IsNil)
+	c.Assert(reorgedBlocks, IsNil)
+
+	// record an observed tx in the block meta, the tx no longer exists on chain
+	blockMeta := types.NewBlockMeta(block, stypes.TxIn{})
+	c.Assert(bs.blockMetaAccessor.SaveBlockMeta(0, blockMeta), IsNil)
+	bs.onObservedTxIn(stypes.TxInItem{Tx: "0x88df016429689c079f3b2f6ad39fa052532c56795b733da78a91ebe6a713944b"}, 0)
+	blockMeta, err = bs.blockMetaAccessor.GetBlockMeta(0)
+	c.Assert(err, IsNil)
+	c.Assert(blockMeta.Transactions, HasLen, 1)
+
+	// the parent hash of the new block doesn't match the block meta, so the tx is errata
+	bs.globalErrataQueue = make(chan stypes.ErrataBlock, 1)
+	reorgedBlocks, err = bs.processReorg(blockNew)
+	c.Assert(err, IsNil)
+	c.Assert(reorgedBlocks, IsNil)
+	c.Assert(bs.globalErrataQueue, HasLen, 1)
+	blockMeta, err = bs.blockMetaAccessor.GetBlockMeta(0)
+	c.Assert(err, IsNil)
+	c.Assert(blockMeta, NotNil)
+	c.Assert(blockMeta.Transactions, HasLen, 0)
+}
@@ -1,7 +1,7 @@
 //go:build mocknet
 // +build mocknet
 
-package ethereum
+package evm
 
 import "gitlab.com/thorchain/thornode/common"
 
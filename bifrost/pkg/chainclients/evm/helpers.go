@@ -9,14 +9,49 @@ import (
 
 	ecore "github.com/ethereum/go-ethereum/core"
 	"github.com/ethereum/go-ethereum/core/txpool"
+	etypes "github.com/ethereum/go-ethereum/core/types"
 	ethclient "github.com/ethereum/go-ethereum/ethclient"
+	"github.com/ethereum/go-ethereum/rpc"
+
+	"gitlab.com/thorchain/thornode/bifrost/pkg/chainclients/shared/failover"
 	"gitlab.com/thorchain/thornode/common"
+	"gitlab.com/thorchain/thornode/config"
 )
 
 func isAcceptableError(err error) bool {
 	return err == nil || err.Error() == txpool.ErrAlreadyKnown.Error() || strings.HasPrefix(err.Error(), ecore.ErrNonceTooLow.Error())
 }
 
+// dialRPC returns the rpc client of the chain, requests fail over between the
+// configured rpc hosts and the quorum methods are cross checked across two hosts if
+// quorum is enabled. A single host is dialed directly, which also supports websocket and
+// IPC endpoints.
+func dialRPC(cfg config.BifrostChainConfiguration) (*ethclient.Client, error) {
+	if len(cfg.RPCHosts) == 0 {
+		ethClient, err := ethclient.Dial(cfg.RPCHost)
+		if err != nil {
+			return nil, fmt.Errorf("fail to dial ETH rpc host(%s): %w", cfg.RPCHost, err)
+		}
+		return ethClient, nil
+	}
+
+	pool, err := failover.NewPool(cfg.ChainID, append([]string{cfg.RPCHost}, cfg.RPCHosts...)...)
+	if err != nil {
+		return nil, fmt.Errorf("fail to create rpc endpoint pool: %w", err)
+	}
+	httpClient := failover.NewHTTPClient(pool, failover.Options{
+		Quorum:        cfg.RPCQuorum,
+		QuorumMethods: quorumMethods,
+		HeightMethod:  "eth_blockNumber",
+		IgnoreFields:  quorumIgnoreFields,
+	})
+	client, err := rpc.DialHTTPWithClient(pool.Primary().String(), httpClient)
+	if err != nil {
+		return nil, fmt.Errorf("fail to dial ETH rpc host(%s): %w", cfg.RPCHost, err)
+	}
+	return ethclient.NewClient(client), nil
+}
+
 // getChainID retrieves the chain id from the node - if this fails we assume local net
 func getChainID(client *ethclient.Client, timeout time.Duration) (*big.Int, error) {
 	ctx, cancel := context.WithTimeout(context.Background(), timeout)
@@ -31,3 +66,24 @@ func getChainID(client *ethclient.Client, timeout time.Duration) (*big.Int, erro
 func convertThorchainAmountToWei(amt *big.Int) *big.Int {
 	return big.NewInt(0).Mul(amt, big.NewInt(common.One*100))
 }
+
+// getEffectiveGasPrice returns the gas price paid by the tx. GasPrice is the max fee on
+// dynamic fee txs, so the price is read from the receipt, or the base fee plus the tip
+// when there is no receipt yet. Under no circumstance the gas price will be less than
+// 10 Gwei, unless it is in dev environment.
+func getEffectiveGasPrice(tx *etypes.Transaction, receipt *etypes.Receipt, baseFee *big.Int) *big.Int {
+	gasPrice := tx.GasPrice()
+	switch {
+	case receipt != nil && receipt.EffectiveGasPrice != nil && receipt.EffectiveGasPrice.Sign() > 0:
+		gasPrice = receipt.EffectiveGasPrice
+	case tx.Type() == etypes.DynamicFeeTxType && baseFee != nil:
+		// the tip is only invalid when the max fee is below the base fee
+		if tip, err := tx.EffectiveGasTip(baseFee); err == nil {
+			gasPrice = new(big.Int).Add(baseFee, tip)
+		}
+	}
+	if gasPrice.Cmp(big.NewInt(tenGwei)) < 0 {
+		gasPrice = big.NewInt(tenGwei)
+	}
+	return gasPrice
+}
@@ -2,10 +2,16 @@ package evm
 
 import (
 	"fmt"
+	"math/big"
 
+	ecommon "github.com/ethereum/go-ethereum/common"
 	ecore "github.com/ethereum/go-ethereum/core"
 	"github.com/ethereum/go-ethereum/core/txpool"
+	etypes "github.com/ethereum/go-ethereum/core/types"
 	. "gopkg.in/check.v1"
+
+	"gitlab.com/thorchain/thornode/common"
+	"gitlab.com/thorchain/thornode/config"
 )
 
 type HelpersTestSuite struct{}
@@ -19,3 +25,72 @@ func (s *HelpersTestSuite) TestIsAcceptableError(c *C) {
 	c.Assert(isAcceptableError(fmt.Errorf("%w: foo", ecore.ErrNonceTooLow)), Equals, true)
 	c.Assert(isAcceptableError(fmt.Errorf("foo: %w", ecore.ErrNonceTooLow)), Equals, false)
 }
+
+func (s *HelpersTestSuite) TestGetEffectiveGasPrice(c *C) {
+	to := ecommon.HexToAddress("0x7d182d6a138eaa06f6f452bc3f8fc57e17d1e193")
+	gwei := big.NewInt(1e9)
+
+	// legacy txs pay the gas price, floored at 10 Gwei
+	legacyTx := etypes.NewTransaction(0, to, big.NewInt(1), MaxContractGas, new(big.Int).Mul(big.NewInt(50), gwei), nil)
+	c.Assert(getEffectiveGasPrice(legacyTx, nil, nil).Int64(), Equals, int64(50e9))
+	legacyTx = etypes.NewTransaction(0, to, big.NewInt(1), MaxContractGas, gwei, nil)
+	c.Assert(getEffectiveGasPrice(legacyTx, nil, nil).Int64(), Equals, int64(tenGwei))
+
+	// dynamic fee txs pay the effective gas price of the receipt, not the max fee
+	dynamicTx := etypes.NewTx(&etypes.DynamicFeeTx{
+		ChainID:   big.NewInt(1),
+		GasTipCap: new(big.Int).Mul(big.NewInt(2), gwei),
+		GasFeeCap: new(big.Int).Mul(big.NewInt(100), gwei),
+		Gas:       MaxContractGas,
+		To:        &to,
+		Value:     big.NewInt(1),
+	})
+	c.Assert(dynamicTx.GasPrice().Int64(), Equals, int64(100e9))
+	receipt := &etypes.Receipt{EffectiveGasPrice: new(big.Int).Mul(big.NewInt(32), gwei)}
+	c.Assert(getEffectiveGasPrice(dynamicTx, receipt, nil).Int64(), Equals, int64(32e9))
+
+	// without a receipt the base fee plus the tip is paid
+	baseFee := new(big.Int).Mul(big.NewInt(30), gwei)
+	c.Assert(getEffectiveGasPrice(dynamicTx, nil, baseFee).Int64(), Equals, int64(32e9))
+
+	// the tip is capped at the max fee
+	baseFee = new(big.Int).Mul(big.NewInt(99), gwei)
+	c.Assert(getEffectiveGasPrice(dynamicTx, nil, baseFee).Int64(), Equals, int64(100e9))
+
+	// fall back to the max fee without a receipt or base fee
+	c.Assert(getEffectiveGasPrice(dynamicTx, nil, nil).Int64(), Equals, int64(100e9))
+	c.Assert(getEffectiveGasPrice(dynamicTx, &etypes.Receipt{}, nil).Int64(), Equals, int64(100e9))
+}
+
+func (s *HelpersTestSuite) TestDialRPC(c *C) {
+	// failover only supports http endpoints
+	_, err := dialRPC(config.BifrostChainConfiguration{
+		ChainID:  common.ETHChain,
+		RPCHost:  "ws://localhost:8546",
+		RPCHosts: []string{"http://localhost:8545"},
+	})
+	c.Assert(err, ErrorMatches, ".*ws endpoints are not supported.*")
+	_, err = dialRPC(config.BifrostChainConfiguration{
+		ChainID:  common.ETHChain,
+		RPCHost:  "/root/.ethereum/geth.ipc",
+		RPCHosts: []string{"http://localhost:8545"},
+	})
+	c.Assert(err, ErrorMatches, ".*IPC endpoints are not supported.*")
+
+	// http endpoints are dialed lazily
+	client, err := dialRPC(config.BifrostChainConfiguration{
+		ChainID:  common.ETHChain,
+		RPCHost:  "http://localhost:8545",
+		RPCHosts: []string{"http://localhost:8547"},
+	})
+	c.Assert(err, IsNil)
+	c.Assert(client, NotNil)
+
+	// a single host is dialed directly
+	client, err = dialRPC(config.BifrostChainConfiguration{
+		ChainID: common.ETHChain,
+		RPCHost: "http://localhost:8545",
+	})
+	c.Assert(err, IsNil)
+	c.Assert(client, NotNil)
+}
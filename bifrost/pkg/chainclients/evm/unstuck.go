@@ -134,6 +134,32 @@ func (c *EVMClient) unstuckTx(vaultPubKey, txid string) error {
 		currentGasRate,
 		nil,
 	)
+
+	// a dynamic fee transaction is only replaced when both the tip and the max fee are
+	// at least 10% higher than the original
+	if tx.Type() == etypes.DynamicFeeTxType {
+		gasTipCap := big.NewInt(1).Div(big.NewInt(1).Mul(tx.GasTipCap(), big.NewInt(11)), big.NewInt(10))
+		if gasTipCap.Cmp(c.evmScanner.GetGasTipCap()) < 0 {
+			gasTipCap = c.evmScanner.GetGasTipCap()
+		}
+		gasFeeCap := big.NewInt(1).Div(big.NewInt(1).Mul(tx.GasFeeCap(), big.NewInt(11)), big.NewInt(10))
+		if gasFeeCap.Cmp(currentGasRate) < 0 {
+			gasFeeCap = currentGasRate
+		}
+		if gasFeeCap.Cmp(gasTipCap) < 0 {
+			gasFeeCap = gasTipCap
+		}
+		to := ecommon.HexToAddress(address.String())
+		canceltx = etypes.NewTx(&etypes.DynamicFeeTx{
+			ChainID:   tx.ChainId(),
+			Nonce:     tx.Nonce(),
+			GasTipCap: gasTipCap,
+			GasFeeCap: gasFeeCap,
+			Gas:       MaxContractGas,
+			To:        &to,
+			Value:     big.NewInt(0),
+		})
+	}
 	rawBytes, err := c.kw.Sign(canceltx, pubKey)
 	if err != nil {
 		return fmt.Errorf("fail to sign tx for cancelling with nonce: %d,err: %w", tx.Nonce(), err)
@@ -0,0 +1,77 @@
+package evm
+
+import (
+	"time"
+
+	. "gopkg.in/check.v1"
+
+	evmtypes "gitlab.com/thorchain/thornode/bifrost/pkg/chainclients/shared/evm/types"
+	"gitlab.com/thorchain/thornode/bifrost/pubkeymanager"
+	"gitlab.com/thorchain/thornode/bifrost/thorclient"
+	"gitlab.com/thorchain/thornode/common"
+	"gitlab.com/thorchain/thornode/config"
+	types2 "gitlab.com/thorchain/thornode/x/thorchain/types"
+)
+
+func (s *EVMSuite) TestUnstuckProcess(c *C) {
+	pubkeyMgr, err := pubkeymanager.NewPubKeyManager(s.bridge, s.m)
+	c.Assert(err, IsNil)
+	poolMgr := thorclient.NewPoolMgr(s.bridge)
+	e, err := NewEVMClient(s.thorKeys, config.BifrostChainConfiguration{
+		ChainID: common.ETHChain,
+		RPCHost: "http://" + s.server.Listener.Addr().String(),
+		BlockScanner: config.BifrostBlockScannerConfiguration{
+			ChainID:              common.ETHChain,
+			RPCHost:              "http://" + s.server.Listener.Addr().String(),
+			StartBlockHeight:     1, // avoids querying thorchain for block height
+			HTTPRequestTimeout:   time.Second,
+			ReorgDetection:       true,
+			ConfirmationCounting: true,
+			GasPriceStrategy:     gasPriceStrategyPercentile,
+		},
+	}, nil, s.bridge, s.m, pubkeyMgr, poolMgr)
+	c.Assert(err, IsNil)
+	c.Assert(e, NotNil)
+	c.Assert(pubkeyMgr.Start(), IsNil)
+	defer func() { c.Assert(pubkeyMgr.Stop(), IsNil) }()
+	pubkey := e.kw.GetPubKey().String()
+	height, err := s.bridge.GetBlockHeight()
+	c.Assert(err, IsNil)
+
+	txID1 := types2.GetRandomTxHash().String()
+	txID2 := types2.GetRandomTxHash().String()
+	c.Assert(e.AddSignedTxItem(txID1, height-TxWaitBlocks+1, pubkey), IsNil)
+	c.Assert(e.AddSignedTxItem(txID2, height, pubkey), IsNil)
+
+	// txs signed less than TxWaitBlocks ago are left alone
+	e.unstuckAction()
+	items, err := e.evmScanner.blockMetaAccessor.GetSignedTxItems()
+	c.Assert(err, IsNil)
+	c.Assert(items, HasLen, 2)
+	c.Assert(e.evmScanner.blockMetaAccessor.RemoveSignedTxItem(txID1), IsNil)
+	c.Assert(e.evmScanner.blockMetaAccessor.RemoveSignedTxItem(txID2), IsNil)
+
+	// txs that fail to be fetched are retried
+	c.Assert(e.AddSignedTxItem(txID1, height-TxWaitBlocks, pubkey), IsNil)
+	e.unstuckAction()
+	items, err = e.evmScanner.blockMetaAccessor.GetSignedTxItems()
+	c.Assert(err, IsNil)
+	c.Assert(items, HasLen, 1)
+	c.Assert(e.evmScanner.blockMetaAccessor.RemoveSignedTxItem(txID1), IsNil)
+
+	// the pending tx is cancelled and the committed tx is dropped
+	c.Assert(e.evmScanner.blockMetaAccessor.AddSignedTxItem(evmtypes.SignedTxItem{
+		Hash:        "0x88df016429689c079f3b2f6ad39fa052532c56795b733da78a91ebe6a713944b",
+		Height:      height - TxWaitBlocks,
+		VaultPubKey: pubkey,
+	}), IsNil)
+	c.Assert(e.evmScanner.blockMetaAccessor.AddSignedTxItem(evmtypes.SignedTxItem{
+		Hash:        "0x96395fbdb39e33293999dc1a0a3b87c8a9e51185e177760d1482c2155bb35b87",
+		Height:      height - TxWaitBlocks,
+		VaultPubKey: pubkey,
+	}), IsNil)
+	e.unstuckAction()
+	items, err = e.evmScanner.blockMetaAccessor.GetSignedTxItems()
+	c.Assert(err, IsNil)
+	c.Assert(items, HasLen, 0)
+}
@@ -1,14 +1,16 @@
 //go:build !testnet && !mocknet && !stagenet
 // +build !testnet,!mocknet,!stagenet
 
-package ethereum
+package evm
 
 import (
 	"gitlab.com/thorchain/thornode/common"
 )
 
-var whitelistSmartContractAddress = append(
-	[]common.Address{
+// whitelistSmartContractAddress contains the contracts allowed to call the router on
+// behalf of a user in addition to the dex aggregators of the chain.
+var whitelistSmartContractAddress = map[common.Chain][]common.Address{
+	common.ETHChain: {
 		// XRUNE
 		common.Address(`0x69fa0feE221AD11012BAb0FdB45d444D3D2Ce71c`),
 		// THORSwap Faucet
@@ -18,5 +20,4 @@ var whitelistSmartContractAddress = append(
 		// THORSwap Gnosis Safe
 		common.Address(`0x7D8911eB1C72F0Ba29302bE30301B75Cec81F622`),
 	},
-	LatestAggregatorContracts()...,
-)
+}
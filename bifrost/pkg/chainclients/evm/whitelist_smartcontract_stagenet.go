@@ -1,14 +1,16 @@
 //go:build stagenet
 // +build stagenet
 
-package ethereum
+package evm
 
 import (
 	"gitlab.com/thorchain/thornode/common"
 )
 
-var whitelistSmartContractAddress = append(
-	[]common.Address{
+// whitelistSmartContractAddress contains the contracts allowed to call the router on
+// behalf of a user in addition to the dex aggregators of the chain.
+var whitelistSmartContractAddress = map[common.Chain][]common.Address{
+	common.ETHChain: {
 		// XRUNE
 		common.Address(`0x69fa0feE221AD11012BAb0FdB45d444D3D2Ce71c`),
 		// THORSwap Faucet
@@ -16,5 +18,4 @@ var whitelistSmartContractAddress = append(
 		// RangoV1
 		common.Address(`0x0e3EB2eAB0e524b69C79E24910f4318dB46bAa9c`),
 	},
-	LatestAggregatorContracts()...,
-)
+}
@@ -1,18 +1,19 @@
 //go:build testnet || mocknet
 // +build testnet mocknet
 
-package ethereum
+package evm
 
 import (
 	"gitlab.com/thorchain/thornode/common"
 )
 
-var whitelistSmartContractAddress = append(
-	[]common.Address{
+// whitelistSmartContractAddress contains the contracts allowed to call the router on
+// behalf of a user in addition to the dex aggregators of the chain.
+var whitelistSmartContractAddress = map[common.Chain][]common.Address{
+	common.ETHChain: {
 		// THORSwap Faucet
 		common.Address(`0x83b0c5136790dDf6cA8D3fb3d220C757e0a91fBe`),
 		// RangoV1
 		common.Address(`0x0e81E5F5555Ece18b5F86F52a736476790eEF82d`),
 	},
-	LatestAggregatorContracts()...,
-)
+}
@@ -22,7 +22,6 @@ import (
 	"github.com/rs/zerolog/log"
 	"github.com/tendermint/tendermint/crypto/tmhash"
 	tmtypes "github.com/tendermint/tendermint/proto/tendermint/types"
-	"google.golang.org/grpc"
 
 	"gitlab.com/thorchain/thornode/bifrost/blockscanner"
 	"gitlab.com/thorchain/thornode/bifrost/metrics"
@@ -72,7 +71,7 @@ type CosmosBlockScanner struct {
 	txConfig         client.TxConfig
 	txService        *rpcclient.HTTP
 	tmService        tmservice.ServiceClient
-	grpc             *grpc.ClientConn
+	grpc             grpcClientConn
 	bridge           thorclient.ThorchainBridge
 	solvencyReporter SolvencyReporter
 
@@ -116,7 +115,8 @@ func NewCosmosBlockScanner(cfg config.BifrostBlockScannerConfiguration,
 	btypes.RegisterInterfaces(registry)
 	cdc := codec.NewProtoCodec(registry)
 
-	grpcConn, err := getGRPCConn(cfg.CosmosGRPCHost, cfg.CosmosGRPCTLS)
+	grpcHosts := append([]string{cfg.CosmosGRPCHost}, cfg.CosmosGRPCHosts...)
+	grpcConn, err := getGRPCConn(cfg.ChainID, grpcHosts, cfg.CosmosGRPCTLS, cfg.RPCQuorum)
 	if err != nil {
 		logger.Fatal().Err(err).Msg("fail to create grpc connection")
 	}
@@ -125,7 +125,7 @@ func NewCosmosBlockScanner(cfg config.BifrostBlockScannerConfiguration,
 	marshaler := codec.NewProtoCodec(registry)
 	txConfig := tx.NewTxConfig(marshaler, []signingtypes.SignMode{signingtypes.SignMode_SIGN_MODE_DIRECT})
 	tmService := tmservice.NewServiceClient(grpcConn)
-	rpcClient, err := rpcclient.New(cfg.RPCHost, "/websocket")
+	rpcClient, err := getRPCClient(cfg.ChainID, append([]string{cfg.RPCHost}, cfg.RPCHosts...), cfg.RPCQuorum)
 	if err != nil {
 		logger.Fatal().Err(err).Msg("fail to create tendemrint rpcclient")
 	}
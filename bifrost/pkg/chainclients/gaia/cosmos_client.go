@@ -110,7 +110,8 @@ func NewCosmosClient(
 		pubkey:  pk,
 	}
 
-	grpcConn, err := getGRPCConn(cfg.CosmosGRPCHost, cfg.CosmosGRPCTLS)
+	grpcHosts := append([]string{cfg.CosmosGRPCHost}, cfg.CosmosGRPCHosts...)
+	grpcConn, err := getGRPCConn(cfg.ChainID, grpcHosts, cfg.CosmosGRPCTLS, cfg.RPCQuorum)
 	if err != nil {
 		return nil, fmt.Errorf("fail to create grpc connection,err: %w", err)
 	}
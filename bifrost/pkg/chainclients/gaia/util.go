@@ -12,6 +12,8 @@ import (
 	btypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	"github.com/gogo/protobuf/jsonpb"
 	"github.com/gogo/protobuf/proto"
+	rpcclient "github.com/tendermint/tendermint/rpc/client/http"
+	"gitlab.com/thorchain/thornode/bifrost/pkg/chainclients/shared/failover"
 	"gitlab.com/thorchain/thornode/common"
 	"gitlab.com/thorchain/thornode/common/cosmos"
 	grpc "google.golang.org/grpc"
@@ -113,20 +115,58 @@ func fromThorchainToCosmos(coin common.Coin) (cosmos.Coin, error) {
 	return cosmos.NewCoin(asset.CosmosDenom, ctypes.NewIntFromBigInt(amount)), nil
 }
 
-func getGRPCConn(host string, tls bool) (*grpc.ClientConn, error) {
+// grpcClientConn is a gRPC connection to one or more hosts
+type grpcClientConn interface {
+	grpc.ClientConnInterface
+	Close() error
+}
+
+// getGRPCConn returns a connection failing over between the given gRPC hosts, the
+// heights and blocks are cross checked across two hosts if quorum is enabled. A single
+// host is dialed directly.
+func getGRPCConn(chain common.Chain, hosts []string, tls, quorum bool) (grpcClientConn, error) {
 	// load system certificates or proceed with insecure if tls disabled
 	var creds credentials.TransportCredentials
 	if tls {
 		certs, err := x509.SystemCertPool()
 		if err != nil {
-			return &grpc.ClientConn{}, fmt.Errorf("unable to load system certs: %w", err)
+			return nil, fmt.Errorf("unable to load system certs: %w", err)
 		}
 		creds = credentials.NewClientTLSFromCert(certs, "")
 	} else {
 		creds = insecure.NewCredentials()
 	}
 
-	return grpc.Dial(host, grpc.WithTransportCredentials(creds))
+	if len(hosts) == 1 {
+		return grpc.Dial(hosts[0], grpc.WithTransportCredentials(creds))
+	}
+	pool, err := failover.NewPool(chain, hosts...)
+	if err != nil {
+		return nil, fmt.Errorf("fail to create grpc endpoint pool: %w", err)
+	}
+	opts := failover.Options{
+		Quorum:        quorum,
+		QuorumMethods: []string{"/cosmos.base.tendermint.v1beta1.Service/GetBlockByHeight"},
+		HeightMethod:  "/cosmos.base.tendermint.v1beta1.Service/GetLatestBlock",
+	}
+	return failover.DialGRPC(pool, opts, grpc.WithTransportCredentials(creds))
+}
+
+// getRPCClient returns a tendermint rpc client failing over between the given hosts, the
+// block results are cross checked across two hosts if quorum is enabled
+func getRPCClient(chain common.Chain, hosts []string, quorum bool) (*rpcclient.HTTP, error) {
+	if len(hosts) == 1 {
+		return rpcclient.New(hosts[0], "/websocket")
+	}
+	pool, err := failover.NewPool(chain, hosts...)
+	if err != nil {
+		return nil, fmt.Errorf("fail to create rpc endpoint pool: %w", err)
+	}
+	httpClient := failover.NewHTTPClient(pool, failover.Options{
+		Quorum:        quorum,
+		QuorumMethods: []string{"block_results"},
+	})
+	return rpcclient.NewWithClient(pool.Primary().String(), "/websocket", httpClient)
 }
 
 func unmarshalJSONToPb(filePath string, msg proto.Message) error {
@@ -189,7 +189,7 @@ func (s *LitecoinSuite) TestGetBlock(c *C) {
 }
 
 func (s *LitecoinSuite) TestFetchTxs(c *C) {
-	txs, err := s.client.FetchTxs(0, 0)
+	txs, err := s.client.utxoClient.FetchTxs(0, 0)
 	c.Assert(err, IsNil)
 	c.Assert(txs.Chain, Equals, common.LTCChain)
 	c.Assert(txs.Count, Equals, "102")
@@ -781,115 +781,17 @@ func (s *LitecoinSuite) TestOnObservedTxIn(c *C) {
 	c.Assert(blockMeta, NotNil)
 }
 
-func (s *LitecoinSuite) TestProcessReOrg(c *C) {
-	// can't get previous block meta should not error
-	var result btcjson.GetBlockVerboseTxResult
-	blockContent, err := os.ReadFile("../../../../test/fixtures/ltc/block.json")
-	c.Assert(err, IsNil)
-	c.Assert(json.Unmarshal(blockContent, &result), IsNil)
-	// should not trigger re-org process
-	reOrgedTxIns, err := s.client.processReorg(&result)
-	c.Assert(err, IsNil)
-	c.Assert(reOrgedTxIns, IsNil)
-	// add one UTXO which will trigger the re-org process next
-	previousHeight := result.Height - 1
-	blockMeta := utxo.NewBlockMeta(ttypes.GetRandomTxHash().String(), previousHeight, ttypes.GetRandomTxHash().String())
-	hash := "27de3e1865c098cd4fded71bae1e8236fd27ce5dce6e524a9ac5cd1a17b5c241"
-	blockMeta.AddCustomerTransaction(hash)
-	c.Assert(s.client.temporalStorage.SaveBlockMeta(previousHeight, blockMeta), IsNil)
-	s.client.globalErrataQueue = make(chan types.ErrataBlock, 1)
-	reOrgedTxIns, err = s.client.processReorg(&result)
-	c.Assert(err, IsNil)
-	c.Assert(reOrgedTxIns, NotNil)
-	// make sure there is errata block in the queue
-	c.Assert(s.client.globalErrataQueue, HasLen, 1)
-	blockMeta, err = s.client.temporalStorage.GetBlockMeta(previousHeight)
-	c.Assert(err, IsNil)
-	c.Assert(blockMeta, NotNil)
-}
-
 func (s *LitecoinSuite) TestGetMemPool(c *C) {
-	txIns, err := s.client.getMemPool(1024)
+	txIns, err := s.client.utxoClient.FetchMemPool(1024)
 	c.Assert(err, IsNil)
 	c.Assert(txIns.TxArray, HasLen, 1)
 
 	// process it again , the tx will be ignored
-	txIns, err = s.client.getMemPool(1024)
+	txIns, err = s.client.utxoClient.FetchMemPool(1024)
 	c.Assert(err, IsNil)
 	c.Assert(txIns.TxArray, HasLen, 0)
 }
 
-func (s *LitecoinSuite) TestConfirmationCountReady(c *C) {
-	c.Assert(s.client.ConfirmationCountReady(types.TxIn{
-		Chain:    common.LTCChain,
-		TxArray:  nil,
-		Filtered: true,
-		MemPool:  false,
-	}), Equals, true)
-	pkey := ttypes.GetRandomPubKey()
-	c.Assert(s.client.ConfirmationCountReady(types.TxIn{
-		Chain: common.LTCChain,
-		TxArray: []types.TxInItem{
-			{
-				BlockHeight: 2,
-				Tx:          "24ed2d26fd5d4e0e8fa86633e40faf1bdfc8d1903b1cd02855286312d48818a2",
-				Sender:      "ltc1q2gjc0rnhy4nrxvuklk6ptwkcs9kcr59mursyaz",
-				To:          "ltc1qjw8h4l3dtz5xxc7uyh5ys70qkezspgfu8hg5j3",
-				Coins: common.Coins{
-					common.NewCoin(common.LTCAsset, cosmos.NewUint(123456)),
-				},
-				Memo:                "MEMO",
-				ObservedVaultPubKey: pkey,
-			},
-		},
-		Filtered: true,
-		MemPool:  true,
-	}), Equals, true)
-	s.client.currentBlockHeight.Store(3)
-	c.Assert(s.client.ConfirmationCountReady(types.TxIn{
-		Chain: common.LTCChain,
-		TxArray: []types.TxInItem{
-			{
-				BlockHeight: 2,
-				Tx:          "24ed2d26fd5d4e0e8fa86633e40faf1bdfc8d1903b1cd02855286312d48818a2",
-				Sender:      "ltc1q2gjc0rnhy4nrxvuklk6ptwkcs9kcr59mursyaz",
-				To:          "ltc1qjw8h4l3dtz5xxc7uyh5ys70qkezspgfu8hg5j3",
-				Coins: common.Coins{
-					common.NewCoin(common.LTCAsset, cosmos.NewUint(123456)),
-				},
-				Memo:                "MEMO",
-				ObservedVaultPubKey: pkey,
-			},
-		},
-		Filtered:             true,
-		MemPool:              false,
-		ConfirmationRequired: 0,
-	}), Equals, true)
-
-	c.Assert(s.client.ConfirmationCountReady(types.TxIn{
-		Chain: common.LTCChain,
-		TxArray: []types.TxInItem{
-			{
-				BlockHeight: 2,
-				Tx:          "24ed2d26fd5d4e0e8fa86633e40faf1bdfc8d1903b1cd02855286312d48818a2",
-				Sender:      "ltc1q2gjc0rnhy4nrxvuklk6ptwkcs9kcr59mursyaz",
-				To:          "ltc1qjw8h4l3dtz5xxc7uyh5ys70qkezspgfu8hg5j3",
-				Coins: common.Coins{
-					common.NewCoin(common.LTCAsset, cosmos.NewUint(12345600000)),
-				},
-				Gas: common.Gas{
-					common.NewCoin(common.LTCAsset, cosmos.NewUint(40000)),
-				},
-				Memo:                "MEMO",
-				ObservedVaultPubKey: pkey,
-			},
-		},
-		Filtered:             true,
-		MemPool:              false,
-		ConfirmationRequired: 5,
-	}), Equals, false)
-}
-
 func (s *LitecoinSuite) TestGetConfirmationCount(c *C) {
 	pkey := ttypes.GetRandomPubKey()
 	// no tx in item , confirmation count should be 0
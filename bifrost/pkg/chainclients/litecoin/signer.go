@@ -3,21 +3,14 @@ package litecoin
 import (
 	"bytes"
 	"encoding/hex"
-	"encoding/json"
-	"errors"
 	"fmt"
-	"math"
-	"sort"
 	"strings"
-	"sync"
 
 	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
-	"github.com/hashicorp/go-multierror"
 	"github.com/ltcsuite/ltcd/btcec"
 	"github.com/ltcsuite/ltcd/btcjson"
 	"github.com/ltcsuite/ltcd/chaincfg"
 	"github.com/ltcsuite/ltcd/chaincfg/chainhash"
-	"github.com/ltcsuite/ltcd/mempool"
 	"github.com/ltcsuite/ltcd/wire"
 	"github.com/ltcsuite/ltcutil"
 	txscript "gitlab.com/thorchain/bifrost/ltcd-txscript"
@@ -27,16 +20,12 @@ import (
 	"gitlab.com/thorchain/thornode/common"
 	"gitlab.com/thorchain/thornode/common/cosmos"
 	mem "gitlab.com/thorchain/thornode/x/thorchain/memo"
-	"gitlab.com/thorchain/thornode/x/thorchain/types"
 )
 
 const (
 	// SatsPervBytes it should be enough , this one will only be used if signer can't find any previous UTXO , and fee info from local storage.
-	SatsPervBytes = 25
-	// MinUTXOConfirmation UTXO that has less confirmation then this will not be spent , unless it is yggdrasil
-	MinUTXOConfirmation  = 1
+	SatsPervBytes        = 25
 	defaultMaxLTCFeeRate = ltcutil.SatoshiPerBitcoin / 10
-	maxUTXOsToSpend      = 10
 )
 
 func getLTCPrivateKey(key cryptotypes.PrivKey) (*btcec.PrivateKey, error) {
@@ -84,111 +73,6 @@ func (c *Client) getGasCoin(tx stypes.TxOutItem, vSize int64) common.Coin {
 	return common.NewCoin(common.LTCAsset, cosmos.NewUint(uint64(gasRate*vSize)))
 }
 
-// isYggdrasil - when the pubkey and node pubkey is the same that means it is signing from yggdrasil
-func (c *Client) isYggdrasil(key common.PubKey) bool {
-	return key.Equals(c.nodePubKey)
-}
-
-func (c *Client) getMaximumUtxosToSpend() int64 {
-	const mimirMaxUTXOsToSpend = `MaxUTXOsToSpend`
-	utxosToSpend, err := c.bridge.GetMimir(mimirMaxUTXOsToSpend)
-	if err != nil {
-		c.logger.Err(err).Msg("fail to get MaxUTXOsToSpend")
-	}
-	if utxosToSpend <= 0 {
-		utxosToSpend = maxUTXOsToSpend
-	}
-	return utxosToSpend
-}
-
-// getAllUtxos go through all the block meta in the local storage, it will spend all UTXOs in  block that might be evicted from local storage soon
-// it also try to spend enough UTXOs that can add up to more than the given total
-func (c *Client) getUtxoToSpend(pubKey common.PubKey, total float64) ([]btcjson.ListUnspentResult, error) {
-	var result []btcjson.ListUnspentResult
-	minConfirmation := 0
-	utxosToSpend := c.getMaximumUtxosToSpend()
-	// Yggdrasil vault is funded by asgard , which will only spend UTXO that is older than 10 blocks, so yggdrasil doesn't need
-	// to do the same logic
-	isYggdrasil := c.isYggdrasil(pubKey)
-	utxos, err := c.getUTXOs(minConfirmation, MaximumConfirmation, pubKey)
-	if err != nil {
-		return nil, fmt.Errorf("fail to get UTXOs: %w", err)
-	}
-	// spend UTXO older to younger
-	sort.SliceStable(utxos, func(i, j int) bool {
-		if utxos[i].Confirmations > utxos[j].Confirmations {
-			return true
-		} else if utxos[i].Confirmations < utxos[j].Confirmations {
-			return false
-		}
-		return utxos[i].TxID < utxos[j].TxID
-	})
-	var toSpend float64
-	minUTXOAmt := ltcutil.Amount(c.chain.DustThreshold().Uint64()).ToBTC()
-	for _, item := range utxos {
-		if !c.isValidUTXO(item.ScriptPubKey) {
-			c.logger.Info().Msgf("invalid UTXO , can't spent it")
-			continue
-		}
-		isSelfTx := c.isSelfTransaction(item.TxID)
-		if item.Confirmations == 0 {
-			// pending tx that is still  in mempool, only count yggdrasil send to itself or from asgard
-			if !c.isSelfTransaction(item.TxID) && !c.isAsgardAddress(item.Address) {
-				continue
-			}
-		}
-		// when the utxo is signed yggdrasil / asgard , even amount is less than DustThreshold
-		// it is ok to spend it
-		if item.Amount < minUTXOAmt && !isSelfTx && !isYggdrasil {
-			continue
-		}
-		if isYggdrasil || item.Confirmations >= MinUTXOConfirmation || isSelfTx {
-			result = append(result, item)
-			toSpend += item.Amount
-		}
-		// in the scenario that there are too many unspent utxos available, make sure it doesn't spend too much
-		// as too much UTXO will cause huge pressure on TSS, also make sure it will spend at least maxUTXOsToSpend
-		// so the UTXOs will be consolidated
-		if int64(len(result)) >= utxosToSpend && toSpend >= total {
-			break
-		}
-	}
-	return result, nil
-}
-
-// isSelfTransaction check the block meta to see whether the transactions is broadcast by ourselves
-// if the transaction is broadcast by ourselves, then we should be able to spend the UTXO even it is still in mempool
-// as such we could daisy chain the outbound transaction
-func (c *Client) isSelfTransaction(txID string) bool {
-	bms, err := c.temporalStorage.GetBlockMetas()
-	if err != nil {
-		c.logger.Err(err).Msg("fail to get block metas")
-		return false
-	}
-	for _, item := range bms {
-		for _, tx := range item.SelfTransactions {
-			if strings.EqualFold(tx, txID) {
-				c.logger.Debug().Msgf("%s is self transaction", txID)
-				return true
-			}
-		}
-	}
-	return false
-}
-
-func (c *Client) getBlockHeight() (int64, error) {
-	hash, err := c.client.GetBestBlockHash()
-	if err != nil {
-		return 0, fmt.Errorf("fail to get best block hash: %w", err)
-	}
-	blockInfo, err := c.client.GetBlockVerbose(hash)
-	if err != nil {
-		return 0, fmt.Errorf("fail to get the best block detail: %w", err)
-	}
-
-	return blockInfo.Height, nil
-}
-
 func (c *Client) getLTCPaymentAmount(tx stypes.TxOutItem) float64 {
 	amtToPay := tx.Coins.GetCoin(common.LTCAsset).Amount.Uint64()
 	amtToPayInLTC := ltcutil.Amount(int64(amtToPay)).ToBTC()
@@ -216,7 +100,7 @@ func (c *Client) getSourceScript(tx stypes.TxOutItem) ([]byte, error) {
 // estimateTxSize will create a temporary MsgTx, and use it to estimate the final tx size
 // the value in the temporary MsgTx is not real
 // https://bitcoinops.org/en/tools/calc-size/
-func (c *Client) estimateTxSize(memo string, txes []btcjson.ListUnspentResult) int64 {
+func (c *Client) estimateTxSize(memo string, txes []utxo.UnspentOutput) int64 {
 	// overhead - 10.75
 	// Per Input - 67.75
 	// Per output - 31 , we sometimes have 2 output , and sometimes only have 1 , it depends ,here we only count 1
@@ -229,7 +113,7 @@ func (c *Client) estimateTxSize(memo string, txes []btcjson.ListUnspentResult) i
 }
 
 func (c *Client) buildTx(tx stypes.TxOutItem, sourceScript []byte) (*wire.MsgTx, map[string]int64, error) {
-	txes, err := c.getUtxoToSpend(tx.VaultPubKey, c.getLTCPaymentAmount(tx))
+	txes, err := c.utxoClient.GetUtxoToSpend(tx.VaultPubKey, c.getLTCPaymentAmount(tx))
 	if err != nil {
 		return nil, nil, fmt.Errorf("fail to get unspent UTXO")
 	}
@@ -347,152 +231,107 @@ func (c *Client) buildTx(tx stypes.TxOutItem, sourceScript []byte) (*wire.MsgTx,
 }
 
 // SignTx builds and signs the outbound transaction. Returns the signed transaction, a
-// serialized checkpoint on error, and an error.
+// serialized checkpoint on error, a corresponding observation tx, and an error.
 func (c *Client) SignTx(tx stypes.TxOutItem, thorchainHeight int64) ([]byte, []byte, *stypes.TxInItem, error) {
-	if !tx.Chain.Equals(common.LTCChain) {
-		return nil, nil, nil, errors.New("not LTC chain")
-	}
+	return c.utxoClient.SignTx(tx, thorchainHeight)
+}
 
-	// skip outbounds without coins
-	if tx.Coins.IsEmpty() {
-		return nil, nil, nil, nil
+// CheckToAddress returns an error when the given address can't be decoded, and false
+// when it can't roundtrip or is an address pubkey, which should not be used
+func (c *Client) CheckToAddress(addr common.Address) (bool, error) {
+	outputAddr, err := ltcutil.DecodeAddress(addr.String(), c.getChainCfg())
+	if err != nil {
+		return false, fmt.Errorf("fail to decode next address: %w", err)
 	}
-
-	// skip outbounds that have been signed
-	if c.signerCacheManager.HasSigned(tx.CacheHash()) {
-		c.logger.Info().Msgf("transaction(%+v), signed before , ignore", tx)
-		return nil, nil, nil, nil
+	if !strings.EqualFold(outputAddr.String(), addr.String()) {
+		c.logger.Info().Msgf("output address: %s, to address: %s can't roundtrip", outputAddr.String(), addr.String())
+		return false, nil
 	}
-
-	// only one keysign per chain at a time
-	vaultSignerLock := c.getVaultSignerLock(tx.VaultPubKey.String())
-	if vaultSignerLock == nil {
-		c.logger.Error().Msgf("fail to get signer lock for vault pub key: %s", tx.VaultPubKey.String())
-		return nil, nil, nil, fmt.Errorf("fail to get signer lock")
+	switch outputAddr.(type) {
+	case *ltcutil.AddressPubKey:
+		c.logger.Info().Msgf("address: %s is address pubkey type, should not be used", outputAddr)
+		return false, nil
+	default: // keep lint happy
 	}
-	vaultSignerLock.Lock()
-	defer vaultSignerLock.Unlock()
+	return true, nil
+}
 
+// BuildTx builds the unsigned transaction of the given outbound
+func (c *Client) BuildTx(tx stypes.TxOutItem) (utxo.SignableTx, map[string]int64, error) {
 	sourceScript, err := c.getSourceScript(tx)
 	if err != nil {
-		return nil, nil, nil, fmt.Errorf("fail to get source pay to address script: %w", err)
+		return nil, nil, fmt.Errorf("fail to get source pay to address script: %w", err)
+	}
+	redeemTx, individualAmounts, err := c.buildTx(tx, sourceScript)
+	if err != nil {
+		return nil, nil, err
 	}
+	return c.newSignableTx(redeemTx, tx, sourceScript), individualAmounts, nil
+}
 
-	// verify output address
-	outputAddr, err := ltcutil.DecodeAddress(tx.ToAddress.String(), c.getChainCfg())
+// DecodeTx decodes the unsigned transaction of the given outbound from a checkpoint
+func (c *Client) DecodeTx(tx stypes.TxOutItem, unsignedTx []byte) (utxo.SignableTx, error) {
+	sourceScript, err := c.getSourceScript(tx)
 	if err != nil {
-		return nil, nil, nil, fmt.Errorf("fail to decode next address: %w", err)
+		return nil, fmt.Errorf("fail to get source pay to address script: %w", err)
 	}
-	if !strings.EqualFold(outputAddr.String(), tx.ToAddress.String()) {
-		c.logger.Info().Msgf("output address: %s, to address: %s can't roundtrip", outputAddr.String(), tx.ToAddress.String())
-		return nil, nil, nil, nil
+	redeemTx := &wire.MsgTx{}
+	if err := redeemTx.Deserialize(bytes.NewReader(unsignedTx)); err != nil {
+		return nil, err
 	}
-	switch outputAddr.(type) {
-	case *ltcutil.AddressPubKey:
-		c.logger.Info().Msgf("address: %s is address pubkey type, should not be used", outputAddr)
-		return nil, nil, nil, nil
-	default: // keep lint happy
+	return c.newSignableTx(redeemTx, tx, sourceScript), nil
+}
+
+// signableTx is a litecoin transaction whose inputs are signed by the vault of the outbound
+type signableTx struct {
+	client       *Client
+	redeemTx     *wire.MsgTx
+	tx           stypes.TxOutItem
+	sourceScript []byte
+}
+
+func (c *Client) newSignableTx(redeemTx *wire.MsgTx, tx stypes.TxOutItem, sourceScript []byte) *signableTx {
+	return &signableTx{
+		client:       c,
+		redeemTx:     redeemTx,
+		tx:           tx,
+		sourceScript: sourceScript,
+	}
+}
+
+func (t *signableTx) Inputs() []string {
+	inputs := make([]string, len(t.redeemTx.TxIn))
+	for idx, txIn := range t.redeemTx.TxIn {
+		inputs[idx] = fmt.Sprintf("%s-%d", txIn.PreviousOutPoint.Hash, txIn.PreviousOutPoint.Index)
 	}
+	return inputs
+}
 
-	// load from checkpoint if it exists
-	checkpoint := utxo.SignCheckpoint{}
-	redeemTx := &wire.MsgTx{}
-	if tx.Checkpoint != nil {
-		if err := json.Unmarshal(tx.Checkpoint, &checkpoint); err != nil {
-			return nil, nil, nil, fmt.Errorf("fail to unmarshal checkpoint: %w", err)
-		}
-		if err := redeemTx.Deserialize(bytes.NewReader(checkpoint.UnsignedTx)); err != nil {
-			return nil, nil, nil, fmt.Errorf("fail to deserialize tx: %w", err)
-		}
-	} else {
-		redeemTx, checkpoint.IndividualAmounts, err = c.buildTx(tx, sourceScript)
-		if err != nil {
-			return nil, nil, nil, err
-		}
-		buf := bytes.NewBuffer([]byte{})
-		err = redeemTx.Serialize(buf)
-		if err != nil {
-			return nil, nil, nil, fmt.Errorf("fail to serialize tx: %w", err)
-		}
-		checkpoint.UnsignedTx = buf.Bytes()
+func (t *signableTx) SignInput(idx int, amount int64) error {
+	return t.client.signUTXO(t.redeemTx, t.tx, amount, t.sourceScript, idx)
+}
+
+func (t *signableTx) Outputs() []int64 {
+	outputs := make([]int64, len(t.redeemTx.TxOut))
+	for idx, txOut := range t.redeemTx.TxOut {
+		outputs[idx] = txOut.Value
 	}
+	return outputs
+}
 
-	// serialize the checkpoint for later
-	checkpointBytes, err := json.Marshal(checkpoint)
-	if err != nil {
-		return nil, nil, nil, fmt.Errorf("fail to marshal checkpoint: %w", err)
-	}
-
-	wg := &sync.WaitGroup{}
-	var utxoErr error
-	c.logger.Info().Msgf("UTXOs to sign: %d", len(redeemTx.TxIn))
-
-	totalAmount := int64(0)
-	for idx, txIn := range redeemTx.TxIn {
-		key := fmt.Sprintf("%s-%d", txIn.PreviousOutPoint.Hash, txIn.PreviousOutPoint.Index)
-		outputAmount := checkpoint.IndividualAmounts[key]
-		totalAmount += outputAmount
-		wg.Add(1)
-		go func(i int, amount int64) {
-			defer wg.Done()
-			if err := c.signUTXO(redeemTx, tx, amount, sourceScript, i, thorchainHeight); err != nil {
-				if nil == utxoErr {
-					utxoErr = err
-				} else {
-					utxoErr = multierror.Append(utxoErr, err)
-				}
-			}
-		}(idx, outputAmount)
-	}
-	wg.Wait()
-	if utxoErr != nil {
-		err = utxo.PostKeysignFailure(c.bridge, tx, c.logger, thorchainHeight, utxoErr)
-		return nil, checkpointBytes, nil, fmt.Errorf("fail to sign the message: %w", err)
-	}
-	finalSize := redeemTx.SerializeSize()
-	finalVBytes := mempool.GetTxVirtualSize(ltcutil.NewTx(redeemTx))
-	c.logger.Info().Msgf("final size: %d, final vbyte: %d", finalSize, finalVBytes)
-	var signedTx bytes.Buffer
-	if err := redeemTx.Serialize(&signedTx); err != nil {
-		return nil, nil, nil, fmt.Errorf("fail to serialize tx to bytes: %w", err)
-	}
-
-	// create the observation to be sent by the signer before broadcast
-	chainHeight, err := c.getBlockHeight()
-	if err != nil { // fall back to the scanner height, thornode voter does not use height
-		chainHeight = c.currentBlockHeight.Load()
-	}
-	amt := redeemTx.TxOut[0].Value // the first output is the outbound amount
-	gas := totalAmount
-	for _, txOut := range redeemTx.TxOut { // subtract all vouts to from vins to get the gas
-		gas -= txOut.Value
-	}
-	var txIn *stypes.TxInItem
-	sender, err := tx.VaultPubKey.GetAddress(tx.Chain)
-	if err == nil {
-		txIn = stypes.NewTxInItem(
-			chainHeight+1,
-			redeemTx.TxHash().String(),
-			tx.Memo,
-			sender.String(),
-			tx.ToAddress.String(),
-			common.NewCoins(
-				common.NewCoin(c.chain.GetGasAsset(), cosmos.NewUint(uint64(amt))),
-			),
-			common.Gas(common.NewCoins(
-				common.NewCoin(c.chain.GetGasAsset(), cosmos.NewUint(uint64(gas))),
-			)),
-			tx.VaultPubKey,
-			"",
-			"",
-			nil,
-		)
-	}
-
-	return signedTx.Bytes(), nil, txIn, nil
+func (t *signableTx) TxID() string {
+	return t.redeemTx.TxHash().String()
 }
 
-func (c *Client) signUTXO(redeemTx *wire.MsgTx, tx stypes.TxOutItem, amount int64, sourceScript []byte, idx int, thorchainHeight int64) error {
+func (t *signableTx) Serialize() ([]byte, error) {
+	var buf bytes.Buffer
+	if err := t.redeemTx.Serialize(&buf); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+func (c *Client) signUTXO(redeemTx *wire.MsgTx, tx stypes.TxOutItem, amount int64, sourceScript []byte, idx int) error {
 	sigHashes := txscript.NewTxSigHashes(redeemTx)
 	sig := c.ksWrapper.GetSignable(tx.VaultPubKey)
 	witness, err := txscript.WitnessSignature(redeemTx, sigHashes, idx, amount, sourceScript, txscript.SigHashAll, sig, true)
@@ -514,50 +353,22 @@ func (c *Client) signUTXO(redeemTx *wire.MsgTx, tx stypes.TxOutItem, amount int6
 
 // BroadcastTx will broadcast the given payload to LTC chain
 func (c *Client) BroadcastTx(txOut stypes.TxOutItem, payload []byte) (string, error) {
+	return c.utxoClient.BroadcastTx(txOut, payload)
+}
+
+// SendRawTransaction broadcasts the signed payload to LTC chain
+func (c *Client) SendRawTransaction(payload []byte) (string, error) {
 	redeemTx := wire.NewMsgTx(wire.TxVersion)
 	buf := bytes.NewBuffer(payload)
 	if err := redeemTx.Deserialize(buf); err != nil {
 		return "", fmt.Errorf("fail to deserialize payload: %w", err)
 	}
-
-	height, err := c.getBlockHeight()
-	if err != nil {
-		return "", fmt.Errorf("fail to get block height: %w", err)
-	}
-	bm, err := c.temporalStorage.GetBlockMeta(height)
-	if err != nil {
-		c.logger.Err(err).Msgf("fail to get blockmeta for heigth: %d", height)
-	}
-	if bm == nil {
-		bm = utxo.NewBlockMeta("", height, "")
-	}
-	defer func() {
-		if err := c.temporalStorage.SaveBlockMeta(height, bm); err != nil {
-			c.logger.Err(err).Msg("fail to save block metadata")
-		}
-	}()
-	// broadcast tx
 	txHash, err := c.sendRawTransaction(redeemTx)
-	if txHash != nil {
-		bm.AddSelfTransaction(txHash.String())
-	}
 	if err != nil {
 		if rpcErr, ok := err.(*btcjson.RPCError); ok && rpcErr.Code == btcjson.ErrRPCTxAlreadyInChain {
-			// this means the tx had been broadcast to chain, it must be another signer finished quicker then us
-			// save tx id to block meta in case we need to errata later
-			c.logger.Info().Str("hash", redeemTx.TxHash().String()).Msg("broadcast to LTC chain by another node")
-			if err := c.signerCacheManager.SetSigned(txOut.CacheHash(), redeemTx.TxHash().String()); err != nil {
-				c.logger.Err(err).Msgf("fail to mark tx out item (%+v) as signed", txOut)
-			}
-			return redeemTx.TxHash().String(), nil
+			return redeemTx.TxHash().String(), utxo.ErrTxAlreadyInChain
 		}
-
-		return "", fmt.Errorf("fail to broadcast transaction to chain: %w", err)
-	}
-	// save tx id to block meta in case we need to errata later
-	c.logger.Info().Str("hash", txHash.String()).Msg("broadcast to LTC chain successfully")
-	if err := c.signerCacheManager.SetSigned(txOut.CacheHash(), txHash.String()); err != nil {
-		c.logger.Err(err).Msgf("fail to mark tx out item (%+v) as signed", txOut)
+		return "", err
 	}
 	return txHash.String(), nil
 }
@@ -591,87 +402,3 @@ func (c *Client) sendRawTransaction(tx *wire.MsgTx) (*chainhash.Hash, error) {
 	}
 	return chainhash.NewHashFromStr(hash)
 }
-
-// consolidateUTXOs only required when there is a new block
-func (c *Client) consolidateUTXOs() {
-	defer func() {
-		c.wg.Done()
-		c.consolidateInProgress.Store(false)
-	}()
-
-	nodeStatus, err := c.bridge.FetchNodeStatus()
-	if err != nil {
-		c.logger.Err(err).Msg("fail to get node status")
-		return
-	}
-	if nodeStatus != types.NodeStatus_Active {
-		c.logger.Info().Msgf("node is not active , doesn't need to consolidate utxos")
-		return
-	}
-	vaults, err := c.bridge.GetAsgards()
-	if err != nil {
-		c.logger.Err(err).Msg("fail to get current asgards")
-		return
-	}
-	utxosToSpend := c.getMaximumUtxosToSpend()
-	for _, vault := range vaults {
-		if !vault.Contains(c.nodePubKey) {
-			// Not part of this vault , don't need to consolidate UTXOs for this Vault
-			continue
-		}
-		// the amount used here doesn't matter , just to see whether there are more than 15 UTXO available or not
-		utxos, err := c.getUtxoToSpend(vault.PubKey, 0.01)
-		if err != nil {
-			c.logger.Err(err).Msg("fail to get utxos to spend")
-			continue
-		}
-		// doesn't have enough UTXOs , don't need to consolidate
-		if int64(len(utxos)) < utxosToSpend {
-			continue
-		}
-		total := 0.0
-		for _, item := range utxos {
-			total += item.Amount
-		}
-		addr, err := vault.PubKey.GetAddress(common.LTCChain)
-		if err != nil {
-			c.logger.Err(err).Msgf("fail to get LTC address for pubkey:%s", vault.PubKey)
-			continue
-		}
-		// THORChain usually pay 1.5 of the last observed fee rate
-		feeRate := math.Ceil(float64(c.lastFeeRate) * 3 / 2)
-		amt, err := ltcutil.NewAmount(total)
-		if err != nil {
-			c.logger.Err(err).Msgf("fail to convert to BTC amount: %f", total)
-			continue
-		}
-
-		txOutItem := stypes.TxOutItem{
-			Chain:       common.LTCChain,
-			ToAddress:   addr,
-			VaultPubKey: vault.PubKey,
-			Coins: common.Coins{
-				common.NewCoin(common.LTCAsset, cosmos.NewUint(uint64(amt))),
-			},
-			Memo:    "consolidate",
-			MaxGas:  nil,
-			GasRate: int64(feeRate),
-		}
-		height, err := c.bridge.GetBlockHeight()
-		if err != nil {
-			c.logger.Err(err).Msg("fail to get THORChain block height")
-			continue
-		}
-		rawTx, _, _, err := c.SignTx(txOutItem, height)
-		if err != nil {
-			c.logger.Err(err).Msg("fail to sign consolidate txout item")
-			continue
-		}
-		txID, err := c.BroadcastTx(txOutItem, rawTx)
-		if err != nil {
-			c.logger.Err(err).Msg("fail to broadcast consolidate tx")
-			continue
-		}
-		c.logger.Info().Msgf("broadcast consolidate tx successfully,hash:%s", txID)
-	}
-}
@@ -1,4 +1,5 @@
-package utxo
+// Package asgard contains helpers shared by the chain clients to look up the asgard vaults.
+package asgard
 
 import (
 	"fmt"
@@ -7,7 +8,9 @@ import (
 	"gitlab.com/thorchain/thornode/common"
 )
 
-func GetAsgardAddress(chain common.Chain, maxAsgardAddresses int, bridge thorclient.ThorchainBridge) ([]common.Address, error) {
+// GetAddresses returns the addresses of the asgard vaults on the given chain, up to
+// maxAsgardAddresses of them.
+func GetAddresses(chain common.Chain, maxAsgardAddresses int, bridge thorclient.ThorchainBridge) ([]common.Address, error) {
 	vaults, err := bridge.GetAsgardPubKeys()
 	if err != nil {
 		return nil, fmt.Errorf("fail to get asgards : %w", err)
@@ -28,13 +28,17 @@ func NewEthRPC(host string, timeout time.Duration, chain string) (*EthRPC, error
 	if err != nil {
 		return nil, fmt.Errorf("fail to dial ETH rpc host(%s): %w", host, err)
 	}
+	return NewEthRPCWithClient(host, ethClient, timeout, chain), nil
+}
 
+// NewEthRPCWithClient create a new instance of EthRPC using the given client
+func NewEthRPCWithClient(host string, ethClient *ethclient.Client, timeout time.Duration, chain string) *EthRPC {
 	return &EthRPC{
 		host:    host,
 		client:  ethClient,
 		timeout: timeout,
 		logger:  log.Logger.With().Str("module", "eth_rpc").Str("chain", chain).Logger(),
-	}, nil
+	}
 }
 
 func (e *EthRPC) getContext() (context.Context, context.CancelFunc) {
@@ -119,3 +123,28 @@ func (e *EthRPC) CheckTransaction(hash string) bool {
 	}
 	return true
 }
+
+// GetPendingTransactions returns all executable transactions in the node's txpool, this
+// relies on the txpool_content rpc method being enabled on the node.
+func (e *EthRPC) GetPendingTransactions() ([]*etypes.Transaction, error) {
+	ctx, cancel := e.getContext()
+	defer cancel()
+
+	// pending transactions are keyed by sender address and then nonce
+	var content struct {
+		Pending map[string]map[string]*etypes.Transaction `json:"pending"`
+	}
+	if err := e.client.Client().CallContext(ctx, &content, "txpool_content"); err != nil {
+		return nil, fmt.Errorf("fail to get txpool content: %w", err)
+	}
+
+	txs := make([]*etypes.Transaction, 0)
+	for _, nonceTxs := range content.Pending {
+		for _, tx := range nonceTxs {
+			if tx != nil {
+				txs = append(txs, tx)
+			}
+		}
+	}
+	return txs, nil
+}
@@ -28,7 +28,7 @@ type KeySignWrapper struct {
 	pubKey        common.PubKey
 	tssKeyManager tss.ThorchainKeyManager
 	logger        zerolog.Logger
-	eipSigner     etypes.EIP155Signer
+	eipSigner     etypes.Signer
 }
 
 // NewKeySignWrapper create a new instance of keysign wrapper
@@ -37,7 +37,7 @@ func NewKeySignWrapper(privateKey *ecdsa.PrivateKey, pubKey common.PubKey, keyMa
 		privKey:       privateKey,
 		pubKey:        pubKey,
 		tssKeyManager: keyManager,
-		eipSigner:     etypes.NewEIP155Signer(chainID),
+		eipSigner:     etypes.NewLondonSigner(chainID),
 		logger:        log.With().Str("module", "signer").Str("chain", chain).Logger(),
 	}, nil
 }
@@ -1,6 +1,6 @@
 package types
 
-// ChainID represent Ethereum chain id type
+// ChainID represent EVM chain id type
 type ChainID int
 
 const (
@@ -0,0 +1,234 @@
+package failover
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"reflect"
+	"sync"
+
+	"github.com/gogo/protobuf/proto"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ClientConn is a grpc.ClientConnInterface invoking each call on the healthiest
+// endpoint of the pool and failing over to the next one on error.
+type ClientConn struct {
+	pool  *Pool
+	opts  Options
+	conns map[*url.URL]*grpc.ClientConn
+}
+
+var _ grpc.ClientConnInterface = &ClientConn{}
+
+// DialGRPC creates a connection to each endpoint of the pool
+func DialGRPC(pool *Pool, opts Options, dialOpts ...grpc.DialOption) (*ClientConn, error) {
+	c := &ClientConn{
+		pool:  pool,
+		opts:  opts,
+		conns: make(map[*url.URL]*grpc.ClientConn),
+	}
+	for _, endpoint := range pool.Endpoints() {
+		conn, err := grpc.Dial(endpoint.Host, dialOpts...)
+		if err != nil {
+			_ = c.Close()
+			return nil, fmt.Errorf("fail to dial grpc endpoint(%s): %w", endpoint.Host, err)
+		}
+		c.conns[endpoint] = conn
+	}
+	return c, nil
+}
+
+// Close closes the connections to all endpoints
+func (c *ClientConn) Close() error {
+	var lastErr error
+	for _, conn := range c.conns {
+		if err := conn.Close(); err != nil {
+			lastErr = err
+		}
+	}
+	return lastErr
+}
+
+// isEndpointFailure returns true when the error is caused by the endpoint rather than the
+// request, application errors are returned to the caller without failover
+func isEndpointFailure(err error) bool {
+	switch status.Code(err) {
+	case codes.Unavailable, codes.DeadlineExceeded, codes.ResourceExhausted, codes.Aborted, codes.Internal:
+		return true
+	}
+	return false
+}
+
+// Invoke implements grpc.ClientConnInterface
+func (c *ClientConn) Invoke(ctx context.Context, method string, args, reply interface{}, opts ...grpc.CallOption) error {
+	isHeight := method == c.opts.HeightMethod
+	if isHeight && c.pool.shouldProbe() {
+		go c.probe(method, args, reply)
+	}
+	if c.pool.Len() > 1 && c.opts.isQuorumMethod(method) {
+		return c.quorum(ctx, method, args, reply, isHeight, opts...)
+	}
+
+	var lastErr error
+	for _, endpoint := range c.pool.Endpoints() {
+		err := c.conns[endpoint].Invoke(ctx, method, args, reply, opts...)
+		if err == nil {
+			c.pool.Success(endpoint)
+			return nil
+		}
+		if !isEndpointFailure(err) {
+			return err
+		}
+		c.pool.Failure(endpoint, err)
+		lastErr = err
+		if ctx.Err() != nil {
+			break
+		}
+	}
+	return lastErr
+}
+
+// NewStream implements grpc.ClientConnInterface, failover only applies to opening the stream
+func (c *ClientConn) NewStream(ctx context.Context, desc *grpc.StreamDesc, method string, opts ...grpc.CallOption) (grpc.ClientStream, error) {
+	var lastErr error
+	for _, endpoint := range c.pool.Endpoints() {
+		stream, err := c.conns[endpoint].NewStream(ctx, desc, method, opts...)
+		if err == nil {
+			c.pool.Success(endpoint)
+			return stream, nil
+		}
+		if !isEndpointFailure(err) {
+			return nil, err
+		}
+		c.pool.Failure(endpoint, err)
+		lastErr = err
+		if ctx.Err() != nil {
+			break
+		}
+	}
+	return nil, lastErr
+}
+
+// newReply returns an empty message of the same type as the reply
+func newReply(reply interface{}) interface{} {
+	return reflect.New(reflect.TypeOf(reply).Elem()).Interface()
+}
+
+// getHeight returns the block height of a block reply
+func getHeight(reply interface{}) (int64, bool) {
+	r, ok := reply.(interface{ GetBlock() *tmproto.Block })
+	if !ok || r.GetBlock() == nil {
+		return 0, false
+	}
+	return r.GetBlock().Header.Height, true
+}
+
+// quorum invokes the call on the two healthiest endpoints and only returns the reply when
+// both agree. For the height method the reply with the lower height is returned.
+func (c *ClientConn) quorum(ctx context.Context, method string, args, reply interface{}, isHeight bool, opts ...grpc.CallOption) error {
+	type result struct {
+		endpoint *url.URL
+		reply    interface{}
+	}
+	var results []result
+	var lastErr error
+	endpoints := c.pool.Endpoints()
+	for len(results) < 2 && len(endpoints) > 0 && ctx.Err() == nil {
+		// query as many endpoints in parallel as replies are missing
+		count := 2 - len(results)
+		if count > len(endpoints) {
+			count = len(endpoints)
+		}
+		batch := endpoints[:count]
+		endpoints = endpoints[count:]
+
+		replies := make([]interface{}, len(batch))
+		errs := make([]error, len(batch))
+		wg := &sync.WaitGroup{}
+		for i, endpoint := range batch {
+			replies[i] = newReply(reply)
+			wg.Add(1)
+			go func(i int, endpoint *url.URL) {
+				defer wg.Done()
+				errs[i] = c.conns[endpoint].Invoke(ctx, method, args, replies[i], opts...)
+			}(i, endpoint)
+		}
+		wg.Wait()
+		for i, endpoint := range batch {
+			if errs[i] != nil {
+				if !isEndpointFailure(errs[i]) {
+					return errs[i]
+				}
+				c.pool.Failure(endpoint, errs[i])
+				lastErr = errs[i]
+				continue
+			}
+			c.pool.Success(endpoint)
+			results = append(results, result{endpoint: endpoint, reply: replies[i]})
+		}
+	}
+	if len(results) < 2 {
+		if lastErr == nil {
+			lastErr = ctx.Err()
+		}
+		return fmt.Errorf("fail to get quorum, %d of 2 endpoints responded: %w", len(results), lastErr)
+	}
+
+	chosen := results[0].reply
+	first, second := results[0], results[1]
+	firstHeight, ok1 := getHeight(first.reply)
+	secondHeight, ok2 := getHeight(second.reply)
+	if isHeight && ok1 && ok2 {
+		c.pool.ReportHeight(first.endpoint, firstHeight)
+		c.pool.ReportHeight(second.endpoint, secondHeight)
+		if secondHeight < firstHeight {
+			chosen = second.reply
+		}
+	} else if !equalReply(first.reply, second.reply) {
+		c.pool.logger.Error().
+			Str("first", first.endpoint.Redacted()).
+			Str("second", second.endpoint.Redacted()).
+			Str("method", method).
+			Msg("rpc endpoints returned different results")
+		return fmt.Errorf("%w, method: %s", ErrQuorum, method)
+	}
+	reflect.ValueOf(reply).Elem().Set(reflect.ValueOf(chosen).Elem())
+	return nil
+}
+
+func equalReply(a, b interface{}) bool {
+	first, ok1 := a.(proto.Message)
+	second, ok2 := b.(proto.Message)
+	if ok1 && ok2 {
+		return proto.Equal(first, second)
+	}
+	return reflect.DeepEqual(a, b)
+}
+
+// probe invokes the height method on all endpoints to detect stalled endpoints
+func (c *ClientConn) probe(method string, args, reply interface{}) {
+	ctx, cancel := context.WithTimeout(context.Background(), probeTimeout)
+	defer cancel()
+	wg := &sync.WaitGroup{}
+	for _, endpoint := range c.pool.Endpoints() {
+		wg.Add(1)
+		go func(endpoint *url.URL) {
+			defer wg.Done()
+			probeReply := newReply(reply)
+			if err := c.conns[endpoint].Invoke(ctx, method, args, probeReply); err != nil {
+				if isEndpointFailure(err) {
+					c.pool.Failure(endpoint, err)
+				}
+				return
+			}
+			if height, ok := getHeight(probeReply); ok {
+				c.pool.ReportHeight(endpoint, height)
+			}
+		}(endpoint)
+	}
+	wg.Wait()
+}
@@ -0,0 +1,192 @@
+package failover
+
+import (
+	"context"
+	"errors"
+	"net"
+	"sync/atomic"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/client/grpc/tmservice"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+	. "gopkg.in/check.v1"
+
+	"gitlab.com/thorchain/thornode/common"
+)
+
+const (
+	getLatestBlockMethod   = "/cosmos.base.tendermint.v1beta1.Service/GetLatestBlock"
+	getBlockByHeightMethod = "/cosmos.base.tendermint.v1beta1.Service/GetBlockByHeight"
+)
+
+type GRPCTestSuite struct{}
+
+var _ = Suite(&GRPCTestSuite{})
+
+// tmServer is a tendermint service answering with blocks of the given chain id, or the
+// given error
+type tmServer struct {
+	tmservice.UnimplementedServiceServer
+	chainID string
+	height  int64
+	err     error
+	count   int64
+}
+
+func (s *tmServer) GetLatestBlock(_ context.Context, _ *tmservice.GetLatestBlockRequest) (*tmservice.GetLatestBlockResponse, error) {
+	atomic.AddInt64(&s.count, 1)
+	if s.err != nil {
+		return nil, s.err
+	}
+	return &tmservice.GetLatestBlockResponse{
+		Block: &tmproto.Block{Header: tmproto.Header{ChainID: s.chainID, Height: s.height}},
+	}, nil
+}
+
+func (s *tmServer) GetBlockByHeight(_ context.Context, req *tmservice.GetBlockByHeightRequest) (*tmservice.GetBlockByHeightResponse, error) {
+	atomic.AddInt64(&s.count, 1)
+	if s.err != nil {
+		return nil, s.err
+	}
+	return &tmservice.GetBlockByHeightResponse{
+		Block: &tmproto.Block{Header: tmproto.Header{ChainID: s.chainID, Height: req.Height}},
+	}, nil
+}
+
+func (s *tmServer) calls() int64 {
+	return atomic.LoadInt64(&s.count)
+}
+
+// newGRPCServer serves the tendermint service on a local port and returns its address
+func newGRPCServer(c *C, srv *tmServer) (string, *grpc.Server) {
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	c.Assert(err, IsNil)
+	server := grpc.NewServer()
+	tmservice.RegisterServiceServer(server, srv)
+	go func() { _ = server.Serve(lis) }()
+	return lis.Addr().String(), server
+}
+
+func dialForTest(c *C, opts Options, hosts ...string) (*Pool, *ClientConn) {
+	pool, err := NewPool(common.GAIAChain, hosts...)
+	c.Assert(err, IsNil)
+	conn, err := DialGRPC(pool, opts, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	c.Assert(err, IsNil)
+	return pool, conn
+}
+
+func (s *GRPCTestSuite) TestFailover(c *C) {
+	unhealthy := &tmServer{err: status.Error(codes.Unavailable, "node is syncing")}
+	unhealthyAddr, unhealthyServer := newGRPCServer(c, unhealthy)
+	defer unhealthyServer.Stop()
+	healthy := &tmServer{chainID: "cosmoshub-4"}
+	healthyAddr, healthyServer := newGRPCServer(c, healthy)
+	defer healthyServer.Stop()
+
+	pool, conn := dialForTest(c, Options{}, unhealthyAddr, healthyAddr)
+	defer func() { c.Assert(conn.Close(), IsNil) }()
+	client := tmservice.NewServiceClient(conn)
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	// the unhealthy primary endpoint fails over to the next endpoint
+	resp, err := client.GetBlockByHeight(ctx, &tmservice.GetBlockByHeightRequest{Height: 5})
+	c.Assert(err, IsNil)
+	c.Assert(resp.Block.Header.Height, Equals, int64(5))
+	c.Assert(unhealthy.calls(), Equals, int64(1))
+	c.Assert(healthy.calls(), Equals, int64(1))
+	c.Assert(pool.Endpoints()[0].Host, Equals, healthyAddr)
+
+	// the unhealthy endpoint is skipped while backing off
+	_, err = client.GetBlockByHeight(ctx, &tmservice.GetBlockByHeightRequest{Height: 6})
+	c.Assert(err, IsNil)
+	c.Assert(unhealthy.calls(), Equals, int64(1))
+	c.Assert(healthy.calls(), Equals, int64(2))
+
+	// all endpoints failed
+	healthyServer.Stop()
+	_, err = client.GetBlockByHeight(ctx, &tmservice.GetBlockByHeightRequest{Height: 7})
+	c.Assert(err, NotNil)
+	c.Assert(isEndpointFailure(err), Equals, true)
+}
+
+func (s *GRPCTestSuite) TestApplicationError(c *C) {
+	notFound := &tmServer{err: status.Error(codes.NotFound, "block not found")}
+	notFoundAddr, notFoundServer := newGRPCServer(c, notFound)
+	defer notFoundServer.Stop()
+	healthy := &tmServer{chainID: "cosmoshub-4"}
+	healthyAddr, healthyServer := newGRPCServer(c, healthy)
+	defer healthyServer.Stop()
+
+	pool, conn := dialForTest(c, Options{}, notFoundAddr, healthyAddr)
+	defer func() { c.Assert(conn.Close(), IsNil) }()
+	client := tmservice.NewServiceClient(conn)
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	// application errors are returned to the caller without failover
+	_, err := client.GetBlockByHeight(ctx, &tmservice.GetBlockByHeightRequest{Height: 5})
+	c.Assert(status.Code(err), Equals, codes.NotFound)
+	c.Assert(notFound.calls(), Equals, int64(1))
+	c.Assert(healthy.calls(), Equals, int64(0))
+	c.Assert(pool.get(pool.Endpoints()[0]).failures, Equals, 0)
+}
+
+func (s *GRPCTestSuite) TestQuorum(c *C) {
+	first := &tmServer{chainID: "cosmoshub-4", height: 100}
+	firstAddr, firstServer := newGRPCServer(c, first)
+	defer firstServer.Stop()
+	second := &tmServer{chainID: "cosmoshub-4", height: 99}
+	secondAddr, secondServer := newGRPCServer(c, second)
+	defer secondServer.Stop()
+	forked := &tmServer{chainID: "forked", height: 100}
+	forkedAddr, forkedServer := newGRPCServer(c, forked)
+	defer forkedServer.Stop()
+	unhealthy := &tmServer{err: status.Error(codes.Unavailable, "node is syncing")}
+	unhealthyAddr, unhealthyServer := newGRPCServer(c, unhealthy)
+	defer unhealthyServer.Stop()
+
+	opts := Options{
+		Quorum:        true,
+		QuorumMethods: []string{getBlockByHeightMethod},
+		HeightMethod:  getLatestBlockMethod,
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	// the blocks agree and the lower of both heights is returned
+	_, conn := dialForTest(c, opts, firstAddr, secondAddr)
+	defer func() { c.Assert(conn.Close(), IsNil) }()
+	client := tmservice.NewServiceClient(conn)
+	resp, err := client.GetBlockByHeight(ctx, &tmservice.GetBlockByHeightRequest{Height: 5})
+	c.Assert(err, IsNil)
+	c.Assert(resp.Block.Header.ChainID, Equals, "cosmoshub-4")
+	latest, err := client.GetLatestBlock(ctx, &tmservice.GetLatestBlockRequest{})
+	c.Assert(err, IsNil)
+	c.Assert(latest.Block.Header.Height, Equals, int64(99))
+
+	// the blocks disagree
+	_, forkedConn := dialForTest(c, opts, firstAddr, forkedAddr)
+	defer func() { c.Assert(forkedConn.Close(), IsNil) }()
+	_, err = tmservice.NewServiceClient(forkedConn).GetBlockByHeight(ctx, &tmservice.GetBlockByHeightRequest{Height: 5})
+	c.Assert(errors.Is(err, ErrQuorum), Equals, true)
+
+	// an unhealthy endpoint is replaced by the next endpoint
+	pool, unhealthyConn := dialForTest(c, opts, unhealthyAddr, firstAddr, secondAddr)
+	defer func() { c.Assert(unhealthyConn.Close(), IsNil) }()
+	resp, err = tmservice.NewServiceClient(unhealthyConn).GetBlockByHeight(ctx, &tmservice.GetBlockByHeightRequest{Height: 5})
+	c.Assert(err, IsNil)
+	c.Assert(resp.Block.Header.Height, Equals, int64(5))
+	c.Assert(unhealthy.calls(), Equals, int64(1))
+	c.Assert(pool.Endpoints()[2].Host, Equals, unhealthyAddr)
+
+	// not enough endpoints responded
+	_, singleConn := dialForTest(c, opts, unhealthyAddr, firstAddr)
+	defer func() { c.Assert(singleConn.Close(), IsNil) }()
+	_, err = tmservice.NewServiceClient(singleConn).GetBlockByHeight(ctx, &tmservice.GetBlockByHeightRequest{Height: 5})
+	c.Assert(err, ErrorMatches, "fail to get quorum, 1 of 2 endpoints responded.*")
+}
@@ -0,0 +1,252 @@
+package failover
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/rs/zerolog"
+	"github.com/rs/zerolog/log"
+
+	"gitlab.com/thorchain/thornode/common"
+)
+
+const (
+	// maxScore is the health score of an endpoint without recent failures.
+	maxScore = 100
+
+	// successReward is the score recovered by an endpoint on each successful request.
+	successReward = 5
+
+	// failurePenalty is the score deducted from an endpoint on each failed request.
+	failurePenalty = 20
+
+	// minBackoff is the time a failed endpoint is skipped, doubled on each consecutive
+	// failure up to maxBackoff.
+	minBackoff = 5 * time.Second
+	maxBackoff = 5 * time.Minute
+
+	// maxHeightLag is the number of blocks an endpoint may lag behind the highest height
+	// reported across all endpoints before it is considered stalled.
+	maxHeightLag = 5
+
+	// probeInterval is the minimum interval between height probes of all endpoints.
+	probeInterval = time.Minute
+)
+
+var ErrNoEndpoints = errors.New("no rpc endpoints")
+
+type endpoint struct {
+	url      *url.URL
+	score    int
+	failures int
+	retryAt  time.Time
+	height   int64
+}
+
+func (e *endpoint) healthy(now time.Time) bool {
+	return !now.Before(e.retryAt)
+}
+
+// Pool is the set of endpoints of a chain daemon, ordered by health.
+type Pool struct {
+	logger    zerolog.Logger
+	lock      sync.Mutex
+	endpoints []*endpoint
+	lastProbe time.Time
+}
+
+// NewPool create a new instance of Pool for the given hosts, empty and duplicate hosts
+// are ignored and the first host is the primary endpoint.
+func NewPool(chain common.Chain, hosts ...string) (*Pool, error) {
+	p := &Pool{
+		logger: log.With().Str("module", "failover").Stringer("chain", chain).Logger(),
+	}
+	seen := make(map[string]bool)
+	for _, host := range hosts {
+		host = strings.TrimSpace(host)
+		if host == "" || seen[host] {
+			continue
+		}
+		seen[host] = true
+		u, err := parseEndpoint(host)
+		if err != nil {
+			return nil, fmt.Errorf("fail to parse rpc endpoint(%s): %w", host, err)
+		}
+		p.endpoints = append(p.endpoints, &endpoint{url: u, score: maxScore})
+	}
+	if len(p.endpoints) == 0 {
+		return nil, ErrNoEndpoints
+	}
+	return p, nil
+}
+
+// parseEndpoint parses the host as url, hosts without scheme default to http. Requests
+// only fail over between http endpoints, websocket and IPC endpoints are rejected.
+func parseEndpoint(host string) (*url.URL, error) {
+	if !strings.Contains(host, "://") {
+		if strings.HasPrefix(host, "/") || strings.HasSuffix(host, ".ipc") {
+			return nil, errors.New("IPC endpoints are not supported, use an http endpoint")
+		}
+		host = "http://" + host
+	}
+	u, err := url.Parse(host)
+	if err != nil {
+		return nil, err
+	}
+	switch u.Scheme {
+	case "tcp":
+		u.Scheme = "http"
+	case "http", "https":
+	default:
+		return nil, fmt.Errorf("%s endpoints are not supported, use an http endpoint", u.Scheme)
+	}
+	if u.Host == "" {
+		return nil, errors.New("host is empty")
+	}
+	return u, nil
+}
+
+// Len returns the number of endpoints in the pool
+func (p *Pool) Len() int {
+	return len(p.endpoints)
+}
+
+// Primary returns the first configured endpoint
+func (p *Pool) Primary() *url.URL {
+	return p.endpoints[0].url
+}
+
+// Endpoints returns the endpoints ordered by health, healthy endpoints come first by
+// score and configured order, endpoints backing off follow by the time they recover.
+func (p *Pool) Endpoints() []*url.URL {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	now := time.Now()
+	endpoints := make([]*endpoint, len(p.endpoints))
+	copy(endpoints, p.endpoints)
+	sort.SliceStable(endpoints, func(i, j int) bool {
+		a, b := endpoints[i], endpoints[j]
+		if a.healthy(now) != b.healthy(now) {
+			return a.healthy(now)
+		}
+		if !a.healthy(now) {
+			return a.retryAt.Before(b.retryAt)
+		}
+		return a.score > b.score
+	})
+	result := make([]*url.URL, len(endpoints))
+	for i, e := range endpoints {
+		result[i] = e.url
+	}
+	return result
+}
+
+func (p *Pool) get(u *url.URL) *endpoint {
+	for _, e := range p.endpoints {
+		if e.url == u {
+			return e
+		}
+	}
+	return nil
+}
+
+// Success records a successful request to the given endpoint
+func (p *Pool) Success(u *url.URL) {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	e := p.get(u)
+	if e == nil {
+		return
+	}
+	if e.failures > 0 {
+		p.logger.Info().Str("endpoint", u.Redacted()).Msg("rpc endpoint recovered")
+	}
+	e.failures = 0
+	e.score += successReward
+	if e.score > maxScore {
+		e.score = maxScore
+	}
+}
+
+// Failure records a failed request to the given endpoint, the endpoint is skipped until
+// its backoff expires
+func (p *Pool) Failure(u *url.URL, err error) {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	e := p.get(u)
+	if e == nil {
+		return
+	}
+	p.fail(e, err)
+}
+
+func (p *Pool) fail(e *endpoint, err error) {
+	e.failures++
+	e.score -= failurePenalty
+	if e.score < 0 {
+		e.score = 0
+	}
+	backoff := maxBackoff
+	if e.failures <= 7 {
+		backoff = minBackoff << (e.failures - 1)
+		if backoff > maxBackoff {
+			backoff = maxBackoff
+		}
+	}
+	e.retryAt = time.Now().Add(backoff)
+	p.logger.Warn().
+		Err(err).
+		Str("endpoint", e.url.Redacted()).
+		Int("score", e.score).
+		Int("failures", e.failures).
+		Dur("backoff", backoff).
+		Msg("rpc endpoint failed")
+}
+
+// ReportHeight records the chain height seen by the given endpoint, endpoints lagging
+// behind the highest height of the pool are considered stalled and backed off
+func (p *Pool) ReportHeight(u *url.URL, height int64) {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	e := p.get(u)
+	if e == nil || height <= 0 {
+		return
+	}
+	e.height = height
+
+	var highest int64
+	for _, item := range p.endpoints {
+		if item.height > highest {
+			highest = item.height
+		}
+	}
+	now := time.Now()
+	for _, item := range p.endpoints {
+		if item.height == 0 || !item.healthy(now) {
+			continue
+		}
+		if highest-item.height > maxHeightLag {
+			p.fail(item, fmt.Errorf("stalled at height %d, highest height is %d", item.height, highest))
+		}
+	}
+}
+
+// shouldProbe returns true at most once per probe interval when the pool has more than
+// one endpoint to compare heights with
+func (p *Pool) shouldProbe() bool {
+	if p.Len() < 2 {
+		return false
+	}
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	if time.Since(p.lastProbe) < probeInterval {
+		return false
+	}
+	p.lastProbe = time.Now()
+	return true
+}
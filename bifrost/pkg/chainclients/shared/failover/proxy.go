@@ -0,0 +1,59 @@
+package failover
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"net/http/httputil"
+	"time"
+)
+
+// Proxy is a local http server forwarding requests through a Transport, it is used by
+// rpc clients that accept a single host and can't be given a custom http client.
+type Proxy struct {
+	listener net.Listener
+	server   *http.Server
+}
+
+// NewProxy starts a Proxy for the pool listening on a random local port
+func NewProxy(pool *Pool, opts Options) (*Proxy, error) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		return nil, fmt.Errorf("fail to listen: %w", err)
+	}
+	primary := pool.Primary()
+	handler := &httputil.ReverseProxy{
+		Director: func(req *http.Request) {
+			req.URL.Scheme = primary.Scheme
+			req.URL.Host = primary.Host
+		},
+		Transport: NewTransport(pool, opts),
+	}
+	p := &Proxy{
+		listener: listener,
+		server: &http.Server{
+			Handler:           handler,
+			ReadHeaderTimeout: 5 * time.Second,
+		},
+	}
+	go func() {
+		if err := p.server.Serve(listener); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			pool.logger.Error().Err(err).Msg("rpc proxy stopped")
+		}
+	}()
+	return p, nil
+}
+
+// Host returns the <host>:<port> the proxy is listening on
+func (p *Proxy) Host() string {
+	return p.listener.Addr().String()
+}
+
+// Close stops the proxy
+func (p *Proxy) Close() error {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	return p.server.Shutdown(ctx)
+}
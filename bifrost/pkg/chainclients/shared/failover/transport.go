@@ -0,0 +1,378 @@
+package failover
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// probeTimeout is the timeout of a height probe to a single endpoint.
+const probeTimeout = 10 * time.Second
+
+var ErrQuorum = errors.New("rpc endpoints disagree")
+
+// Options configures how requests are spread over the endpoints of a pool.
+type Options struct {
+	// Quorum enables cross checking the result of quorum methods across two endpoints,
+	// requests fail when the results differ.
+	Quorum bool
+
+	// QuorumMethods are the read methods whose results are cross checked.
+	QuorumMethods []string
+
+	// HeightMethod is the method returning the chain height. It is used to probe for
+	// stalled endpoints, and when cross checked the lower of both heights is returned.
+	HeightMethod string
+
+	// IgnoreFields are the fields of JSON-RPC results which are relative to the tip of
+	// the endpoint, e.g. confirmations, and ignored when cross checking results.
+	IgnoreFields []string
+}
+
+func (o Options) isQuorumMethod(methods ...string) bool {
+	if !o.Quorum || len(methods) == 0 {
+		return false
+	}
+	for _, method := range methods {
+		found := method == o.HeightMethod
+		for _, item := range o.QuorumMethods {
+			found = found || item == method
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
+
+// Transport is a http.RoundTripper for JSON-RPC clients, sending each request to the
+// healthiest endpoint of the pool and failing over to the next one on error.
+type Transport struct {
+	pool *Pool
+	opts Options
+	base http.RoundTripper
+}
+
+// NewTransport create a new instance of Transport
+func NewTransport(pool *Pool, opts Options) *Transport {
+	return &Transport{
+		pool: pool,
+		opts: opts,
+		base: http.DefaultTransport,
+	}
+}
+
+// NewHTTPClient returns a http client sending requests through a Transport, clients should
+// use the primary endpoint of the pool as their host.
+func NewHTTPClient(pool *Pool, opts Options) *http.Client {
+	return &http.Client{
+		Transport: NewTransport(pool, opts),
+	}
+}
+
+type response struct {
+	endpoint *url.URL
+	resp     *http.Response
+	body     []byte
+}
+
+// RoundTrip implements http.RoundTripper
+func (t *Transport) RoundTrip(req *http.Request) (*http.Response, error) {
+	var body []byte
+	if req.Body != nil {
+		var err error
+		body, err = io.ReadAll(req.Body)
+		_ = req.Body.Close()
+		if err != nil {
+			return nil, fmt.Errorf("fail to read request body: %w", err)
+		}
+	}
+
+	methods := getMethods(body)
+	isHeight := len(methods) == 1 && methods[0] == t.opts.HeightMethod
+	if isHeight && t.pool.shouldProbe() {
+		go t.probe(req, body)
+	}
+	if t.pool.Len() > 1 && t.opts.isQuorumMethod(methods...) {
+		return t.quorum(req, body, isHeight)
+	}
+
+	var lastErr error
+	for _, endpoint := range t.pool.Endpoints() {
+		resp, err := t.send(req, body, endpoint)
+		if err == nil {
+			t.pool.Success(endpoint)
+			return resp, nil
+		}
+		t.pool.Failure(endpoint, err)
+		lastErr = err
+		if req.Context().Err() != nil {
+			break
+		}
+	}
+	return nil, lastErr
+}
+
+// send the request to the given endpoint, responses indicating an unhealthy endpoint
+// are returned as error
+func (t *Transport) send(req *http.Request, body []byte, endpoint *url.URL) (*http.Response, error) {
+	r := req.Clone(req.Context())
+	r.URL = t.rewrite(req.URL, endpoint)
+	r.Host = ""
+	r.Body = io.NopCloser(bytes.NewReader(body))
+	r.ContentLength = int64(len(body))
+	r.GetBody = func() (io.ReadCloser, error) {
+		return io.NopCloser(bytes.NewReader(body)), nil
+	}
+	if endpoint.User != nil {
+		password, _ := endpoint.User.Password()
+		r.SetBasicAuth(endpoint.User.Username(), password)
+	}
+
+	resp, err := t.base.RoundTrip(r)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode >= http.StatusInternalServerError || resp.StatusCode == http.StatusTooManyRequests {
+		_, _ = io.Copy(io.Discard, resp.Body)
+		_ = resp.Body.Close()
+		return nil, fmt.Errorf("unexpected status code: %d", resp.StatusCode)
+	}
+	return resp, nil
+}
+
+// rewrite the request url, which targets the primary endpoint, to the given endpoint
+func (t *Transport) rewrite(u, endpoint *url.URL) *url.URL {
+	result := *u
+	result.Scheme = endpoint.Scheme
+	result.Host = endpoint.Host
+	result.User = nil
+	suffix := strings.TrimPrefix(u.Path, strings.TrimSuffix(t.pool.Primary().Path, "/"))
+	if suffix == "/" {
+		suffix = ""
+	}
+	result.Path = endpoint.Path + suffix
+	if result.Path == "" {
+		result.Path = "/"
+	}
+	result.RawPath = ""
+	return &result
+}
+
+// fetch sends the request to the given endpoint and reads the full response
+func (t *Transport) fetch(req *http.Request, body []byte, endpoint *url.URL) (*response, error) {
+	resp, err := t.send(req, body, endpoint)
+	if err != nil {
+		t.pool.Failure(endpoint, err)
+		return nil, err
+	}
+	defer resp.Body.Close()
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		t.pool.Failure(endpoint, err)
+		return nil, err
+	}
+	t.pool.Success(endpoint)
+	resp.Body = io.NopCloser(bytes.NewReader(respBody))
+	return &response{endpoint: endpoint, resp: resp, body: respBody}, nil
+}
+
+// quorum sends the request to the two healthiest endpoints and only returns the response
+// when both results agree. For the height method the lower height is returned.
+func (t *Transport) quorum(req *http.Request, body []byte, isHeight bool) (*http.Response, error) {
+	var responses []*response
+	var lastErr error
+	endpoints := t.pool.Endpoints()
+	for len(responses) < 2 && len(endpoints) > 0 && req.Context().Err() == nil {
+		// query as many endpoints in parallel as responses are missing
+		count := 2 - len(responses)
+		if count > len(endpoints) {
+			count = len(endpoints)
+		}
+		batch := endpoints[:count]
+		endpoints = endpoints[count:]
+
+		results := make([]*response, len(batch))
+		errs := make([]error, len(batch))
+		wg := &sync.WaitGroup{}
+		for i, endpoint := range batch {
+			wg.Add(1)
+			go func(i int, endpoint *url.URL) {
+				defer wg.Done()
+				results[i], errs[i] = t.fetch(req, body, endpoint)
+			}(i, endpoint)
+		}
+		wg.Wait()
+		for i := range batch {
+			if errs[i] != nil {
+				lastErr = errs[i]
+				continue
+			}
+			responses = append(responses, results[i])
+		}
+	}
+	if len(responses) < 2 {
+		if lastErr == nil {
+			lastErr = req.Context().Err()
+		}
+		return nil, fmt.Errorf("fail to get quorum, %d of 2 endpoints responded: %w", len(responses), lastErr)
+	}
+
+	first, second := responses[0], responses[1]
+	if isHeight {
+		firstHeight, err1 := parseHeight(first.body)
+		secondHeight, err2 := parseHeight(second.body)
+		if err1 == nil && err2 == nil {
+			t.pool.ReportHeight(first.endpoint, firstHeight)
+			t.pool.ReportHeight(second.endpoint, secondHeight)
+			if secondHeight < firstHeight {
+				return second.resp, nil
+			}
+			return first.resp, nil
+		}
+	}
+	if !sameResult(first.body, second.body, t.opts.IgnoreFields) {
+		t.pool.logger.Error().
+			Str("first", first.endpoint.Redacted()).
+			Str("second", second.endpoint.Redacted()).
+			Strs("methods", getMethods(body)).
+			Msg("rpc endpoints returned different results")
+		return nil, fmt.Errorf("%w, methods: %s", ErrQuorum, strings.Join(getMethods(body), ","))
+	}
+	return first.resp, nil
+}
+
+// probe requests the height from all endpoints to detect stalled endpoints
+func (t *Transport) probe(req *http.Request, body []byte) {
+	ctx, cancel := context.WithTimeout(context.Background(), probeTimeout)
+	defer cancel()
+	req = req.Clone(ctx)
+	wg := &sync.WaitGroup{}
+	for _, endpoint := range t.pool.Endpoints() {
+		wg.Add(1)
+		go func(endpoint *url.URL) {
+			defer wg.Done()
+			result, err := t.fetch(req, body, endpoint)
+			if err != nil {
+				return
+			}
+			height, err := parseHeight(result.body)
+			if err != nil {
+				t.pool.logger.Debug().Err(err).Str("endpoint", endpoint.Redacted()).Msg("fail to parse height")
+				return
+			}
+			t.pool.ReportHeight(endpoint, height)
+		}(endpoint)
+	}
+	wg.Wait()
+}
+
+// getMethods returns the methods of a JSON-RPC request or batch request
+func getMethods(body []byte) []string {
+	type request struct {
+		Method string `json:"method"`
+	}
+	body = bytes.TrimSpace(body)
+	var requests []request
+	if bytes.HasPrefix(body, []byte("[")) {
+		if err := json.Unmarshal(body, &requests); err != nil {
+			return nil
+		}
+	} else {
+		var r request
+		if err := json.Unmarshal(body, &r); err != nil {
+			return nil
+		}
+		requests = append(requests, r)
+	}
+	methods := make([]string, 0, len(requests))
+	for _, r := range requests {
+		if r.Method == "" {
+			return nil
+		}
+		methods = append(methods, r.Method)
+	}
+	return methods
+}
+
+// parseHeight parses the result of a JSON-RPC response as height, which is either a
+// number or a decimal or hex encoded string
+func parseHeight(body []byte) (int64, error) {
+	var resp struct {
+		Result json.RawMessage `json:"result"`
+	}
+	if err := json.Unmarshal(body, &resp); err != nil {
+		return 0, fmt.Errorf("fail to unmarshal response: %w", err)
+	}
+	var value string
+	if err := json.Unmarshal(resp.Result, &value); err != nil {
+		value = string(resp.Result)
+	}
+	if strings.HasPrefix(value, "0x") {
+		return strconv.ParseInt(value[2:], 16, 64)
+	}
+	return strconv.ParseInt(value, 10, 64)
+}
+
+// sameResult compares the JSON-RPC responses ignoring the request ids and the given
+// result fields
+func sameResult(a, b []byte, ignoreFields []string) bool {
+	first, err1 := normalise(a, ignoreFields)
+	second, err2 := normalise(b, ignoreFields)
+	if err1 != nil || err2 != nil {
+		return bytes.Equal(a, b)
+	}
+	return reflect.DeepEqual(first, second)
+}
+
+func normalise(body []byte, ignoreFields []string) (interface{}, error) {
+	decoder := json.NewDecoder(bytes.NewReader(body))
+	decoder.UseNumber()
+	var value interface{}
+	if err := decoder.Decode(&value); err != nil {
+		return nil, err
+	}
+	strip := func(item interface{}) {
+		m, ok := item.(map[string]interface{})
+		if !ok {
+			return
+		}
+		delete(m, "id")
+		if result, ok := m["result"].(map[string]interface{}); ok {
+			for _, field := range ignoreFields {
+				delete(result, field)
+			}
+		}
+	}
+	switch v := value.(type) {
+	case map[string]interface{}:
+		strip(v)
+	case []interface{}:
+		// batch responses may be in any order
+		sort.SliceStable(v, func(i, j int) bool {
+			return fmt.Sprint(getID(v[i])) < fmt.Sprint(getID(v[j]))
+		})
+		for _, item := range v {
+			strip(item)
+		}
+	}
+	return value, nil
+}
+
+func getID(value interface{}) interface{} {
+	if m, ok := value.(map[string]interface{}); ok {
+		return m["id"]
+	}
+	return nil
+}
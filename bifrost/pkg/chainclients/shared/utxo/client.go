@@ -0,0 +1,536 @@
+// Package utxo holds the UTXO chain client shared by bitcoin, bitcoincash, litecoin and
+// dogecoin: the block scanner glue, block meta storage and re-org handling, mempool
+// scanning, solvency reporting, confirmation counting, UTXO selection and consolidation,
+// vault signer locks, the signing and broadcast flow, TSS signing and the rpc endpoint
+// failover.
+//
+// The chains depend on separate forks of btcd (btcd, bchd, ltcd and dogd) whose wire,
+// txscript, btcjson and btcec types are distinct, so each chain implements a Driver that
+// wraps its rpc client and script library: fetching and decoding blocks and transactions,
+// the network fee rules, and building, signing and sending a transaction.
+package utxo
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"math/big"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/btcsuite/btcutil"
+	"github.com/rs/zerolog"
+	"go.uber.org/atomic"
+
+	"gitlab.com/thorchain/thornode/bifrost/blockscanner"
+	"gitlab.com/thorchain/thornode/bifrost/metrics"
+	"gitlab.com/thorchain/thornode/bifrost/pkg/chainclients/shared/asgard"
+	"gitlab.com/thorchain/thornode/bifrost/pkg/chainclients/shared/runners"
+	"gitlab.com/thorchain/thornode/bifrost/pkg/chainclients/shared/signercache"
+	"gitlab.com/thorchain/thornode/bifrost/thorclient"
+	stypes "gitlab.com/thorchain/thornode/bifrost/thorclient/types"
+	"gitlab.com/thorchain/thornode/common"
+	"gitlab.com/thorchain/thornode/common/cosmos"
+	"gitlab.com/thorchain/thornode/config"
+	"gitlab.com/thorchain/thornode/constants"
+	mem "gitlab.com/thorchain/thornode/x/thorchain/memo"
+	"gitlab.com/thorchain/thornode/x/thorchain/types"
+)
+
+const (
+	// MaximumConfirmation is the upper bound of confirmations used when listing UTXOs
+	MaximumConfirmation = 99999999
+	// MaxAsgardAddresses is the maximum number of asgard addresses cached by the client
+	MaxAsgardAddresses = 100
+	// MinUTXOConfirmation UTXO that has less confirmation then this will not be spent , unless it is yggdrasil
+	MinUTXOConfirmation = 1
+	// MaxMempoolScanPerTry is the maximum number of mempool txs scanned per try
+	MaxMempoolScanPerTry = 500
+	// maxUTXOsToSpend is the default for the MaxUTXOsToSpend mimir
+	maxUTXOsToSpend = 10
+	// maxRBFSequence the highest sequence number that still signals replace-by-fee (BIP125)
+	maxRBFSequence = 0xffffffff - 2
+)
+
+// ErrTxAlreadyInChain is returned by Driver.SendRawTransaction when the transaction had been
+// broadcast to the chain already
+var ErrTxAlreadyInChain = errors.New("transaction already in chain")
+
+// UnspentOutput is the chain agnostic view of an entry returned by the daemon's listunspent RPC
+type UnspentOutput struct {
+	TxID          string
+	Vout          uint32
+	Address       string
+	ScriptPubKey  string
+	Amount        float64 // amount in coin unit, as reported by the daemon
+	Confirmations int64
+}
+
+// Block is the chain agnostic view of a block fetched by a Driver
+type Block struct {
+	Height       int64
+	Hash         string
+	PreviousHash string
+	// TxHashes are the hashes of all the transactions in the block
+	TxHashes []string
+	// Data is the block as decoded by the driver, it is passed back to the driver to
+	// extract the transactions and the network fee
+	Data interface{}
+}
+
+// Driver is the chain specific part of a UTXO chain client, it wraps the RPC client, script
+// and signing libraries of the chain so the rest of the client logic can be shared
+type Driver interface {
+	// GetChain returns the chain the driver is for
+	GetChain() common.Chain
+	// GetHeight returns the height of the chain tip
+	GetHeight() (int64, error)
+	// ListUnspent returns the UTXOs of the given pubkey with confirmations in [minConfirm, maxConfirm]
+	ListUnspent(minConfirm, maxConfirm int, pkey common.PubKey) ([]UnspentOutput, error)
+	// IsValidUTXO returns true when the given hex encoded script pub key can be spent by a vault
+	IsValidUTXO(hexPubKey string) bool
+	// GetNetworkFeeRate returns the last fee rate reported to THORChain
+	GetNetworkFeeRate() uint64
+
+	// GetBlock returns the block at the given height, blockscanner/types.ErrUnavailableBlock
+	// when the chain doesn't have the block yet
+	GetBlock(height int64) (*Block, error)
+	// GetBlockTxIns returns the transactions of the block that are in the THORChain format,
+	// transactions that can't be parsed are skipped
+	GetBlockTxIns(block *Block) []stypes.TxInItem
+	// GetMempoolTxIDs returns the hashes of the transactions in the mempool
+	GetMempoolTxIDs() ([]string, error)
+	// GetMempoolTxIn returns the given mempool transaction, an empty item when the
+	// transaction is not in the THORChain format or must not be observed from the mempool
+	GetMempoolTxIn(txID string, height int64) (stypes.TxInItem, error)
+	// IsTxOnChain returns true when the given transaction is on chain or in the mempool
+	IsTxOnChain(txID string) (bool, error)
+	// IsTxInMempool returns true when the given transaction is in the mempool
+	IsTxInMempool(txID string) bool
+	// GetCoinbaseValue returns the block reward and fees of the block at the given height, in sats
+	GetCoinbaseValue(height int64) (int64, error)
+	// UpdateNetworkInfo refreshes the network settings, such as the minimum relay fee
+	UpdateNetworkInfo()
+	// SendNetworkFee reports the network fee observed in the given block to THORChain
+	SendNetworkFee(block *Block) error
+	// ShouldReportSolvency returns true when solvency should be reported at the given height
+	ShouldReportSolvency(height, lastSolvencyCheckHeight int64) bool
+
+	// CheckToAddress returns an error when the given address can't be decoded, and false
+	// when it decodes but must not be paid to
+	CheckToAddress(addr common.Address) (bool, error)
+	// BuildTx builds the unsigned transaction of the given outbound, and returns the amount
+	// of each spent UTXO keyed by "txid-vout"
+	BuildTx(tx stypes.TxOutItem) (SignableTx, map[string]int64, error)
+	// DecodeTx decodes the unsigned transaction of the given outbound from a checkpoint
+	DecodeTx(tx stypes.TxOutItem, unsignedTx []byte) (SignableTx, error)
+	// SendRawTransaction broadcasts the signed payload and returns the transaction hash,
+	// ErrTxAlreadyInChain along with the hash when it had been broadcast already
+	SendRawTransaction(payload []byte) (string, error)
+}
+
+// Settings are the chain specific settings of a Client
+type Settings struct {
+	// BlockCacheSize is the number of blocks the block metas are kept for
+	BlockCacheSize int64
+	// EstimateAverageTxSize is the tx size, in bytes, the network fee is reported for
+	EstimateAverageTxSize uint64
+}
+
+// Client implements the logic shared by all UTXO chain clients, it scans the chain with a
+// block scanner, signs and broadcasts outbounds, and consolidates UTXOs
+type Client struct {
+	logger                  zerolog.Logger
+	cfg                     config.BifrostChainConfiguration
+	settings                Settings
+	driver                  Driver
+	chain                   common.Chain
+	bridge                  thorclient.ThorchainBridge
+	blockScanner            *blockscanner.BlockScanner
+	temporalStorage         *TemporalStorage
+	signerCacheManager      *signercache.CacheManager
+	globalErrataQueue       chan<- stypes.ErrataBlock
+	globalSolvencyQueue     chan<- stypes.Solvency
+	nodePubKey              common.PubKey
+	currentBlockHeight      *atomic.Int64
+	lastSolvencyCheckHeight int64
+	wg                      *sync.WaitGroup
+	stopchan                chan struct{}
+	asgardAddresses         []common.Address
+	lastAsgard              time.Time
+	signerLock              *sync.Mutex
+	vaultSignerLocks        map[string]*sync.Mutex
+	consolidateInProgress   *atomic.Bool
+}
+
+// NewClient create a new instance of Client, along with its block scanner and storage
+func NewClient(driver Driver, cfg config.BifrostChainConfiguration, settings Settings, bridge thorclient.ThorchainBridge, m *metrics.Metrics, nodePubKey common.PubKey, logger zerolog.Logger) (*Client, error) {
+	c := &Client{
+		logger:                logger,
+		cfg:                   cfg,
+		settings:              settings,
+		driver:                driver,
+		chain:                 driver.GetChain(),
+		bridge:                bridge,
+		nodePubKey:            nodePubKey,
+		currentBlockHeight:    atomic.NewInt64(0),
+		wg:                    &sync.WaitGroup{},
+		stopchan:              make(chan struct{}),
+		signerLock:            &sync.Mutex{},
+		vaultSignerLocks:      make(map[string]*sync.Mutex),
+		consolidateInProgress: atomic.NewBool(false),
+	}
+
+	var path string // if not set later, will in memory storage
+	if len(cfg.BlockScanner.DBPath) > 0 {
+		path = fmt.Sprintf("%s/%s", cfg.BlockScanner.DBPath, cfg.BlockScanner.ChainID)
+	}
+	storage, err := blockscanner.NewBlockScannerStorage(path, cfg.ScannerLevelDB)
+	if err != nil {
+		return nil, fmt.Errorf("fail to create blockscanner storage: %w", err)
+	}
+	c.blockScanner, err = blockscanner.NewBlockScanner(cfg.BlockScanner, storage, m, bridge, c)
+	if err != nil {
+		return nil, fmt.Errorf("fail to create block scanner: %w", err)
+	}
+	c.temporalStorage, err = NewTemporalStorage(storage.GetInternalDb(), cfg.MempoolTxIDCacheSize)
+	if err != nil {
+		return nil, fmt.Errorf("fail to create temporal storage: %w", err)
+	}
+	c.signerCacheManager, err = signercache.NewSignerCacheManager(storage.GetInternalDb())
+	if err != nil {
+		return nil, fmt.Errorf("fail to create signer cache manager,err: %w", err)
+	}
+	return c, nil
+}
+
+// Start starts the block scanner and the solvency check runner
+func (c *Client) Start(globalTxsQueue chan stypes.TxIn, globalErrataQueue chan<- stypes.ErrataBlock, globalSolvencyQueue chan<- stypes.Solvency) {
+	c.globalErrataQueue = globalErrataQueue
+	c.globalSolvencyQueue = globalSolvencyQueue
+	c.blockScanner.Start(globalTxsQueue)
+	c.wg.Add(1)
+	go runners.SolvencyCheckRunner(c.chain, c, c.bridge, c.stopchan, c.wg, constants.ThorchainBlockTime)
+}
+
+// Stop stops the block scanner, and waits for the solvency check runner and UTXO consolidation to exit
+func (c *Client) Stop() {
+	c.blockScanner.Stop()
+	close(c.stopchan)
+	c.wg.Wait()
+}
+
+// GetTemporalStorage returns the storage of the block metas, mempool and observed tx caches
+func (c *Client) GetTemporalStorage() *TemporalStorage {
+	return c.temporalStorage
+}
+
+// GetHeight returns current block height
+func (c *Client) GetHeight() (int64, error) {
+	return c.driver.GetHeight()
+}
+
+// IsBlockScannerHealthy returns true if the block scanner is healthy
+func (c *Client) IsBlockScannerHealthy() bool {
+	return c.blockScanner.IsHealthy()
+}
+
+// GetAccount returns account with balance for an address
+func (c *Client) GetAccount(pkey common.PubKey, height *big.Int) (common.Account, error) {
+	if height != nil {
+		c.logger.Error().Msg("height was provided but will be ignored")
+	}
+
+	acct := common.Account{}
+	if pkey.IsEmpty() {
+		return acct, errors.New("pubkey can't be empty")
+	}
+	total, err := c.GetBalance(pkey)
+	if err != nil {
+		return acct, err
+	}
+	return common.NewAccount(0, 0,
+		common.Coins{
+			common.NewCoin(c.chain.GetGasAsset(), total),
+		}, false), nil
+}
+
+// IsRBFEnabled returns true when any of the given input sequences signals replace-by-fee
+func IsRBFEnabled(sequences ...uint32) bool {
+	for _, sequence := range sequences {
+		if sequence <= maxRBFSequence {
+			return true
+		}
+	}
+	return false
+}
+
+// GetBalance returns the balance of the given pubkey in sats, pending UTXOs are only counted
+// when they are sent by ourselves or by asgard
+func (c *Client) GetBalance(pkey common.PubKey) (cosmos.Uint, error) {
+	utxos, err := c.driver.ListUnspent(0, MaximumConfirmation, pkey)
+	if err != nil {
+		return cosmos.ZeroUint(), fmt.Errorf("fail to get UTXOs: %w", err)
+	}
+	total := 0.0
+	for _, item := range utxos {
+		if !c.driver.IsValidUTXO(item.ScriptPubKey) {
+			continue
+		}
+		if item.Confirmations == 0 {
+			// pending tx that is still  in mempool, only count yggdrasil send to itself or from asgard
+			if !c.IsSelfTransaction(item.TxID) && !c.IsAsgardAddress(item.Address) {
+				continue
+			}
+		}
+		total += item.Amount
+	}
+	totalAmt, err := btcutil.NewAmount(total)
+	if err != nil {
+		return cosmos.ZeroUint(), fmt.Errorf("fail to convert total amount: %w", err)
+	}
+	return cosmos.NewUint(uint64(totalAmt)), nil
+}
+
+// GetAsgardAddresses returns the asgard addresses of the chain, refreshed at most once per THORChain block
+func (c *Client) GetAsgardAddresses() ([]common.Address, error) {
+	if time.Since(c.lastAsgard) < constants.ThorchainBlockTime && c.asgardAddresses != nil {
+		return c.asgardAddresses, nil
+	}
+	newAddresses, err := asgard.GetAddresses(c.chain, MaxAsgardAddresses, c.bridge)
+	if err != nil {
+		return nil, fmt.Errorf("fail to get asgards : %w", err)
+	}
+	if len(newAddresses) > 0 { // ensure we don't overwrite with empty list
+		c.asgardAddresses = newAddresses
+	}
+	c.lastAsgard = time.Now()
+	return c.asgardAddresses, nil
+}
+
+// CachedAsgardAddresses returns the asgard addresses from the last refresh without querying THORChain
+func (c *Client) CachedAsgardAddresses() []common.Address {
+	return c.asgardAddresses
+}
+
+// IsAsgardAddress returns true when the given address belongs to an asgard vault
+func (c *Client) IsAsgardAddress(addressToCheck string) bool {
+	asgards, err := c.GetAsgardAddresses()
+	if err != nil {
+		c.logger.Err(err).Msg("fail to get asgard addresses")
+		return false
+	}
+	for _, addr := range asgards {
+		if strings.EqualFold(addr.String(), addressToCheck) {
+			return true
+		}
+	}
+	return false
+}
+
+// IsYggdrasil - when the pubkey and node pubkey is the same that means it is signing from yggdrasil
+func (c *Client) IsYggdrasil(key common.PubKey) bool {
+	return key.Equals(c.nodePubKey)
+}
+
+// IsSelfTransaction check the block meta to see whether the transactions is broadcast by ourselves
+// if the transaction is broadcast by ourselves, then we should be able to spend the UTXO even it is still in mempool
+// as such we could daisy chain the outbound transaction
+func (c *Client) IsSelfTransaction(txID string) bool {
+	bms, err := c.temporalStorage.GetBlockMetas()
+	if err != nil {
+		c.logger.Err(err).Msg("fail to get block metas")
+		return false
+	}
+	for _, item := range bms {
+		for _, tx := range item.SelfTransactions {
+			if strings.EqualFold(tx, txID) {
+				c.logger.Debug().Msgf("%s is self transaction", txID)
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// GetMaximumUtxosToSpend returns the MaxUTXOsToSpend mimir, or the default when it is not set
+func (c *Client) GetMaximumUtxosToSpend() int64 {
+	const mimirMaxUTXOsToSpend = `MaxUTXOsToSpend`
+	utxosToSpend, err := c.bridge.GetMimir(mimirMaxUTXOsToSpend)
+	if err != nil {
+		c.logger.Err(err).Msg("fail to get MaxUTXOsToSpend")
+	}
+	if utxosToSpend <= 0 {
+		utxosToSpend = maxUTXOsToSpend
+	}
+	return utxosToSpend
+}
+
+// GetUtxoToSpend go through all the UTXOs of the given pubkey, oldest first, and return the ones that can be spent
+// it also try to spend enough UTXOs that can add up to more than the given total
+func (c *Client) GetUtxoToSpend(pubKey common.PubKey, total float64) ([]UnspentOutput, error) {
+	var result []UnspentOutput
+	minConfirmation := 0
+	utxosToSpend := c.GetMaximumUtxosToSpend()
+	// Yggdrasil vault is funded by asgard , which will only spend UTXO that is older than 10 blocks, so yggdrasil doesn't need
+	// to do the same logic
+	isYggdrasil := c.IsYggdrasil(pubKey)
+	utxos, err := c.driver.ListUnspent(minConfirmation, MaximumConfirmation, pubKey)
+	if err != nil {
+		return nil, fmt.Errorf("fail to get UTXOs: %w", err)
+	}
+	// spend UTXO older to younger
+	sort.SliceStable(utxos, func(i, j int) bool {
+		if utxos[i].Confirmations > utxos[j].Confirmations {
+			return true
+		} else if utxos[i].Confirmations < utxos[j].Confirmations {
+			return false
+		}
+		return utxos[i].TxID < utxos[j].TxID
+	})
+	var toSpend float64
+	minUTXOAmt := btcutil.Amount(c.chain.DustThreshold().Uint64()).ToBTC()
+	for _, item := range utxos {
+		if !c.driver.IsValidUTXO(item.ScriptPubKey) {
+			c.logger.Info().Msgf("invalid UTXO , can't spent it")
+			continue
+		}
+		isSelfTx := c.IsSelfTransaction(item.TxID)
+		if item.Confirmations == 0 {
+			// pending tx that is still  in mempool, only count yggdrasil send to itself or from asgard
+			if !isSelfTx && !c.IsAsgardAddress(item.Address) {
+				continue
+			}
+		}
+		// when the utxo is signed by yggdrasil / asgard , even amount is less than DustThreshold
+		// it is ok to spend it
+		if item.Amount < minUTXOAmt && !isSelfTx && !isYggdrasil {
+			continue
+		}
+		if isYggdrasil || item.Confirmations >= MinUTXOConfirmation || isSelfTx {
+			result = append(result, item)
+			toSpend += item.Amount
+		}
+		// in the scenario that there are too many unspent utxos available, make sure it doesn't spend too much
+		// as too much UTXO will cause huge pressure on TSS, also make sure it will spend at least maxUTXOsToSpend
+		// so the UTXOs will be consolidated
+		if int64(len(result)) >= utxosToSpend && toSpend >= total {
+			break
+		}
+	}
+	return result, nil
+}
+
+// GetVaultSignerLock , with consolidate UTXO process add into bifrost , there are two entry points for SignTx , one is from signer , signing the outbound tx
+// from state machine, the other one will be consolidate utxo process
+// this keep a lock per vault pubkey , the goal is each vault we only have one key sign in flight at a time, however different vault can do key sign in parallel
+// assume there are multiple asgards(A,B) , and local yggdrasil vault , when A is signing , B and local yggdrasil vault should be able to sign as well
+// however if A already has a key sign in flight , bifrost should not kick off another key sign in parallel, otherwise we might double spend some UTXOs
+func (c *Client) GetVaultSignerLock(vaultPubKey string) *sync.Mutex {
+	c.signerLock.Lock()
+	defer c.signerLock.Unlock()
+	l, ok := c.vaultSignerLocks[vaultPubKey]
+	if !ok {
+		newLock := &sync.Mutex{}
+		c.vaultSignerLocks[vaultPubKey] = newLock
+		return newLock
+	}
+	return l
+}
+
+// ConsolidateUTXOs kicks off UTXO consolidation in the background, unless a previous run is still in progress
+func (c *Client) ConsolidateUTXOs() {
+	if c.consolidateInProgress.Load() {
+		return
+	}
+	c.wg.Add(1)
+	// c.consolidateInProgress will be set to false once c.consolidateUTXOs finished
+	c.consolidateInProgress.Store(true)
+	go c.consolidateUTXOs()
+}
+
+// consolidateUTXOs only required when there is a new block
+func (c *Client) consolidateUTXOs() {
+	defer func() {
+		c.wg.Done()
+		c.consolidateInProgress.Store(false)
+	}()
+	nodeStatus, err := c.bridge.FetchNodeStatus()
+	if err != nil {
+		c.logger.Err(err).Msg("fail to get node status")
+		return
+	}
+	if nodeStatus != types.NodeStatus_Active {
+		c.logger.Info().Msgf("node is not active , doesn't need to consolidate utxos")
+		return
+	}
+	vaults, err := c.bridge.GetAsgards()
+	if err != nil {
+		c.logger.Err(err).Msg("fail to get current asgards")
+		return
+	}
+	utxosToSpend := c.GetMaximumUtxosToSpend()
+	for _, vault := range vaults {
+		if !vault.Contains(c.nodePubKey) {
+			// Not part of this vault , don't need to consolidate UTXOs for this Vault
+			continue
+		}
+		// the amount used here doesn't matter , just to see whether there are more than 15 UTXO available or not
+		utxos, err := c.GetUtxoToSpend(vault.PubKey, 0.01)
+		if err != nil {
+			c.logger.Err(err).Msg("fail to get utxos to spend")
+			continue
+		}
+		// doesn't have enough UTXOs , don't need to consolidate
+		if int64(len(utxos)) < utxosToSpend {
+			continue
+		}
+		total := 0.0
+		for _, item := range utxos {
+			total += item.Amount
+		}
+		addr, err := vault.PubKey.GetAddress(c.chain)
+		if err != nil {
+			c.logger.Err(err).Msgf("fail to get %s address for pubkey:%s", c.chain, vault.PubKey)
+			continue
+		}
+		// THORChain usually pay 1.5 of the last observed fee rate
+		feeRate := math.Ceil(float64(c.driver.GetNetworkFeeRate()) * 3 / 2)
+		amt, err := btcutil.NewAmount(total)
+		if err != nil {
+			c.logger.Err(err).Msgf("fail to convert to %s amount: %f", c.chain, total)
+			continue
+		}
+
+		txOutItem := stypes.TxOutItem{
+			Chain:       c.chain,
+			ToAddress:   addr,
+			VaultPubKey: vault.PubKey,
+			Coins: common.Coins{
+				common.NewCoin(c.chain.GetGasAsset(), cosmos.NewUint(uint64(amt))),
+			},
+			Memo:    mem.NewConsolidateMemo().String(),
+			MaxGas:  nil,
+			GasRate: int64(feeRate),
+		}
+		height, err := c.bridge.GetBlockHeight()
+		if err != nil {
+			c.logger.Err(err).Msg("fail to get THORChain block height")
+			continue
+		}
+		// ignore checkpoint since there is no risk of double spend on consolidate
+		rawTx, _, _, err := c.SignTx(txOutItem, height)
+		if err != nil {
+			c.logger.Err(err).Msg("fail to sign consolidate txout item")
+			continue
+		}
+		txID, err := c.BroadcastTx(txOutItem, rawTx)
+		if err != nil {
+			c.logger.Err(err).Msg("fail to broadcast consolidate tx")
+			continue
+		}
+		c.logger.Info().Msgf("broadcast consolidate tx successfully,hash:%s", txID)
+	}
+}
@@ -0,0 +1,350 @@
+package utxo
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/crypto/hd"
+	ckeys "github.com/cosmos/cosmos-sdk/crypto/keyring"
+	"github.com/rs/zerolog/log"
+	. "gopkg.in/check.v1"
+
+	btypes "gitlab.com/thorchain/thornode/bifrost/blockscanner/types"
+	"gitlab.com/thorchain/thornode/bifrost/metrics"
+	"gitlab.com/thorchain/thornode/bifrost/thorclient"
+	stypes "gitlab.com/thorchain/thornode/bifrost/thorclient/types"
+	"gitlab.com/thorchain/thornode/cmd"
+	"gitlab.com/thorchain/thornode/common"
+	"gitlab.com/thorchain/thornode/common/cosmos"
+	"gitlab.com/thorchain/thornode/config"
+	"gitlab.com/thorchain/thornode/x/thorchain/types"
+)
+
+const invalidScript = "invalid"
+
+type mockDriver struct {
+	utxos   []UnspentOutput
+	blocks  map[int64]*Block
+	txIns   map[int64][]stypes.TxInItem
+	onChain map[string]bool
+}
+
+func (d *mockDriver) GetChain() common.Chain {
+	return common.BTCChain
+}
+
+func (d *mockDriver) GetHeight() (int64, error) {
+	return 10, nil
+}
+
+func (d *mockDriver) ListUnspent(minConfirm, maxConfirm int, pkey common.PubKey) ([]UnspentOutput, error) {
+	result := make([]UnspentOutput, len(d.utxos))
+	copy(result, d.utxos)
+	return result, nil
+}
+
+func (d *mockDriver) IsValidUTXO(hexPubKey string) bool {
+	return hexPubKey != invalidScript
+}
+
+func (d *mockDriver) GetNetworkFeeRate() uint64 {
+	return 10
+}
+
+func (d *mockDriver) GetBlock(height int64) (*Block, error) {
+	block, ok := d.blocks[height]
+	if !ok {
+		return nil, btypes.ErrUnavailableBlock
+	}
+	return block, nil
+}
+
+func (d *mockDriver) GetBlockTxIns(block *Block) []stypes.TxInItem {
+	return d.txIns[block.Height]
+}
+
+func (d *mockDriver) GetMempoolTxIDs() ([]string, error) {
+	return nil, nil
+}
+
+func (d *mockDriver) GetMempoolTxIn(txID string, height int64) (stypes.TxInItem, error) {
+	return stypes.TxInItem{}, nil
+}
+
+func (d *mockDriver) IsTxOnChain(txID string) (bool, error) {
+	return d.onChain[txID], nil
+}
+
+func (d *mockDriver) IsTxInMempool(txID string) bool {
+	return false
+}
+
+func (d *mockDriver) GetCoinbaseValue(height int64) (int64, error) {
+	return 0, nil
+}
+
+func (d *mockDriver) UpdateNetworkInfo() {}
+
+func (d *mockDriver) SendNetworkFee(block *Block) error {
+	return nil
+}
+
+func (d *mockDriver) ShouldReportSolvency(height, lastSolvencyCheckHeight int64) bool {
+	return height-lastSolvencyCheckHeight > 1
+}
+
+func (d *mockDriver) CheckToAddress(addr common.Address) (bool, error) {
+	return true, nil
+}
+
+func (d *mockDriver) BuildTx(tx stypes.TxOutItem) (SignableTx, map[string]int64, error) {
+	return nil, nil, errors.New("not implemented")
+}
+
+func (d *mockDriver) DecodeTx(tx stypes.TxOutItem, unsignedTx []byte) (SignableTx, error) {
+	return nil, errors.New("not implemented")
+}
+
+func (d *mockDriver) SendRawTransaction(payload []byte) (string, error) {
+	return "", errors.New("not implemented")
+}
+
+type UTXOClientTestSuite struct {
+	server *httptest.Server
+	bridge thorclient.ThorchainBridge
+	driver *mockDriver
+	client *Client
+}
+
+var _ = Suite(&UTXOClientTestSuite{})
+
+func (s *UTXOClientTestSuite) SetUpTest(c *C) {
+	types.SetupConfigForTest()
+	// THORChain endpoints are not available, the client should fall back to its defaults
+	s.server = httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		rw.WriteHeader(http.StatusNotFound)
+	}))
+	m, err := metrics.NewMetrics(config.BifrostMetricsConfiguration{
+		Enabled:      false,
+		ListenPort:   9090,
+		ReadTimeout:  time.Second,
+		WriteTimeout: time.Second,
+		Chains:       common.Chains{common.BTCChain},
+	})
+	c.Assert(err, IsNil)
+	cfg := config.BifrostClientConfiguration{
+		ChainID:         "thorchain",
+		ChainHost:       s.server.Listener.Addr().String(),
+		ChainRPC:        s.server.Listener.Addr().String(),
+		SignerName:      "bob",
+		SignerPasswd:    "password",
+		ChainHomeFolder: ".",
+	}
+	kb := ckeys.NewInMemory()
+	_, _, err = kb.NewMnemonic(cfg.SignerName, ckeys.English, cmd.THORChainHDPath, cfg.SignerPasswd, hd.Secp256k1)
+	c.Assert(err, IsNil)
+	s.bridge, err = thorclient.NewThorchainBridge(cfg, m, thorclient.NewKeysWithKeybase(kb, cfg.SignerName, cfg.SignerPasswd))
+	c.Assert(err, IsNil)
+
+	s.driver = &mockDriver{
+		blocks:  make(map[int64]*Block),
+		txIns:   make(map[int64][]stypes.TxInItem),
+		onChain: make(map[string]bool),
+	}
+	chainCfg := config.BifrostChainConfiguration{
+		ChainID: common.BTCChain,
+		BlockScanner: config.BifrostBlockScannerConfiguration{
+			ChainID:          common.BTCChain,
+			StartBlockHeight: 1, // avoids querying thorchain for block height
+		},
+	}
+	s.client, err = NewClient(s.driver, chainCfg, Settings{BlockCacheSize: 144, EstimateAverageTxSize: 1000}, s.bridge, m, types.GetRandomPubKey(), log.Logger)
+	c.Assert(err, IsNil)
+}
+
+func (s *UTXOClientTestSuite) TearDownTest(c *C) {
+	s.server.Close()
+}
+
+func (s *UTXOClientTestSuite) TestIsRBFEnabled(c *C) {
+	c.Assert(IsRBFEnabled(), Equals, false)
+	c.Assert(IsRBFEnabled(0xffffffff), Equals, false)
+	c.Assert(IsRBFEnabled(0xffffffff-1), Equals, false)
+	c.Assert(IsRBFEnabled(0xffffffff-2), Equals, true)
+	c.Assert(IsRBFEnabled(0xffffffff, 0), Equals, true)
+}
+
+func (s *UTXOClientTestSuite) TestGetUtxoToSpend(c *C) {
+	selfTx := "66d2d6b5eb564972c59e4797683a1225a02515a41119f0a8919381236b63e948"
+	bm := NewBlockMeta("", 1024, "")
+	bm.AddSelfTransaction(selfTx)
+	c.Assert(s.client.temporalStorage.SaveBlockMeta(1024, bm), IsNil)
+
+	s.driver.utxos = []UnspentOutput{
+		{TxID: "c5946215d82d5870ba2b1e8f245d8aa1446783975aa3a592cf55589fccbf285f", Amount: 0.1, Confirmations: 5},
+		{TxID: "a1", Amount: 0.2, Confirmations: 10},
+		{TxID: "a2", Amount: 0.3, Confirmations: 10, ScriptPubKey: invalidScript},
+		{TxID: "a3", Amount: 0.00001, Confirmations: 20},
+		{TxID: "a4", Amount: 0.4, Confirmations: 0},
+		{TxID: selfTx, Amount: 0.5, Confirmations: 0},
+	}
+	pubKey := types.GetRandomPubKey()
+	utxos, err := s.client.GetUtxoToSpend(pubKey, 1)
+	c.Assert(err, IsNil)
+	// oldest first, invalid / dust / pending UTXOs from others are skipped, pending self transactions can be spent
+	c.Assert(utxos, HasLen, 3)
+	c.Assert(utxos[0].TxID, Equals, "a1")
+	c.Assert(utxos[1].TxID, Equals, "c5946215d82d5870ba2b1e8f245d8aa1446783975aa3a592cf55589fccbf285f")
+	c.Assert(utxos[2].TxID, Equals, selfTx)
+
+	balance, err := s.client.GetBalance(pubKey)
+	c.Assert(err, IsNil)
+	// dust is part of the balance, pending UTXO not sent by ourselves / asgard is not
+	c.Assert(balance.Equal(cosmos.NewUint(80001000)), Equals, true)
+}
+
+func (s *UTXOClientTestSuite) TestGetVaultSignerLock(c *C) {
+	pubKey := types.GetRandomPubKey()
+	lock := s.client.GetVaultSignerLock(pubKey.String())
+	c.Assert(lock, NotNil)
+	c.Assert(s.client.GetVaultSignerLock(pubKey.String()) == lock, Equals, true)
+	c.Assert(s.client.GetVaultSignerLock(types.GetRandomPubKey().String()) == lock, Equals, false)
+	c.Assert(s.client.IsYggdrasil(pubKey), Equals, false)
+	c.Assert(s.client.IsYggdrasil(s.client.nodePubKey), Equals, true)
+}
+
+func (s *UTXOClientTestSuite) TestProcessReOrg(c *C) {
+	block := &Block{
+		Height:       1024,
+		Hash:         types.GetRandomTxHash().String(),
+		PreviousHash: types.GetRandomTxHash().String(),
+	}
+	// can't get previous block meta should not error
+	reorgedItems, err := s.client.processReorg(block)
+	c.Assert(err, IsNil)
+	c.Assert(reorgedItems, IsNil)
+
+	// previous block meta matches, should not trigger re-org process
+	previousHeight := block.Height - 1
+	blockMeta := NewBlockMeta(types.GetRandomTxHash().String(), previousHeight, block.PreviousHash)
+	c.Assert(s.client.temporalStorage.SaveBlockMeta(previousHeight, blockMeta), IsNil)
+	reorgedItems, err = s.client.processReorg(block)
+	c.Assert(err, IsNil)
+	c.Assert(reorgedItems, IsNil)
+
+	// the previous block got re-orged, one tx is gone and the other one is still on chain
+	goneTx := types.GetRandomTxHash().String()
+	keptTx := types.GetRandomTxHash().String()
+	s.driver.onChain[keptTx] = true
+	blockMeta = NewBlockMeta(types.GetRandomTxHash().String(), previousHeight, types.GetRandomTxHash().String())
+	blockMeta.AddCustomerTransaction(goneTx)
+	blockMeta.AddCustomerTransaction(keptTx)
+	c.Assert(s.client.temporalStorage.SaveBlockMeta(previousHeight, blockMeta), IsNil)
+	s.driver.blocks[previousHeight] = &Block{
+		Height:       previousHeight,
+		Hash:         block.PreviousHash,
+		PreviousHash: types.GetRandomTxHash().String(),
+	}
+	s.driver.txIns[previousHeight] = []stypes.TxInItem{
+		{
+			BlockHeight: previousHeight,
+			Tx:          types.GetRandomTxHash().String(),
+			Sender:      "bc1q0s4mg25tu6termrk8egltfyme4q7sg3h0e56p3",
+			To:          "bc1q2gjc0rnhy4nrxvuklk6ptwkcs9kcr59mcl2q9j",
+			Coins: common.Coins{
+				common.NewCoin(common.BTCAsset, cosmos.NewUint(123456)),
+			},
+			Memo: "MEMO",
+		},
+	}
+	errataQueue := make(chan stypes.ErrataBlock, 1)
+	s.client.globalErrataQueue = errataQueue
+	reorgedItems, err = s.client.processReorg(block)
+	c.Assert(err, IsNil)
+	c.Assert(reorgedItems, HasLen, 1)
+	c.Assert(reorgedItems[0].TxArray, HasLen, 1)
+	// make sure there is errata block in the queue
+	c.Assert(errataQueue, HasLen, 1)
+	errata := <-errataQueue
+	c.Assert(errata.Height, Equals, previousHeight)
+	c.Assert(errata.Txs, HasLen, 1)
+	c.Assert(errata.Txs[0].TxID.String(), Equals, goneTx)
+	blockMeta, err = s.client.temporalStorage.GetBlockMeta(previousHeight)
+	c.Assert(err, IsNil)
+	c.Assert(blockMeta, NotNil)
+	c.Assert(blockMeta.BlockHash, Equals, block.PreviousHash)
+	c.Assert(blockMeta.CustomerTransactions, DeepEquals, []string{keptTx})
+}
+
+func (s *UTXOClientTestSuite) TestConfirmationCountReady(c *C) {
+	c.Assert(s.client.ConfirmationCountReady(stypes.TxIn{
+		Chain:    common.BTCChain,
+		TxArray:  nil,
+		Filtered: true,
+		MemPool:  false,
+	}), Equals, true)
+	pkey := types.GetRandomPubKey()
+	c.Assert(s.client.ConfirmationCountReady(stypes.TxIn{
+		Chain: common.BTCChain,
+		TxArray: []stypes.TxInItem{
+			{
+				BlockHeight: 2,
+				Tx:          "24ed2d26fd5d4e0e8fa86633e40faf1bdfc8d1903b1cd02855286312d48818a2",
+				Sender:      "bc1q0s4mg25tu6termrk8egltfyme4q7sg3h0e56p3",
+				To:          "bc1q2gjc0rnhy4nrxvuklk6ptwkcs9kcr59mcl2q9j",
+				Coins: common.Coins{
+					common.NewCoin(common.BTCAsset, cosmos.NewUint(123456)),
+				},
+				Memo:                "MEMO",
+				ObservedVaultPubKey: pkey,
+			},
+		},
+		Filtered: true,
+		MemPool:  true,
+	}), Equals, true)
+	s.client.currentBlockHeight.Store(3)
+	c.Assert(s.client.ConfirmationCountReady(stypes.TxIn{
+		Chain: common.BTCChain,
+		TxArray: []stypes.TxInItem{
+			{
+				BlockHeight: 2,
+				Tx:          "24ed2d26fd5d4e0e8fa86633e40faf1bdfc8d1903b1cd02855286312d48818a2",
+				Sender:      "bc1q0s4mg25tu6termrk8egltfyme4q7sg3h0e56p3",
+				To:          "bc1q2gjc0rnhy4nrxvuklk6ptwkcs9kcr59mcl2q9j",
+				Coins: common.Coins{
+					common.NewCoin(common.BTCAsset, cosmos.NewUint(123456)),
+				},
+				Memo:                "MEMO",
+				ObservedVaultPubKey: pkey,
+			},
+		},
+		Filtered:             true,
+		MemPool:              false,
+		ConfirmationRequired: 0,
+	}), Equals, true)
+
+	c.Assert(s.client.ConfirmationCountReady(stypes.TxIn{
+		Chain: common.BTCChain,
+		TxArray: []stypes.TxInItem{
+			{
+				BlockHeight: 2,
+				Tx:          "24ed2d26fd5d4e0e8fa86633e40faf1bdfc8d1903b1cd02855286312d48818a2",
+				Sender:      "bc1q0s4mg25tu6termrk8egltfyme4q7sg3h0e56p3",
+				To:          "bc1q2gjc0rnhy4nrxvuklk6ptwkcs9kcr59mcl2q9j",
+				Coins: common.Coins{
+					common.NewCoin(common.BTCAsset, cosmos.NewUint(12345600000)),
+				},
+				Gas: common.Gas{
+					common.NewCoin(common.BTCAsset, cosmos.NewUint(40000)),
+				},
+				Memo:                "MEMO",
+				ObservedVaultPubKey: pkey,
+			},
+		},
+		Filtered:             true,
+		MemPool:              false,
+		ConfirmationRequired: 5,
+	}), Equals, false)
+}
@@ -0,0 +1,76 @@
+package utxo
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"gitlab.com/thorchain/thornode/bifrost/pkg/chainclients/shared/failover"
+	"gitlab.com/thorchain/thornode/config"
+)
+
+// rpcOptions cross check the reads which determine observations when rpc quorum is
+// enabled, confirmations and the next block hash depend on the tip of the endpoint.
+var rpcOptions = failover.Options{
+	QuorumMethods: []string{"getblockhash", "getblock"},
+	HeightMethod:  "getblockcount",
+	IgnoreFields:  []string{"confirmations", "nextblockhash"},
+}
+
+// RPCEndpoint is the host the rpc client of the chain daemon connects to. When additional
+// rpc hosts are configured it is a local proxy failing over between all of them.
+type RPCEndpoint struct {
+	Host       string
+	DisableTLS bool
+	proxy      *failover.Proxy
+}
+
+// NewRPCEndpoint returns the endpoint the daemon rpc client should connect to, a local
+// proxy is only started when there are additional rpc hosts.
+func NewRPCEndpoint(cfg config.BifrostChainConfiguration) (*RPCEndpoint, error) {
+	if len(cfg.RPCHosts) == 0 {
+		return &RPCEndpoint{
+			Host:       cfg.RPCHost,
+			DisableTLS: cfg.DisableTLS,
+		}, nil
+	}
+	if !cfg.HTTPostMode {
+		return nil, errors.New("rpc hosts require http post mode")
+	}
+
+	scheme := "https://"
+	if cfg.DisableTLS {
+		scheme = "http://"
+	}
+	hosts := make([]string, 0, len(cfg.RPCHosts)+1)
+	for _, host := range append([]string{cfg.RPCHost}, cfg.RPCHosts...) {
+		if host != "" && !strings.Contains(host, "://") {
+			host = scheme + host
+		}
+		hosts = append(hosts, host)
+	}
+	pool, err := failover.NewPool(cfg.ChainID, hosts...)
+	if err != nil {
+		return nil, fmt.Errorf("fail to create rpc endpoint pool: %w", err)
+	}
+
+	opts := rpcOptions
+	opts.Quorum = cfg.RPCQuorum
+	proxy, err := failover.NewProxy(pool, opts)
+	if err != nil {
+		return nil, fmt.Errorf("fail to create rpc proxy: %w", err)
+	}
+	return &RPCEndpoint{
+		Host:       proxy.Host(),
+		DisableTLS: true,
+		proxy:      proxy,
+	}, nil
+}
+
+// Close stops the local proxy of the endpoint, if any
+func (e *RPCEndpoint) Close() error {
+	if e == nil || e.proxy == nil {
+		return nil
+	}
+	return e.proxy.Close()
+}
@@ -0,0 +1,45 @@
+package utxo
+
+import (
+	. "gopkg.in/check.v1"
+
+	"gitlab.com/thorchain/thornode/common"
+	"gitlab.com/thorchain/thornode/config"
+)
+
+type RPCEndpointTestSuite struct{}
+
+var _ = Suite(&RPCEndpointTestSuite{})
+
+func (s *RPCEndpointTestSuite) TestNewRPCEndpoint(c *C) {
+	cfg := config.BifrostChainConfiguration{
+		ChainID:     common.BTCChain,
+		RPCHost:     "localhost:18443",
+		DisableTLS:  true,
+		HTTPostMode: true,
+	}
+
+	// a single host is used as is
+	endpoint, err := NewRPCEndpoint(cfg)
+	c.Assert(err, IsNil)
+	c.Check(endpoint.Host, Equals, "localhost:18443")
+	c.Check(endpoint.DisableTLS, Equals, true)
+	c.Assert(endpoint.Close(), IsNil)
+
+	// additional hosts go through a local proxy
+	cfg.RPCHosts = []string{"localhost:18444"}
+	cfg.DisableTLS = false
+	endpoint, err = NewRPCEndpoint(cfg)
+	c.Assert(err, IsNil)
+	c.Check(endpoint.Host, Not(Equals), "localhost:18443")
+	c.Check(endpoint.DisableTLS, Equals, true)
+	c.Assert(endpoint.Close(), IsNil)
+
+	// the proxy relays http post requests only
+	cfg.HTTPostMode = false
+	_, err = NewRPCEndpoint(cfg)
+	c.Assert(err, NotNil)
+
+	var nilEndpoint *RPCEndpoint
+	c.Assert(nilEndpoint.Close(), IsNil)
+}
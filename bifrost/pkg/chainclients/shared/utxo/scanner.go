@@ -0,0 +1,462 @@
+package utxo
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcutil"
+	"golang.org/x/sync/errgroup"
+	"golang.org/x/sync/semaphore"
+
+	"gitlab.com/thorchain/thornode/bifrost/pkg/chainclients/shared/runners"
+	stypes "gitlab.com/thorchain/thornode/bifrost/thorclient/types"
+	"gitlab.com/thorchain/thornode/common"
+	"gitlab.com/thorchain/thornode/common/cosmos"
+	"gitlab.com/thorchain/thornode/constants"
+	mem "gitlab.com/thorchain/thornode/x/thorchain/memo"
+)
+
+// FetchMemPool retrieves txs from mempool
+func (c *Client) FetchMemPool(height int64) (stypes.TxIn, error) {
+	hashes, err := c.driver.GetMempoolTxIDs()
+	if err != nil {
+		return stypes.TxIn{}, fmt.Errorf("fail to get tx hashes from mempool: %w", err)
+	}
+	txIn := stypes.TxIn{
+		Chain:   c.chain,
+		MemPool: true,
+	}
+	maxWorker := c.cfg.ParallelMempoolScan
+	if maxWorker == 0 {
+		// set default worker to 5
+		maxWorker = 5
+	}
+	sem := semaphore.NewWeighted(int64(maxWorker))
+	g, _ := errgroup.WithContext(context.Background())
+	total := 0
+	lock := &sync.Mutex{}
+	for _, h := range hashes {
+		// this hash had been processed before , ignore it
+		if !c.tryAddToMemPoolCache(h) {
+			c.logger.Debug().Msgf("%s had been processed , ignore", h)
+			continue
+		}
+		// closure
+		txHash := h
+		g.Go(func() error {
+			ctx := context.TODO()
+			if err := sem.Acquire(ctx, 1); err != nil {
+				return fmt.Errorf("fail to acquire semaphore: %w", err)
+			}
+			defer sem.Release(1)
+			defer func(startTime time.Time) {
+				c.logger.Debug().Msgf("time to scan one tx in mempool: %s", time.Since(startTime).String())
+			}(time.Now())
+			c.logger.Debug().Msgf("process hash %s", txHash)
+			// if the client fail to get the transaction , it could be the transaction removed , of the local node fail to
+			// respond with the transaction, it is safe to ignore it , and continue with the rest
+			txInItem, err := c.driver.GetMempoolTxIn(txHash, height)
+			if err != nil {
+				c.logger.Debug().Err(err).Msg("fail to get TxInItem")
+				return nil
+			}
+			if txInItem.IsEmpty() || txInItem.Coins.IsEmpty() {
+				return nil
+			}
+			lock.Lock()
+			defer lock.Unlock()
+			txIn.TxArray = append(txIn.TxArray, txInItem)
+			return nil
+		})
+		total++
+		// even it didn't finish scan all the mempool tx, but still yield here , so as block scanner can continue to scan a committed block
+		if total >= MaxMempoolScanPerTry {
+			break
+		}
+	}
+	if err := g.Wait(); err != nil {
+		c.logger.Err(err).Msgf("fail to scan mempool")
+	}
+	txIn.Count = strconv.Itoa(len(txIn.TxArray))
+	return txIn, nil
+}
+
+// FetchTxs retrieves txs for a block height
+func (c *Client) FetchTxs(height, chainHeight int64) (stypes.TxIn, error) {
+	txIn := stypes.TxIn{
+		Chain:   c.chain,
+		TxArray: nil,
+	}
+	block, err := c.driver.GetBlock(height)
+	if err != nil {
+		return txIn, fmt.Errorf("fail to get block: %w", err)
+	}
+
+	// if somehow the block is not valid
+	if block.Hash == "" && block.PreviousHash == "" {
+		return txIn, fmt.Errorf("fail to get block: %w", err)
+	}
+	c.currentBlockHeight.Store(height)
+	reScannedTxs, err := c.processReorg(block)
+	if err != nil {
+		c.logger.Err(err).Msgf("fail to process %s re-org", c.chain)
+	}
+	for _, item := range reScannedTxs {
+		if len(item.TxArray) == 0 {
+			continue
+		}
+		txIn.TxArray = append(txIn.TxArray, item.TxArray...)
+	}
+
+	blockMeta, err := c.temporalStorage.GetBlockMeta(block.Height)
+	if err != nil {
+		return txIn, fmt.Errorf("fail to get block meta from storage: %w", err)
+	}
+	if blockMeta == nil {
+		blockMeta = NewBlockMeta(block.PreviousHash, block.Height, block.Hash)
+	} else {
+		blockMeta.PreviousHash = block.PreviousHash
+		blockMeta.BlockHash = block.Hash
+	}
+
+	if err := c.temporalStorage.SaveBlockMeta(block.Height, blockMeta); err != nil {
+		return txIn, fmt.Errorf("fail to save block meta into storage: %w", err)
+	}
+	pruneHeight := height - c.settings.BlockCacheSize
+	if pruneHeight > 0 {
+		defer func() {
+			if err := c.temporalStorage.PruneBlockMeta(pruneHeight, c.canDeleteBlock); err != nil {
+				c.logger.Err(err).Msgf("fail to prune block meta, height(%d)", pruneHeight)
+			}
+		}()
+	}
+
+	txInBlock := c.extractTxs(block)
+	if len(txInBlock.TxArray) > 0 {
+		txIn.TxArray = append(txIn.TxArray, txInBlock.TxArray...)
+	}
+	c.driver.UpdateNetworkInfo()
+
+	// report network fee and solvency if within flexibility blocks of tip
+	if chainHeight-height <= c.cfg.BlockScanner.ObservationFlexibilityBlocks {
+		if err := c.driver.SendNetworkFee(block); err != nil {
+			c.logger.Err(err).Msg("fail to send network fee")
+		}
+		if c.IsBlockScannerHealthy() {
+			if err := c.ReportSolvency(height); err != nil {
+				c.logger.Err(err).Msgf("fail to send solvency info to THORChain")
+			}
+		}
+	}
+
+	txIn.Count = strconv.Itoa(len(txIn.TxArray))
+	// try to consolidate UTXOs
+	c.ConsolidateUTXOs()
+	return txIn, nil
+}
+
+// extractTxs extracts txs from a block to type TxIn
+func (c *Client) extractTxs(block *Block) stypes.TxIn {
+	txIn := stypes.TxIn{
+		Chain:   c.chain,
+		MemPool: false,
+	}
+	// mempool transaction get committed to block , thus remove it from mempool cache
+	for _, hash := range block.TxHashes {
+		c.removeFromMemPoolCache(hash)
+	}
+	var txItems []stypes.TxInItem
+	for _, txInItem := range c.driver.GetBlockTxIns(block) {
+		if txInItem.IsEmpty() {
+			continue
+		}
+		if txInItem.Coins.IsEmpty() {
+			continue
+		}
+		if txInItem.Coins[0].Amount.LT(c.chain.DustThreshold()) {
+			continue
+		}
+		exist, err := c.temporalStorage.TrackObservedTx(txInItem.Tx)
+		if err != nil {
+			c.logger.Err(err).Msgf("fail to determinate whether hash(%s) had been observed before", txInItem.Tx)
+		}
+		if !exist {
+			c.logger.Info().Msgf("tx: %s had been report before, ignore", txInItem.Tx)
+			if err := c.temporalStorage.UntrackObservedTx(txInItem.Tx); err != nil {
+				c.logger.Err(err).Msgf("fail to remove observed tx from cache: %s", txInItem.Tx)
+			}
+			continue
+		}
+		txItems = append(txItems, txInItem)
+	}
+	txIn.TxArray = txItems
+	txIn.Count = strconv.Itoa(len(txItems))
+	return txIn
+}
+
+func (c *Client) processReorg(block *Block) ([]stypes.TxIn, error) {
+	previousHeight := block.Height - 1
+	prevBlockMeta, err := c.temporalStorage.GetBlockMeta(previousHeight)
+	if err != nil {
+		return nil, fmt.Errorf("fail to get block meta of height(%d) : %w", previousHeight, err)
+	}
+	if prevBlockMeta == nil {
+		return nil, nil
+	}
+	// the block's previous hash need to be the same as the block hash chain client recorded in block meta
+	// blockMetas[PreviousHeight].BlockHash == Block.PreviousHash
+	if strings.EqualFold(prevBlockMeta.BlockHash, block.PreviousHash) {
+		return nil, nil
+	}
+
+	c.logger.Info().Msgf("re-org detected, current block height:%d ,previous block hash is : %s , however block meta at height: %d, block hash is %s", block.Height, block.PreviousHash, prevBlockMeta.Height, prevBlockMeta.BlockHash)
+	blockHeights, err := c.reConfirmTx()
+	if err != nil {
+		c.logger.Err(err).Msgf("fail to reprocess all txs")
+	}
+	var txIns []stypes.TxIn
+	for _, item := range blockHeights {
+		c.logger.Info().Msgf("rescan block height: %d", item)
+		b, err := c.driver.GetBlock(item)
+		if err != nil {
+			c.logger.Err(err).Msgf("fail to get block from RPC for height:%d", item)
+			continue
+		}
+		txIn := c.extractTxs(b)
+		if len(txIn.TxArray) == 0 {
+			continue
+		}
+		txIns = append(txIns, txIn)
+	}
+	return txIns, nil
+}
+
+// reConfirmTx will be kicked off only when chain client detected a re-org on the chain
+// it will read through all the block meta data from local storage , and go through all the UTXOs.
+// For each UTXO , it will send a RPC request to the chain , double check whether the TX exist or not
+// if the tx still exist , then it is all good, if a transaction previous we detected , however doesn't exist anymore , that means
+// the transaction had been removed from chain,  chain client should report to thorchain
+func (c *Client) reConfirmTx() ([]int64, error) {
+	blockMetas, err := c.temporalStorage.GetBlockMetas()
+	if err != nil {
+		return nil, fmt.Errorf("fail to get block metas from local storage: %w", err)
+	}
+	var rescanBlockHeights []int64
+	for _, blockMeta := range blockMetas {
+		var errataTxs []stypes.ErrataTx
+		for _, tx := range blockMeta.CustomerTransactions {
+			exist, err := c.driver.IsTxOnChain(tx)
+			if err != nil {
+				c.logger.Info().Err(err).Msgf("%s invalid transaction hash", tx)
+				continue
+			}
+			if exist {
+				c.logger.Info().Msgf("block height: %d, tx: %s still exist", blockMeta.Height, tx)
+				continue
+			}
+			// this means the tx doesn't exist in chain ,thus should errata it
+			errataTxs = append(errataTxs, stypes.ErrataTx{
+				TxID:  common.TxID(tx),
+				Chain: c.chain,
+			})
+			blockMeta.RemoveCustomerTransaction(tx)
+		}
+		if len(errataTxs) > 0 {
+			c.globalErrataQueue <- stypes.ErrataBlock{
+				Height: blockMeta.Height,
+				Txs:    errataTxs,
+			}
+		}
+		// Let's get the block again to fix the block hash
+		r, err := c.driver.GetBlock(blockMeta.Height)
+		if err != nil {
+			c.logger.Err(err).Msgf("fail to get block verbose tx result: %d", blockMeta.Height)
+			continue
+		}
+		if !strings.EqualFold(blockMeta.BlockHash, r.Hash) {
+			rescanBlockHeights = append(rescanBlockHeights, blockMeta.Height)
+		}
+		blockMeta.PreviousHash = r.PreviousHash
+		blockMeta.BlockHash = r.Hash
+		if err := c.temporalStorage.SaveBlockMeta(blockMeta.Height, blockMeta); err != nil {
+			c.logger.Err(err).Msgf("fail to save block meta of height: %d ", blockMeta.Height)
+		}
+	}
+	return rescanBlockHeights, nil
+}
+
+func (c *Client) canDeleteBlock(blockMeta *BlockMeta) bool {
+	if blockMeta == nil {
+		return true
+	}
+	for _, tx := range blockMeta.SelfTransactions {
+		if c.driver.IsTxInMempool(tx) {
+			c.logger.Info().Msgf("tx: %s still in mempool , block can't be deleted", tx)
+			return false
+		}
+	}
+	return true
+}
+
+func (c *Client) removeFromMemPoolCache(hash string) {
+	if err := c.temporalStorage.UntrackMempoolTx(hash); err != nil {
+		c.logger.Err(err).Msgf("fail to remove %s from mempool cache", hash)
+	}
+}
+
+func (c *Client) tryAddToMemPoolCache(hash string) bool {
+	exist, err := c.temporalStorage.TrackMempoolTx(hash)
+	if err != nil {
+		c.logger.Err(err).Msgf("fail to add mempool hash to key value store")
+	}
+	return exist
+}
+
+// OnObservedTxIn gets called from observer when we have a valid observation
+// For UTXO chain client we want to save the utxo we can spend later to sign
+func (c *Client) OnObservedTxIn(txIn stypes.TxInItem, blockHeight int64) {
+	hash, err := chainhash.NewHashFromStr(txIn.Tx)
+	if err != nil {
+		c.logger.Error().Err(err).Str("txID", txIn.Tx).Msg("fail to add spendable utxo to storage")
+		return
+	}
+	blockMeta, err := c.temporalStorage.GetBlockMeta(blockHeight)
+	if err != nil {
+		c.logger.Err(err).Msgf("fail to get block meta on block height(%d)", blockHeight)
+		return
+	}
+	if blockMeta == nil {
+		blockMeta = NewBlockMeta("", blockHeight, "")
+	}
+	if _, err := c.temporalStorage.TrackObservedTx(txIn.Tx); err != nil {
+		c.logger.Err(err).Msgf("fail to add hash (%s) to observed tx cache", txIn.Tx)
+	}
+	if c.IsAsgardAddress(txIn.Sender) {
+		c.logger.Debug().Msgf("add hash %s as self transaction,block height:%d", hash.String(), blockHeight)
+		blockMeta.AddSelfTransaction(hash.String())
+	} else {
+		// add the transaction to block meta
+		blockMeta.AddCustomerTransaction(hash.String())
+	}
+	if err := c.temporalStorage.SaveBlockMeta(blockHeight, blockMeta); err != nil {
+		c.logger.Err(err).Msgf("fail to save block meta to storage,block height(%d)", blockHeight)
+	}
+	// update the signer cache
+	m, err := mem.ParseMemo(common.LatestVersion, txIn.Memo)
+	if err != nil {
+		// Debug log only as ParseMemo error is expected for THORName inbounds.
+		c.logger.Debug().Err(err).Msgf("fail to parse memo: %s", txIn.Memo)
+		return
+	}
+	if !m.IsOutbound() {
+		return
+	}
+	if m.GetTxID().IsEmpty() {
+		return
+	}
+	if err := c.signerCacheManager.SetSigned(txIn.CacheHash(c.chain, m.GetTxID().String()), txIn.Tx); err != nil {
+		c.logger.Err(err).Msg("fail to update signer cache")
+	}
+}
+
+// ReportSolvency reports the balance of the asgard vaults that are insolvent, or all of them
+// when the block scanner is unhealthy
+func (c *Client) ReportSolvency(blockHeight int64) error {
+	if !c.ShouldReportSolvency(blockHeight) {
+		return nil
+	}
+	asgardVaults, err := c.bridge.GetAsgards()
+	if err != nil {
+		return fmt.Errorf("fail to get asgards,err: %w", err)
+	}
+	for _, asgard := range asgardVaults {
+		acct, err := c.GetAccount(asgard.PubKey, nil)
+		if err != nil {
+			c.logger.Err(err).Msgf("fail to get account balance")
+			continue
+		}
+
+		if runners.IsVaultSolvent(acct, asgard, cosmos.NewUint(3*c.settings.EstimateAverageTxSize*c.driver.GetNetworkFeeRate())) && c.IsBlockScannerHealthy() {
+			// when vault is solvent , don't need to report solvency
+			continue
+		}
+
+		select {
+		case c.globalSolvencyQueue <- stypes.Solvency{
+			Height: blockHeight,
+			Chain:  c.chain,
+			PubKey: asgard.PubKey,
+			Coins:  acct.Coins,
+		}:
+		case <-time.After(constants.ThorchainBlockTime):
+			c.logger.Info().Msgf("fail to send solvency info to THORChain, timeout")
+		}
+	}
+	c.lastSolvencyCheckHeight = blockHeight
+	return nil
+}
+
+// ShouldReportSolvency based on the given block height , should the client report solvency to THORNode
+func (c *Client) ShouldReportSolvency(height int64) bool {
+	return c.driver.ShouldReportSolvency(height, c.lastSolvencyCheckHeight)
+}
+
+// getBlockRequiredConfirmation find out how many confirmation the given txIn need to have before it can be send to THORChain
+func (c *Client) getBlockRequiredConfirmation(txIn stypes.TxIn, height int64) (int64, error) {
+	totalTxValue := txIn.GetTotalTransactionValue(c.chain.GetGasAsset(), c.CachedAsgardAddresses())
+	totalFeeAndSubsidy, err := c.driver.GetCoinbaseValue(height)
+	if err != nil {
+		c.logger.Err(err).Msg("fail to get coinbase value")
+	}
+	if totalFeeAndSubsidy == 0 {
+		cbValue, err := btcutil.NewAmount(c.chain.DefaultCoinbase())
+		if err != nil {
+			return 0, fmt.Errorf("fail to get default coinbase value: %w", err)
+		}
+		totalFeeAndSubsidy = int64(cbValue)
+	}
+	confirm := totalTxValue.QuoUint64(uint64(totalFeeAndSubsidy)).Uint64()
+	c.logger.Info().Msgf("totalTxValue:%s,total fee and Subsidy:%d,confirmation:%d", totalTxValue, totalFeeAndSubsidy, confirm)
+	return int64(confirm), nil
+}
+
+// GetConfirmationCount return the number of blocks the tx need to wait before processing in THORChain
+func (c *Client) GetConfirmationCount(txIn stypes.TxIn) int64 {
+	if len(txIn.TxArray) == 0 {
+		return 0
+	}
+	// MemPool items doesn't need confirmation
+	if txIn.MemPool {
+		return 0
+	}
+	blockHeight := txIn.TxArray[0].BlockHeight
+	confirm, err := c.getBlockRequiredConfirmation(txIn, blockHeight)
+	c.logger.Info().Msgf("confirmation required: %d", confirm)
+	if err != nil {
+		c.logger.Err(err).Msg("fail to get block confirmation ")
+		return 0
+	}
+	return confirm
+}
+
+// ConfirmationCountReady will be called by observer before send the txIn to thorchain
+// confirmation counting is on block level , refer to https://medium.com/coinmonks/1confvalue-a-simple-pow-confirmation-rule-of-thumb-a8d9c6c483dd for detail
+func (c *Client) ConfirmationCountReady(txIn stypes.TxIn) bool {
+	if len(txIn.TxArray) == 0 {
+		return true
+	}
+	// MemPool items doesn't need confirmation
+	if txIn.MemPool {
+		return true
+	}
+	blockHeight := txIn.TxArray[0].BlockHeight
+	confirm := txIn.ConfirmationRequired
+	c.logger.Info().Msgf("confirmation required: %d", confirm)
+	// every tx in txIn already have at least 1 confirmation
+	return (c.currentBlockHeight.Load() - blockHeight) >= confirm
+}
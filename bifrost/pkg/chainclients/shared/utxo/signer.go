@@ -1,14 +1,18 @@
 package utxo
 
 import (
+	"encoding/json"
 	"errors"
 	"fmt"
+	"sync"
 
 	"github.com/hashicorp/go-multierror"
 	"github.com/rs/zerolog"
 	"gitlab.com/thorchain/thornode/bifrost/thorclient"
 	stypes "gitlab.com/thorchain/thornode/bifrost/thorclient/types"
 	"gitlab.com/thorchain/thornode/bifrost/tss"
+	"gitlab.com/thorchain/thornode/common"
+	"gitlab.com/thorchain/thornode/common/cosmos"
 )
 
 // SignCheckpoint is used to checkpoint the built transaction before signing, for use in
@@ -18,6 +22,198 @@ type SignCheckpoint struct {
 	IndividualAmounts map[string]int64 `json:"individual_amounts"`
 }
 
+// SignableTx is a transaction built by a Driver, its inputs are signed by the Client
+type SignableTx interface {
+	// Inputs returns the "txid-vout" key of each input, in order
+	Inputs() []string
+	// SignInput signs the input at the given index, which spends the given amount, the
+	// inputs are signed in parallel
+	SignInput(idx int, amount int64) error
+	// Outputs returns the value of each output in sats, in order
+	Outputs() []int64
+	// TxID returns the hash of the transaction
+	TxID() string
+	// Serialize returns the transaction in the wire format
+	Serialize() ([]byte, error)
+}
+
+// SignTx builds and signs the outbound transaction. Returns the signed transaction, a
+// serialized checkpoint on error, a corresponding observation tx, and an error.
+func (c *Client) SignTx(tx stypes.TxOutItem, thorchainHeight int64) ([]byte, []byte, *stypes.TxInItem, error) {
+	if !tx.Chain.Equals(c.chain) {
+		return nil, nil, nil, fmt.Errorf("not %s chain", c.chain)
+	}
+
+	// skip outbounds without coins
+	if tx.Coins.IsEmpty() {
+		return nil, nil, nil, nil
+	}
+
+	// skip outbounds that have been signed
+	if c.signerCacheManager.HasSigned(tx.CacheHash()) {
+		c.logger.Info().Msgf("transaction(%+v), signed before , ignore", tx)
+		return nil, nil, nil, nil
+	}
+
+	// only one keysign per chain at a time
+	vaultSignerLock := c.GetVaultSignerLock(tx.VaultPubKey.String())
+	if vaultSignerLock == nil {
+		c.logger.Error().Msgf("fail to get signer lock for vault pub key: %s", tx.VaultPubKey.String())
+		return nil, nil, nil, fmt.Errorf("fail to get signer lock")
+	}
+	vaultSignerLock.Lock()
+	defer vaultSignerLock.Unlock()
+
+	// verify output address
+	ok, err := c.driver.CheckToAddress(tx.ToAddress)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	if !ok {
+		return nil, nil, nil, nil
+	}
+
+	// load from checkpoint if it exists
+	checkpoint := SignCheckpoint{}
+	var redeemTx SignableTx
+	if tx.Checkpoint != nil {
+		if err := json.Unmarshal(tx.Checkpoint, &checkpoint); err != nil {
+			return nil, nil, nil, fmt.Errorf("fail to unmarshal checkpoint: %w", err)
+		}
+		redeemTx, err = c.driver.DecodeTx(tx, checkpoint.UnsignedTx)
+		if err != nil {
+			return nil, nil, nil, fmt.Errorf("fail to deserialize tx: %w", err)
+		}
+	} else {
+		redeemTx, checkpoint.IndividualAmounts, err = c.driver.BuildTx(tx)
+		if err != nil {
+			return nil, nil, nil, err
+		}
+		checkpoint.UnsignedTx, err = redeemTx.Serialize()
+		if err != nil {
+			return nil, nil, nil, fmt.Errorf("fail to serialize tx: %w", err)
+		}
+	}
+
+	// serialize the checkpoint for later
+	checkpointBytes, err := json.Marshal(checkpoint)
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf("fail to marshal checkpoint: %w", err)
+	}
+
+	inputs := redeemTx.Inputs()
+	wg := &sync.WaitGroup{}
+	errLock := &sync.Mutex{}
+	var utxoErr error
+	c.logger.Info().Msgf("UTXOs to sign: %d", len(inputs))
+
+	totalAmount := int64(0)
+	for idx, key := range inputs {
+		outputAmount := checkpoint.IndividualAmounts[key]
+		totalAmount += outputAmount
+		wg.Add(1)
+		go func(i int, amount int64) {
+			defer wg.Done()
+			if err := redeemTx.SignInput(i, amount); err != nil {
+				errLock.Lock()
+				defer errLock.Unlock()
+				if nil == utxoErr {
+					utxoErr = err
+				} else {
+					utxoErr = multierror.Append(utxoErr, err)
+				}
+			}
+		}(idx, outputAmount)
+	}
+	wg.Wait()
+
+	if utxoErr != nil {
+		err = PostKeysignFailure(c.bridge, tx, c.logger, thorchainHeight, utxoErr)
+		return nil, checkpointBytes, nil, fmt.Errorf("fail to sign the message: %w", err)
+	}
+	signedTx, err := redeemTx.Serialize()
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf("fail to serialize tx to bytes: %w", err)
+	}
+	c.logger.Info().Msgf("final size: %d", len(signedTx))
+
+	// create the observation to be sent by the signer before broadcast
+	chainHeight, err := c.driver.GetHeight()
+	if err != nil { // fall back to the scanner height, thornode voter does not use height
+		chainHeight = c.currentBlockHeight.Load()
+	}
+	outputs := redeemTx.Outputs()
+	amt := outputs[0] // the first output is the outbound amount
+	gas := totalAmount
+	for _, value := range outputs { // subtract all vouts to from vins to get the gas
+		gas -= value
+	}
+	var txIn *stypes.TxInItem
+	sender, err := tx.VaultPubKey.GetAddress(tx.Chain)
+	if err == nil {
+		txIn = stypes.NewTxInItem(
+			chainHeight+1,
+			redeemTx.TxID(),
+			tx.Memo,
+			sender.String(),
+			tx.ToAddress.String(),
+			common.NewCoins(
+				common.NewCoin(c.chain.GetGasAsset(), cosmos.NewUint(uint64(amt))),
+			),
+			common.Gas(common.NewCoins(
+				common.NewCoin(c.chain.GetGasAsset(), cosmos.NewUint(uint64(gas))),
+			)),
+			tx.VaultPubKey,
+			"",
+			"",
+			nil,
+		)
+	}
+
+	return signedTx, nil, txIn, nil
+}
+
+// BroadcastTx will broadcast the given payload to the chain
+func (c *Client) BroadcastTx(txOut stypes.TxOutItem, payload []byte) (string, error) {
+	height, err := c.driver.GetHeight()
+	if err != nil {
+		return "", fmt.Errorf("fail to get block height: %w", err)
+	}
+	bm, err := c.temporalStorage.GetBlockMeta(height)
+	if err != nil {
+		c.logger.Err(err).Msgf("fail to get blockmeta for heigth: %d", height)
+	}
+	if bm == nil {
+		bm = NewBlockMeta("", height, "")
+	}
+	defer func() {
+		if err := c.temporalStorage.SaveBlockMeta(height, bm); err != nil {
+			c.logger.Err(err).Msg("fail to save block metadata")
+		}
+	}()
+	// broadcast tx
+	txHash, err := c.driver.SendRawTransaction(payload)
+	if err != nil {
+		if errors.Is(err, ErrTxAlreadyInChain) {
+			// this means the tx had been broadcast to chain, it must be another signer finished quicker then us
+			c.logger.Info().Str("hash", txHash).Msgf("broadcast to %s chain by another node", c.chain)
+			if err := c.signerCacheManager.SetSigned(txOut.CacheHash(), txHash); err != nil {
+				c.logger.Err(err).Msgf("fail to mark tx out item (%+v) as signed", txOut)
+			}
+			return txHash, nil
+		}
+
+		return "", fmt.Errorf("fail to broadcast transaction to chain: %w", err)
+	}
+	// save tx id to block meta in case we need to errata later
+	bm.AddSelfTransaction(txHash)
+	c.logger.Info().Str("hash", txHash).Msgf("broadcast to %s chain successfully", c.chain)
+	if err := c.signerCacheManager.SetSigned(txOut.CacheHash(), txHash); err != nil {
+		c.logger.Err(err).Msgf("fail to mark tx out item (%+v) as signed", txOut)
+	}
+	return txHash, nil
+}
+
 func PostKeysignFailure(
 	thorchainBridge thorclient.ThorchainBridge,
 	tx stypes.TxOutItem,
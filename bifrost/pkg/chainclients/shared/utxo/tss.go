@@ -0,0 +1,87 @@
+package utxo
+
+import (
+	"encoding/base64"
+	"fmt"
+	"math/big"
+
+	"github.com/btcsuite/btcd/btcec"
+	"github.com/cosmos/cosmos-sdk/crypto/codec"
+	"github.com/rs/zerolog"
+	"github.com/rs/zerolog/log"
+	"github.com/tendermint/tendermint/crypto/secp256k1"
+
+	"gitlab.com/thorchain/thornode/bifrost/tss"
+	"gitlab.com/thorchain/thornode/common"
+	"gitlab.com/thorchain/thornode/common/cosmos"
+)
+
+// TssSigner signs payloads with the tss key of a vault. All UTXO chains sign with
+// secp256k1, the chain clients only wrap the signature and public key in the types
+// of their own btcec fork.
+type TssSigner struct {
+	poolPubKey    common.PubKey
+	tssKeyManager tss.ThorchainKeyManager
+	logger        zerolog.Logger
+}
+
+// NewTssSigner create a new instance of TssSigner
+func NewTssSigner(pubKey common.PubKey, manager tss.ThorchainKeyManager) *TssSigner {
+	return &TssSigner{
+		poolPubKey:    pubKey,
+		tssKeyManager: manager,
+		logger:        log.Logger.With().Str("module", "tss_signable").Logger(),
+	}
+}
+
+// Sign the given payload, returns the R and S of the signature
+func (ts *TssSigner) Sign(payload []byte) (*big.Int, *big.Int, error) {
+	ts.logger.Info().Msgf("msg to sign: %s", base64.StdEncoding.EncodeToString(payload))
+	result, _, err := ts.tssKeyManager.RemoteSign(payload, ts.poolPubKey.String())
+	if err != nil {
+		return nil, nil, err
+	}
+	sig := btcec.Signature{
+		R: new(big.Int).SetBytes(result[:32]),
+		S: new(big.Int).SetBytes(result[32:]),
+	}
+	// let's verify the signature
+	if pubKey, err := ts.parsePubKey(); err == nil && sig.Verify(payload, pubKey) {
+		ts.logger.Info().Msg("we can verify the signature successfully")
+	} else {
+		ts.logger.Info().Msg("the signature can't be verified")
+	}
+	return sig.R, sig.S, nil
+}
+
+// GetPubKey returns the compressed secp256k1 public key of the vault, nil when the
+// pool pubkey isn't a secp256k1 key
+func (ts *TssSigner) GetPubKey() []byte {
+	pubKey, err := ts.parsePubKey()
+	if err != nil {
+		ts.logger.Err(err).Str("pubkey", ts.poolPubKey.String()).Msg("fail to get public key")
+		return nil
+	}
+	return pubKey.SerializeCompressed()
+}
+
+func (ts *TssSigner) parsePubKey() (*btcec.PublicKey, error) {
+	cpk, err := cosmos.GetPubKeyFromBech32(cosmos.Bech32PubKeyTypeAccPub, ts.poolPubKey.String())
+	if err != nil {
+		return nil, fmt.Errorf("fail to get pubic key from the bech32 pool public key string: %w", err)
+	}
+	secpPubKey, err := codec.ToTmPubKeyInterface(cpk)
+	if err != nil {
+		return nil, fmt.Errorf("%s is not a secp256 k1 public key: %w", ts.poolPubKey, err)
+	}
+	pubKey, err := btcec.ParsePubKey(secpPubKey.Bytes(), btcec.S256())
+	if err != nil {
+		return nil, fmt.Errorf("fail to parse public key: %w", err)
+	}
+	return pubKey, nil
+}
+
+// GetBech32AccountPubKey convert the given compressed secp256k1 public key to a pubkey
+func GetBech32AccountPubKey(compressed []byte) (common.PubKey, error) {
+	return common.NewPubKeyFromCrypto(secp256k1.PubKey(compressed))
+}
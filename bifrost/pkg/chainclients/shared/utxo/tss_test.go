@@ -0,0 +1,42 @@
+package utxo
+
+import (
+	"github.com/btcsuite/btcd/btcec"
+	. "gopkg.in/check.v1"
+
+	"gitlab.com/thorchain/thornode/bifrost/tss"
+	"gitlab.com/thorchain/thornode/common"
+	"gitlab.com/thorchain/thornode/x/thorchain/types"
+)
+
+type TssSignerTestSuite struct{}
+
+var _ = Suite(&TssSignerTestSuite{})
+
+func (s *TssSignerTestSuite) SetUpSuite(c *C) {
+	types.SetupConfigForTest()
+}
+
+func (s *TssSignerTestSuite) TestTssSigner(c *C) {
+	// the mock key manager signs with the key of this vault
+	pubKey, err := common.NewPubKey("tthorpub1addwnpepqwznsrgk2t5vn2cszr6ku6zned6tqxknugzw3vhdcjza284d7djp5rql6vn")
+	c.Assert(err, IsNil)
+	signer := NewTssSigner(pubKey, &tss.MockThorchainKeyManager{})
+
+	buf := signer.GetPubKey()
+	c.Assert(buf, HasLen, 33)
+	_, err = btcec.ParsePubKey(buf, btcec.S256())
+	c.Assert(err, IsNil)
+	bech32PubKey, err := GetBech32AccountPubKey(buf)
+	c.Assert(err, IsNil)
+	c.Check(bech32PubKey.Equals(pubKey), Equals, true)
+
+	r, sig, err := signer.Sign([]byte("payload"))
+	c.Assert(err, IsNil)
+	c.Check(r.Sign(), Equals, 1)
+	c.Check(sig.Sign(), Equals, 1)
+
+	// not a secp256k1 pubkey
+	signer = NewTssSigner(common.PubKey("bogus"), &tss.MockThorchainKeyManager{})
+	c.Check(signer.GetPubKey(), IsNil)
+}
@@ -18,6 +18,7 @@ type TxIn struct {
 	MemPool              bool         `json:"mem_pool"`          // indicate whether this item is in the mempool or not
 	SentUnFinalised      bool         `json:"sent_un_finalised"` // indicate whehter unfinalised tx had been sent to THORChain
 	Finalised            bool         `json:"finalised"`
+	Pending              bool         `json:"pending"` // indicate the tx is not in a block yet, thus can only be sent to THORChain as not finalised
 	ConfirmationRequired int64        `json:"confirmation_required"`
 }
 
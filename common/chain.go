@@ -82,6 +82,17 @@ func (c Chain) Equals(c2 Chain) bool {
 	return strings.EqualFold(c.String(), c2.String())
 }
 
+// IsKnown returns true when the chain is one of the chains THORChain knows
+// about
+func (c Chain) IsKnown() bool {
+	for _, chain := range AllChains {
+		if c.Equals(chain) {
+			return true
+		}
+	}
+	return false
+}
+
 func (c Chain) IsTHORChain() bool {
 	return c.Equals(THORChain)
 }
@@ -26,6 +26,8 @@ func (s ChainSuite) TestChain(c *C) {
 	chains := Chains{"BNB", "BNB", "BTC"}
 	c.Check(chains.Has("BTC"), Equals, true)
 	c.Check(chains.Has("ETH"), Equals, false)
+	c.Check(BTCChain.IsKnown(), Equals, true)
+	c.Check(Chain("WALLET").IsKnown(), Equals, false)
 	uniq := chains.Distinct()
 	c.Assert(uniq, HasLen, 2)
 
@@ -242,6 +242,70 @@ func Init() {
 		"bifrost.chains.GAIA.block_scanner.cosmos_grpc_tls",
 		"GAIA_GRPC_TLS",
 	))
+	assert(viper.BindEnv(
+		"bifrost.chains.BTC.rpc_hosts",
+		"BTC_HOSTS",
+	))
+	assert(viper.BindEnv(
+		"bifrost.chains.BTC.block_scanner.rpc_hosts",
+		"BTC_HOSTS",
+	))
+	assert(viper.BindEnv(
+		"bifrost.chains.ETH.rpc_hosts",
+		"ETH_HOSTS",
+	))
+	assert(viper.BindEnv(
+		"bifrost.chains.ETH.block_scanner.rpc_hosts",
+		"ETH_HOSTS",
+	))
+	assert(viper.BindEnv(
+		"bifrost.chains.AVAX.rpc_hosts",
+		"AVAX_HOSTS",
+	))
+	assert(viper.BindEnv(
+		"bifrost.chains.AVAX.block_scanner.rpc_hosts",
+		"AVAX_HOSTS",
+	))
+	assert(viper.BindEnv(
+		"bifrost.chains.DOGE.rpc_hosts",
+		"DOGE_HOSTS",
+	))
+	assert(viper.BindEnv(
+		"bifrost.chains.DOGE.block_scanner.rpc_hosts",
+		"DOGE_HOSTS",
+	))
+	assert(viper.BindEnv(
+		"bifrost.chains.GAIA.rpc_hosts",
+		"GAIA_HOSTS",
+	))
+	assert(viper.BindEnv(
+		"bifrost.chains.GAIA.block_scanner.rpc_hosts",
+		"GAIA_HOSTS",
+	))
+	assert(viper.BindEnv(
+		"bifrost.chains.LTC.rpc_hosts",
+		"LTC_HOSTS",
+	))
+	assert(viper.BindEnv(
+		"bifrost.chains.LTC.block_scanner.rpc_hosts",
+		"LTC_HOSTS",
+	))
+	assert(viper.BindEnv(
+		"bifrost.chains.BCH.rpc_hosts",
+		"BCH_HOSTS",
+	))
+	assert(viper.BindEnv(
+		"bifrost.chains.BCH.block_scanner.rpc_hosts",
+		"BCH_HOSTS",
+	))
+	assert(viper.BindEnv(
+		"bifrost.chains.GAIA.cosmos_grpc_hosts",
+		"GAIA_GRPC_HOSTS",
+	))
+	assert(viper.BindEnv(
+		"bifrost.chains.GAIA.block_scanner.cosmos_grpc_hosts",
+		"GAIA_GRPC_HOSTS",
+	))
 	assert(viper.BindEnv("bifrost.chains.GAIA.disabled", "GAIA_DISABLED"))
 	assert(viper.BindEnv("bifrost.chains.DOGE.disabled", "DOGE_DISABLED"))
 	assert(viper.BindEnv("bifrost.chains.LTC.disabled", "LTC_DISABLED"))
@@ -414,6 +478,19 @@ type Thornode struct {
 	API struct {
 		LimitCount    float64       `mapstructure:"limit_count"`
 		LimitDuration time.Duration `mapstructure:"limit_duration"`
+
+		// SubscriptionsMax is the maximum number of concurrent websocket
+		// subscriptions to tx lifecycle updates.
+		SubscriptionsMax int `mapstructure:"subscriptions_max"`
+
+		// SubscriptionPollInterval is the interval at which new blocks are
+		// checked for tx lifecycle events, it defaults to 2s when unset.
+		SubscriptionPollInterval time.Duration `mapstructure:"subscription_poll_interval"`
+
+		// SubscriptionAllowedOrigins are the origins browsers may open a
+		// subscription from, "*" allows any origin. When empty only same
+		// origin requests are allowed.
+		SubscriptionAllowedOrigins []string `mapstructure:"subscription_allowed_origins"`
 	} `mapstructure:"api"`
 
 	// Cosmos contains values used in templating the Cosmos app.toml.
@@ -585,6 +662,18 @@ type BifrostChainConfiguration struct {
 
 	// ScannerLevelDB is the LevelDB configuration for the block scanner.
 	ScannerLevelDB LevelDBOptions `mapstructure:"scanner_leveldb"`
+
+	// RPCHosts are additional endpoints of the chain daemon. Requests fail over to the
+	// healthiest endpoint when the rpc host is unavailable or stalled, which is only
+	// supported for http endpoints.
+	RPCHosts []string `mapstructure:"rpc_hosts"`
+
+	// CosmosGRPCHosts are additional gRPC endpoints of the Cosmos SDK chain.
+	CosmosGRPCHosts []string `mapstructure:"cosmos_grpc_hosts"`
+
+	// RPCQuorum enables cross checking the height, blocks and receipts across two
+	// endpoints before they are observed, it requires at least one additional host.
+	RPCQuorum bool `mapstructure:"rpc_quorum"`
 }
 
 func (b *BifrostChainConfiguration) Validate() {
@@ -615,6 +704,18 @@ type BifrostBlockScannerConfiguration struct {
 	// CosmosGRPCTLS is a boolean value indicating whether the gRPC host is using TLS.
 	CosmosGRPCTLS bool `mapstructure:"cosmos_grpc_tls"`
 
+	// RPCHosts are additional endpoints of the chain daemon. Requests fail over to the
+	// healthiest endpoint when the rpc host is unavailable or stalled, which is only
+	// supported for http endpoints.
+	RPCHosts []string `mapstructure:"rpc_hosts"`
+
+	// CosmosGRPCHosts are additional gRPC endpoints of the Cosmos SDK chain.
+	CosmosGRPCHosts []string `mapstructure:"cosmos_grpc_hosts"`
+
+	// RPCQuorum enables cross checking the height, blocks and receipts across two
+	// endpoints before they are observed, it requires at least one additional host.
+	RPCQuorum bool `mapstructure:"rpc_quorum"`
+
 	// GasCacheBlocks is the number of blocks worth of gas price data cached to determine
 	// the gas price reported to Thorchain.
 	GasCacheBlocks int `mapstructure:"gas_cache_blocks"`
@@ -638,8 +739,28 @@ type BifrostBlockScannerConfiguration struct {
 	MaxContractGas int64 `mapstructure:"max_contract_gas"`
 
 	// WhitelistTokens is the set of whitelisted token addresses. Inbounds for all other
-	// tokens are ignored.
+	// tokens are ignored. If empty, all tokens in the chain token list are whitelisted.
 	WhitelistTokens []string `mapstructure:"whitelist_tokens"`
+
+	// DynamicFees is a boolean value indicating whether outbounds on EVM chains are sent
+	// as EIP-1559 dynamic fee transactions instead of legacy gas price transactions.
+	DynamicFees bool `mapstructure:"dynamic_fees"`
+
+	// ReorgDetection is a boolean value indicating whether the EVM block scanner keeps the
+	// block meta of recent blocks to detect re-orgs and rescan the affected blocks, this is
+	// needed for chains without instant finality.
+	ReorgDetection bool `mapstructure:"reorg_detection"`
+
+	// ConfirmationCounting is a boolean value indicating whether EVM inbounds wait for a
+	// number of confirmations relative to their value before they are finalised, this is
+	// needed for chains without instant finality.
+	ConfirmationCounting bool `mapstructure:"confirmation_counting"`
+
+	// GasPriceStrategy is how the EVM block scanner determines the gas price reported to
+	// Thorchain. "median" (the default) reports the median of the block median gas prices
+	// rounded up to the resolution, "percentile" reports the mean plus 3 standard
+	// deviations of the block 25th percentile gas prices on every change.
+	GasPriceStrategy string `mapstructure:"gas_price_strategy"`
 }
 
 func (b *BifrostBlockScannerConfiguration) Validate() {
@@ -62,7 +62,10 @@ const (
 	EnableDerivedAssets
 	MinSwapsPerBlock
 	MaxSwapsPerBlock
+	StreamingSwapMinBPFee
+	StreamingSwapMaxLength
 	EnableOrderBooks
+	OrderBookDefaultTTL
 	MaxSynthPerAssetDepth // TODO: remove me on hard fork
 	MaxSynthPerPoolDepth
 	MaxSynthsForSaversYield
@@ -85,6 +88,11 @@ const (
 	PauseLoans
 	LoanRepaymentMaturity
 	LendingLever
+	LoanLiquidationCR
+	LoanLiquidationCloseFactor
+	LoanLiquidationPenalty
+	LoanInterestBaseRate
+	LoanInterestSlope
 	PermittedSolvencyGap
 	NodeOperatorFee
 	ValidatorMaxRewardRatio
@@ -100,12 +108,15 @@ const (
 	SwapOutDexAggregationDisabled
 	SynthYieldBasisPoints
 	SynthYieldCycle
+	SaversGrowthInterval
+	SaversGrowthPeriods
 	MinimumL1OutboundFeeUSD
 	MinimumPoolLiquidityFee
 	ILPCutoff
 	ChurnMigrateRounds
 	AllowWideBlame
 	MaxAffiliateFeeBasisPoints
+	PreferredAssetOutboundFeeMultiplier
 	TargetOutboundFeeSurplusRune
 	MaxOutboundFeeMultiplierBasisPoints
 	MinOutboundFeeMultiplierBasisPoints
@@ -172,7 +183,10 @@ var nameToString = map[ConstantName]string{
 	EnableDerivedAssets:                 "EnableDerivedAssets",
 	MinSwapsPerBlock:                    "MinSwapsPerBlock",
 	MaxSwapsPerBlock:                    "MaxSwapsPerBlock",
+	StreamingSwapMinBPFee:               "StreamingSwapMinBPFee",
+	StreamingSwapMaxLength:              "StreamingSwapMaxLength",
 	EnableOrderBooks:                    "EnableOrderBooks",
+	OrderBookDefaultTTL:                 "OrderBookDefaultTTL",
 	VirtualMultSynths:                   "VirtualMultSynths",
 	VirtualMultSynthsBasisPoints:        "VirtualMultSynthsBasisPoints",
 	MaxSynthPerAssetDepth:               "MaxSynthPerAssetDepth", // TODO: remove me on hard fork
@@ -206,16 +220,24 @@ var nameToString = map[ConstantName]string{
 	RagnarokProcessNumOfLPPerIteration:  "RagnarokProcessNumOfLPPerIteration",
 	SynthYieldBasisPoints:               "SynthYieldBasisPoints",
 	SynthYieldCycle:                     "SynthYieldCycle",
+	SaversGrowthInterval:                "SaversGrowthInterval",
+	SaversGrowthPeriods:                 "SaversGrowthPeriods",
 	MinimumL1OutboundFeeUSD:             "MinimumL1OutboundFeeUSD",
 	MinimumPoolLiquidityFee:             "MinimumPoolLiquidityFee",
 	ILPCutoff:                           "ILPCutoff",
 	ChurnMigrateRounds:                  "ChurnMigrateRounds",
 	MaxAffiliateFeeBasisPoints:          "MaxAffiliateFeeBasisPoints",
+	PreferredAssetOutboundFeeMultiplier: "PreferredAssetOutboundFeeMultiplier",
 	MinCR:                               "MinCR",
 	MaxCR:                               "MaxCR",
 	PauseLoans:                          "PauseLoans",
 	LoanRepaymentMaturity:               "LoanRepaymentMaturity",
 	LendingLever:                        "LendingLever",
+	LoanLiquidationCR:                   "LoanLiquidationCR",
+	LoanLiquidationCloseFactor:          "LoanLiquidationCloseFactor",
+	LoanLiquidationPenalty:              "LoanLiquidationPenalty",
+	LoanInterestBaseRate:                "LoanInterestBaseRate",
+	LoanInterestSlope:                   "LoanInterestSlope",
 	AllowWideBlame:                      "AllowWideBlame",
 	TargetOutboundFeeSurplusRune:        "TargetOutboundFeeSurplusRune",
 	MaxOutboundFeeMultiplierBasisPoints: "MaxOutboundFeeMultiplierBasisPoints",
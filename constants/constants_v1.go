@@ -54,7 +54,10 @@ func NewConstantValue() *ConstantVals {
 			EnableDerivedAssets:                 0,                  // enable/disable swapping of derived assets
 			MinSwapsPerBlock:                    10,                 // process all swaps if queue is less than this number
 			MaxSwapsPerBlock:                    100,                // max swaps to process per block
+			StreamingSwapMinBPFee:               5,                  // min liquidity fee (in basis points) each sub-swap of a streaming swap should pay
+			StreamingSwapMaxLength:              14400,              // max number of blocks a streaming swap can trade for
 			EnableOrderBooks:                    0,                  // enable order books instead of swap queue
+			OrderBookDefaultTTL:                 43200,              // number of blocks a limit order stays in the order book when the memo doesn't set an expiry
 			VirtualMultSynths:                   2,                  // pool depth multiplier for synthetic swaps
 			VirtualMultSynthsBasisPoints:        10_000,             // pool depth multiplier for synthetic swaps (in basis points)
 			MaxSynthPerAssetDepth:               3300,               // TODO: remove me on hard fork
@@ -67,6 +70,11 @@ func NewConstantValue() *ConstantVals {
 			PauseLoans:                          1,                  // pause opening new loans and repaying loans
 			LoanRepaymentMaturity:               0,                  // number of blocks before loan has reached maturity and can be repaid
 			LendingLever:                        3333,               // This controls (in basis points) how much lending is allowed relative to rune supply
+			LoanLiquidationCR:                   0,                  // collateralization ratio (basis pts) below which a loan is liquidated, 0 to disable liquidations
+			LoanLiquidationCloseFactor:          5000,               // percentage (in basis points) of the debt of an undercollateralized loan cleared per liquidation
+			LoanLiquidationPenalty:              500,                // extra collateral (in basis points of the cleared debt) seized by the reserve on liquidation
+			LoanInterestBaseRate:                0,                  // yearly interest rate (in basis points) charged on loan debt when lending pool utilization is zero
+			LoanInterestSlope:                   0,                  // yearly interest rate (in basis points) added on top of the base rate at full lending pool utilization
 			MinTxOutVolumeThreshold:             1000_00000000,      // total txout volume (in rune) a block needs to have to slow outbound transactions
 			TxOutDelayRate:                      25_00000000,        // outbound rune per block rate for scheduled transactions (excluding native assets)
 			TxOutDelayMax:                       17280,              // max number of blocks a transaction can be delayed
@@ -89,12 +97,15 @@ func NewConstantValue() *ConstantVals {
 			RagnarokProcessNumOfLPPerIteration:  200,                // the number of LP to be processed per iteration during ragnarok pool
 			SynthYieldBasisPoints:               5000,               // amount of the yield the capital earns the synth holder receives if synth per pool is 0%
 			SynthYieldCycle:                     0,                  // number of blocks when the network pays out rewards to yield bearing synths
+			SaversGrowthInterval:                600,                // number of blocks between two samples of the redeem value of a savers unit, 0 to disable the tracking of the savers yield
+			SaversGrowthPeriods:                 720,                // number of samples the moving average of the annualised savers yield is smoothed over
 			MinimumL1OutboundFeeUSD:             1000000,            // Minimum fee in USD to charge for LP swap, default to $0.01 , nodes need to vote it to a larger value
 			MinimumPoolLiquidityFee:             0,                  // Minimum liquidity fee made by the pool,active pool fail to meet this within a PoolCycle will be demoted
 			ILPCutoff:                           0,                  // the cutoff height for impermanent loss protection
 			ChurnMigrateRounds:                  5,                  // Number of rounds to migrate vaults during churn
 			AllowWideBlame:                      0,                  // allow for a wide blame, only set in mocknet for regression testing tss keysign failures
 			MaxAffiliateFeeBasisPoints:          10_000,             // Max allowed affiliate fee basis points
+			PreferredAssetOutboundFeeMultiplier: 100,                // multiple of the outbound fee of its preferred asset the affiliate fees accrued by a THORName must reach to be paid out
 			TargetOutboundFeeSurplusRune:        100_000_00000000,   // Target amount of RUNE for Outbound Fee Surplus: the sum of the diff between outbound cost to user and outbound cost to network
 			MaxOutboundFeeMultiplierBasisPoints: 30_000,             // Maximum multiplier applied to base outbound fee charged to user, in basis points
 			MinOutboundFeeMultiplierBasisPoints: 15_000,             // Minimum multiplier applied to base outbound fee charged to user, in basis points
@@ -9,14 +9,15 @@ import (
 )
 
 const (
-	ModuleName       = types.ModuleName
-	ReserveName      = types.ReserveName
-	AsgardName       = types.AsgardName
-	BondName         = types.BondName
-	LendingName      = types.LendingName
-	RouterKey        = types.RouterKey
-	StoreKey         = types.StoreKey
-	DefaultCodespace = types.DefaultCodespace
+	ModuleName             = types.ModuleName
+	ReserveName            = types.ReserveName
+	AsgardName             = types.AsgardName
+	BondName               = types.BondName
+	LendingName            = types.LendingName
+	AffiliateCollectorName = types.AffiliateCollectorName
+	RouterKey              = types.RouterKey
+	StoreKey               = types.StoreKey
+	DefaultCodespace       = types.DefaultCodespace
 
 	// pool status
 	PoolAvailable = types.PoolStatus_Available
@@ -68,29 +69,32 @@ const (
 	BurnSupplyType = types.MintBurnSupplyType_burn
 
 	// Memos
-	TxSwap            = mem.TxSwap
-	TxLimitOrder      = mem.TxLimitOrder
-	TxAdd             = mem.TxAdd
-	TxBond            = mem.TxBond
-	TxYggdrasilFund   = mem.TxYggdrasilFund
-	TxYggdrasilReturn = mem.TxYggdrasilReturn
-	TxMigrate         = mem.TxMigrate
-	TxRagnarok        = mem.TxRagnarok
-	TxReserve         = mem.TxReserve
-	TxOutbound        = mem.TxOutbound
-	TxRefund          = mem.TxRefund
-	TxUnBond          = mem.TxUnbond
-	TxLeave           = mem.TxLeave
-	TxWithdraw        = mem.TxWithdraw
-	TxTHORName        = mem.TxTHORName
-	TxLoanOpen        = mem.TxLoanOpen
-	TxLoanRepayment   = mem.TxLoanRepayment
+	TxSwap             = mem.TxSwap
+	TxLimitOrder       = mem.TxLimitOrder
+	TxAdd              = mem.TxAdd
+	TxBond             = mem.TxBond
+	TxYggdrasilFund    = mem.TxYggdrasilFund
+	TxYggdrasilReturn  = mem.TxYggdrasilReturn
+	TxMigrate          = mem.TxMigrate
+	TxRagnarok         = mem.TxRagnarok
+	TxReserve          = mem.TxReserve
+	TxOutbound         = mem.TxOutbound
+	TxRefund           = mem.TxRefund
+	TxUnBond           = mem.TxUnbond
+	TxLeave            = mem.TxLeave
+	TxWithdraw         = mem.TxWithdraw
+	TxTHORName         = mem.TxTHORName
+	TxLoanOpen         = mem.TxLoanOpen
+	TxLoanRepayment    = mem.TxLoanRepayment
+	TxModifyLimitOrder = mem.TxModifyLimitOrder
 )
 
 var (
 	NewPool                        = types.NewPool
 	NewNetwork                     = types.NewNetwork
 	NewProtocolOwnedLiquidity      = types.NewProtocolOwnedLiquidity
+	NewSaversGrowth                = types.NewSaversGrowth
+	SaversValuePerUnit             = types.SaversValuePerUnit
 	NewObservedTx                  = types.NewObservedTx
 	NewTssVoter                    = types.NewTssVoter
 	NewBanVoter                    = types.NewBanVoter
@@ -98,6 +102,7 @@ var (
 	NewObservedTxVoter             = types.NewObservedTxVoter
 	NewMsgLoanOpen                 = types.NewMsgLoanOpen
 	NewMsgLoanRepayment            = types.NewMsgLoanRepayment
+	NewMsgModifyLimitOrder         = types.NewMsgModifyLimitOrder
 	NewMsgMimir                    = types.NewMsgMimir
 	NewMsgNodePauseChain           = types.NewMsgNodePauseChain
 	NewMsgDeposit                  = types.NewMsgDeposit
@@ -121,6 +126,10 @@ var (
 	NewEventDonate                 = types.NewEventDonate
 	NewEventSwap                   = types.NewEventSwap
 	NewEventLimitOrder             = types.NewEventLimitOrder
+	NewEventLimitOrderExpired      = types.NewEventLimitOrderExpired
+	NewEventLimitOrderModify       = types.NewEventLimitOrderModify
+	NewEventLimitOrderCancel       = types.NewEventLimitOrderCancel
+	NewEventStreamingSwap          = types.NewEventStreamingSwap
 	NewEventAddLiquidity           = types.NewEventAddLiquidity
 	NewEventWithdraw               = types.NewEventWithdraw
 	NewEventRefund                 = types.NewEventRefund
@@ -147,6 +156,9 @@ var (
 	NewEventVersion                = types.NewEventVersion
 	NewEventLoanOpen               = types.NewEventLoanOpen
 	NewEventLoanRepayment          = types.NewEventLoanRepayment
+	NewEventLoanLiquidation        = types.NewEventLoanLiquidation
+	NewEventLoanInterest           = types.NewEventLoanInterest
+	NewEventAffiliateFee           = types.NewEventAffiliateFee
 	NewPoolMod                     = types.NewPoolMod
 	NewMsgRefundTx                 = types.NewMsgRefundTx
 	NewMsgOutboundTx               = types.NewMsgOutboundTx
@@ -183,6 +195,8 @@ var (
 	NewNetworkFee                  = types.NewNetworkFee
 	NewTHORName                    = types.NewTHORName
 	NewLoan                        = types.NewLoan
+	NewStreamingSwap               = types.NewStreamingSwap
+	NewAffiliate                   = types.NewAffiliate
 	GetPoolStatus                  = types.GetPoolStatus
 	GetRandomVault                 = types.GetRandomVault
 	GetRandomYggVault              = types.GetRandomYggVault
@@ -262,6 +276,7 @@ type (
 	MsgSolvency                    = types.MsgSolvency
 	MsgLoanOpen                    = types.MsgLoanOpen
 	MsgLoanRepayment               = types.MsgLoanRepayment
+	MsgModifyLimitOrder            = types.MsgModifyLimitOrder
 	QueryVersion                   = types.QueryVersion
 	QueryQueue                     = types.QueryQueue
 	QueryNodeAccountPreflightCheck = types.QueryNodeAccountPreflightCheck
@@ -280,6 +295,8 @@ type (
 	QueryTxStatus                  = types.QueryTxStatus
 	QuerySaver                     = types.QuerySaver
 	QueryChainAddress              = types.QueryChainAddress
+	QuerySimulateResponse          = types.QuerySimulateResponse
+	QuerySimulateFees              = types.QuerySimulateFees
 	PoolStatus                     = types.PoolStatus
 	Pool                           = types.Pool
 	Pools                          = types.Pools
@@ -287,6 +304,10 @@ type (
 	LiquidityProviders             = types.LiquidityProviders
 	Loan                           = types.Loan
 	Loans                          = types.Loans
+	StreamingSwap                  = types.StreamingSwap
+	Affiliate                      = types.Affiliate
+	Affiliates                     = types.Affiliates
+	StreamingSwaps                 = types.StreamingSwaps
 	ObservedTxs                    = types.ObservedTxs
 	ObservedTx                     = types.ObservedTx
 	ObservedTxVoter                = types.ObservedTxVoter
@@ -300,6 +321,9 @@ type (
 	Keygen                         = types.Keygen
 	KeygenBlock                    = types.KeygenBlock
 	EventSwap                      = types.EventSwap
+	EventStreamingSwap             = types.EventStreamingSwap
+	EventLimitOrderModify          = types.EventLimitOrderModify
+	EventLimitOrderCancel          = types.EventLimitOrderCancel
 	EventAddLiquidity              = types.EventAddLiquidity
 	EventWithdraw                  = types.EventWithdraw
 	EventDonate                    = types.EventDonate
@@ -308,6 +332,9 @@ type (
 	EventReserve                   = types.EventReserve
 	EventLoanOpen                  = types.EventLoanOpen
 	EventLoanRepayment             = types.EventLoanRepayment
+	EventLoanLiquidation           = types.EventLoanLiquidation
+	EventLoanInterest              = types.EventLoanInterest
+	EventAffiliateFee              = types.EventAffiliateFee
 	PoolAmt                        = types.PoolAmt
 	PoolMod                        = types.PoolMod
 	PoolMods                       = types.PoolMods
@@ -322,6 +349,7 @@ type (
 	BondProvider                   = types.BondProvider
 	Network                        = types.Network
 	ProtocolOwnedLiquidity         = types.ProtocolOwnedLiquidity
+	SaversGrowth                   = types.SaversGrowth
 	VaultStatus                    = types.VaultStatus
 	GasPool                        = types.GasPool
 	EventGas                       = types.EventGas
@@ -368,6 +396,8 @@ type (
 
 	LoanOpenMemo      = mem.LoanOpenMemo
 	LoanRepaymentMemo = mem.LoanRepaymentMemo
+
+	ModifyLimitOrderMemo = mem.ModifyLimitOrderMemo
 )
 
 var _ codec.ProtoMarshaler = &types.LiquidityProvider{}
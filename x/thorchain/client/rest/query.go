@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"net/http"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/cosmos/cosmos-sdk/client"
@@ -21,9 +22,14 @@ func pingHandler(cliCtx client.Context, storeName string) http.HandlerFunc {
 	}
 }
 
-// Generic wrapper to generate GET handler
+// Generic wrapper to generate GET handler. An optional `height` query
+// parameter serves the state at a past height, the height actually served is
+// returned in the X-Thorchain-Height header.
 func getHandlerWrapper(q query.Query, storeName string, cliCtx client.Context) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
+		// the handler serves concurrent requests, so the height is set on a
+		// request-local copy of the context
+		ctx := cliCtx.WithHeight(0)
 		heightStr, ok := r.URL.Query()["height"]
 		if ok && len(heightStr) > 0 {
 			height, err := strconv.ParseInt(heightStr[0], 10, 64)
@@ -31,9 +37,11 @@ func getHandlerWrapper(q query.Query, storeName string, cliCtx client.Context) h
 				rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
 				return
 			}
-			cliCtx = cliCtx.WithHeight(height)
-		} else {
-			cliCtx = cliCtx.WithHeight(0)
+			if height < 0 {
+				rest.WriteErrorResponse(w, http.StatusBadRequest, "height cannot be negative")
+				return
+			}
+			ctx = ctx.WithHeight(height)
 		}
 		param := mux.Vars(r)[restURLParam]
 		text, err := r.URL.MarshalBinary()
@@ -42,22 +50,43 @@ func getHandlerWrapper(q query.Query, storeName string, cliCtx client.Context) h
 			return
 		}
 
-		res, _, err := cliCtx.QueryWithData(q.Path(storeName, param, mux.Vars(r)[restURLParam2]), text)
+		res, height, err := ctx.QueryWithData(q.Path(storeName, param, mux.Vars(r)[restURLParam2]), text)
 		if err != nil {
+			if ctx.Height > 0 && isHeightUnavailable(err) {
+				rest.WriteErrorResponse(w, http.StatusBadRequest, fmt.Sprintf("height %d is not available on this node, it is either in the future or has been pruned: %s", ctx.Height, err))
+				return
+			}
 			rest.WriteErrorResponse(w, http.StatusNotFound, err.Error())
 			return
 		}
 		w.Header().Set("Content-Type", "application/json")
 
-		// get the block height and time of the latest block on the node
-		latest, err := cliCtx.Client.Block(r.Context(), nil)
+		// get the block height and time of the block the state was served at
+		var block *int64
+		if height > 0 {
+			block = &height
+		}
+		served, err := ctx.Client.Block(r.Context(), block)
 		if err != nil {
-			log.Debug().Err(err).Msg("fail to get latest block")
+			log.Debug().Err(err).Int64("height", height).Msg("fail to get block")
+			if height > 0 {
+				w.Header().Set("X-Thorchain-Height", fmt.Sprintf("%d", height))
+			}
 		} else {
-			w.Header().Set("X-Thorchain-Height", fmt.Sprintf("%d", latest.Block.Height))
-			w.Header().Set("X-Thorchain-Time", latest.Block.Time.Format(time.RFC3339))
+			w.Header().Set("X-Thorchain-Height", fmt.Sprintf("%d", served.Block.Height))
+			w.Header().Set("X-Thorchain-Time", served.Block.Time.Format(time.RFC3339))
 		}
 
 		_, _ = w.Write(res)
 	}
 }
+
+// isHeightUnavailable returns true when the query failed because the state at
+// the requested height isn't available, either because it hasn't been reached
+// yet, or because it has been pruned
+func isHeightUnavailable(err error) bool {
+	msg := err.Error()
+	return strings.Contains(msg, "failed to load state at height") ||
+		strings.Contains(msg, "version does not exist") ||
+		strings.Contains(msg, "cannot query with height in the future")
+}
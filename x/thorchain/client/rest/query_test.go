@@ -0,0 +1,154 @@
+package rest
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	abci "github.com/tendermint/tendermint/abci/types"
+	tmbytes "github.com/tendermint/tendermint/libs/bytes"
+	rpcclient "github.com/tendermint/tendermint/rpc/client"
+	coretypes "github.com/tendermint/tendermint/rpc/core/types"
+	tmtypes "github.com/tendermint/tendermint/types"
+	. "gopkg.in/check.v1"
+
+	"gitlab.com/thorchain/thornode/x/thorchain/query"
+)
+
+func TestPackage(t *testing.T) { TestingT(t) }
+
+type QuerySuite struct{}
+
+var _ = Suite(&QuerySuite{})
+
+// queryTestClient serves every height from 1 up to the latest one
+type queryTestClient struct {
+	rpcclient.Client
+	latest  int64
+	mu      sync.Mutex
+	queried []int64
+}
+
+func (c *queryTestClient) ABCIQueryWithOptions(_ context.Context, _ string, _ tmbytes.HexBytes, opts rpcclient.ABCIQueryOptions) (*coretypes.ResultABCIQuery, error) {
+	c.mu.Lock()
+	c.queried = append(c.queried, opts.Height)
+	c.mu.Unlock()
+	height := opts.Height
+	if height == 0 {
+		height = c.latest
+	}
+	if height > c.latest {
+		return &coretypes.ResultABCIQuery{Response: abci.ResponseQuery{
+			Code: 1,
+			Log:  fmt.Sprintf("failed to load state at height %d; version does not exist (latest height: %d)", height, c.latest),
+		}}, nil
+	}
+	return &coretypes.ResultABCIQuery{Response: abci.ResponseQuery{
+		Value:  []byte(`{}`),
+		Height: height,
+	}}, nil
+}
+
+func (c *queryTestClient) Block(_ context.Context, height *int64) (*coretypes.ResultBlock, error) {
+	h := c.latest
+	if height != nil {
+		h = *height
+	}
+	return &coretypes.ResultBlock{Block: &tmtypes.Block{Header: tmtypes.Header{
+		Height: h,
+		Time:   time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC).Add(time.Duration(h) * time.Second),
+	}}}, nil
+}
+
+func (s *QuerySuite) get(handler http.HandlerFunc, url string) *httptest.ResponseRecorder {
+	w := httptest.NewRecorder()
+	handler(w, httptest.NewRequest(http.MethodGet, url, nil))
+	return w
+}
+
+func (s *QuerySuite) TestGetHandlerWrapper(c *C) {
+	rpc := &queryTestClient{latest: 100}
+	handler := getHandlerWrapper(query.QueryPools, "thorchain", client.Context{}.WithClient(rpc))
+
+	// latest height
+	w := s.get(handler, "/thorchain/pools")
+	c.Assert(w.Code, Equals, http.StatusOK)
+	c.Assert(w.Header().Get("X-Thorchain-Height"), Equals, "100")
+	c.Assert(w.Header().Get("X-Thorchain-Time"), Equals, "2023-01-01T00:01:40Z")
+
+	// past height
+	w = s.get(handler, "/thorchain/pools?height=50")
+	c.Assert(w.Code, Equals, http.StatusOK)
+	c.Assert(w.Header().Get("X-Thorchain-Height"), Equals, "50")
+	c.Assert(w.Header().Get("X-Thorchain-Time"), Equals, "2023-01-01T00:00:50Z")
+
+	// a past height must not leak into later requests
+	w = s.get(handler, "/thorchain/pools")
+	c.Assert(w.Code, Equals, http.StatusOK)
+	c.Assert(w.Header().Get("X-Thorchain-Height"), Equals, "100")
+	c.Assert(rpc.queried, DeepEquals, []int64{0, 50, 0})
+
+	// future or pruned height
+	w = s.get(handler, "/thorchain/pools?height=200")
+	c.Assert(w.Code, Equals, http.StatusBadRequest)
+	c.Assert(w.Body.String(), Matches, `.*height 200 is not available on this node.*`)
+	c.Assert(w.Header().Get("X-Thorchain-Height"), Equals, "")
+
+	// invalid heights are rejected before querying
+	rpc.queried = nil
+	w = s.get(handler, "/thorchain/pools?height=-1")
+	c.Assert(w.Code, Equals, http.StatusBadRequest)
+	c.Assert(w.Body.String(), Matches, `.*height cannot be negative.*`)
+	w = s.get(handler, "/thorchain/pools?height=abc")
+	c.Assert(w.Code, Equals, http.StatusBadRequest)
+	c.Assert(rpc.queried, HasLen, 0)
+}
+
+func (s *QuerySuite) TestGetHandlerWrapperConcurrent(c *C) {
+	rpc := &queryTestClient{latest: 100}
+	handler := getHandlerWrapper(query.QueryPools, "thorchain", client.Context{}.WithClient(rpc))
+
+	var wg sync.WaitGroup
+	heights := make([]string, 20)
+	for i := range heights {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			url := "/thorchain/pools"
+			if i%2 == 0 {
+				url = fmt.Sprintf("/thorchain/pools?height=%d", i+1)
+			}
+			heights[i] = s.get(handler, url).Header().Get("X-Thorchain-Height")
+		}(i)
+	}
+	wg.Wait()
+
+	for i, height := range heights {
+		if i%2 == 0 {
+			c.Check(height, Equals, fmt.Sprintf("%d", i+1))
+		} else {
+			c.Check(height, Equals, "100")
+		}
+	}
+}
+
+func (s *QuerySuite) TestIsHeightUnavailable(c *C) {
+	for _, tc := range []struct {
+		err      error
+		expected bool
+	}{
+		{errors.New("rpc error: code = Unknown desc = failed to load state at height 5; version does not exist (latest height: 3)"), true},
+		{errors.New("version does not exist"), true},
+		{errors.New("cannot query with height in the future; please provide a valid height"), true},
+		{errors.New("pool: BTC.BTC doesn't exist"), false},
+		{errors.New("connection refused"), false},
+	} {
+		c.Check(isHeightUnavailable(tc.err), Equals, tc.expected, Commentf("%s", tc.err))
+	}
+}
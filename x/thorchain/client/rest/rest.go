@@ -49,6 +49,12 @@ func RegisterRoutes(cliCtx client.Context, r *mux.Router, storeName string) {
 		}
 	}
 
+	// Push tx lifecycle updates over a websocket, instead of polling the tx status
+	r.HandleFunc(
+		fmt.Sprintf("/%s/subscribe", storeName),
+		subscribeHandler(newTxLifecycleHub(cliCtx)),
+	).Methods(http.MethodGet)
+
 	// Get unsigned json for emitting a transaction. Validators only.
 	// TODO: check to ensure these are dead and remove them
 	r.HandleFunc(
@@ -0,0 +1,338 @@
+package rest
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/types/rest"
+	"github.com/gorilla/websocket"
+	"github.com/rs/zerolog/log"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"gitlab.com/thorchain/thornode/config"
+	"gitlab.com/thorchain/thornode/x/thorchain/types"
+)
+
+const (
+	// subscriptionBufferSize is the number of updates buffered for a subscriber,
+	// a subscriber falling further behind is disconnected
+	subscriptionBufferSize = 64
+	subscriptionWriteWait  = 10 * time.Second
+	subscriptionPingPeriod = 30 * time.Second
+
+	// defaultSubscriptionPollInterval is used when no valid poll interval is
+	// configured
+	defaultSubscriptionPollInterval = 2 * time.Second
+)
+
+// TxLifecycleUpdate is pushed to the subscribers of a tx or an address when an
+// event of the tx lifecycle is emitted in a committed block.
+type TxLifecycleUpdate struct {
+	Height int64 `json:"height"`
+	// Type is the type of the event: swap, refund, scheduled_outbound or outbound
+	Type string `json:"type"`
+	// InTxID is the hash of the inbound tx
+	InTxID string `json:"in_tx_id"`
+	// OutTxID is the hash of the outbound tx, once signed
+	OutTxID     string `json:"out_tx_id,omitempty"`
+	Chain       string `json:"chain,omitempty"`
+	FromAddress string `json:"from_address,omitempty"`
+	ToAddress   string `json:"to_address,omitempty"`
+	Coin        string `json:"coin,omitempty"`
+	Memo        string `json:"memo,omitempty"`
+
+	// swap
+	Pool         string `json:"pool,omitempty"`
+	EmitAsset    string `json:"emit_asset,omitempty"`
+	SwapSlip     string `json:"swap_slip,omitempty"`
+	LiquidityFee string `json:"liquidity_fee,omitempty"`
+
+	// refund
+	Code   string `json:"code,omitempty"`
+	Reason string `json:"reason,omitempty"`
+}
+
+// newTxLifecycleUpdate returns the update of a block event, and false when the
+// event is not part of the tx lifecycle
+func newTxLifecycleUpdate(height int64, evt abci.Event) (TxLifecycleUpdate, bool) {
+	attrs := make(map[string]string, len(evt.Attributes))
+	for _, attr := range evt.Attributes {
+		attrs[string(attr.Key)] = string(attr.Value)
+	}
+
+	update := TxLifecycleUpdate{
+		Height: height,
+		Type:   evt.Type,
+		Chain:  attrs["chain"],
+		Memo:   attrs["memo"],
+	}
+	switch evt.Type {
+	case types.SwapEventType:
+		update.InTxID = attrs["id"]
+		update.FromAddress = attrs["from"]
+		update.ToAddress = attrs["to"]
+		update.Coin = attrs["coin"]
+		update.Pool = attrs["pool"]
+		update.EmitAsset = attrs["emit_asset"]
+		update.SwapSlip = attrs["swap_slip"]
+		update.LiquidityFee = attrs["liquidity_fee"]
+	case types.RefundEventType:
+		update.InTxID = attrs["id"]
+		update.FromAddress = attrs["from"]
+		update.ToAddress = attrs["to"]
+		update.Coin = attrs["coin"]
+		update.Code = attrs["code"]
+		update.Reason = attrs["reason"]
+	case types.ScheduledOutboundEventType:
+		update.InTxID = attrs["in_hash"]
+		update.OutTxID = attrs["out_hash"]
+		update.ToAddress = attrs["to_address"]
+		update.Coin = fmt.Sprintf("%s %s", attrs["coin_amount"], attrs["coin_asset"])
+	case types.OutboundEventType:
+		update.InTxID = attrs["in_tx_id"]
+		update.OutTxID = attrs["id"]
+		update.FromAddress = attrs["from"]
+		update.ToAddress = attrs["to"]
+		update.Coin = attrs["coin"]
+	default:
+		return update, false
+	}
+	return update, true
+}
+
+// txLifecycleSubscriber is a websocket client subscribed to the updates of a
+// set of tx hashes and addresses
+type txLifecycleSubscriber struct {
+	txIDs     map[string]bool
+	addresses map[string]bool
+	updates   chan TxLifecycleUpdate
+}
+
+func (s *txLifecycleSubscriber) matches(update TxLifecycleUpdate) bool {
+	return s.txIDs[strings.ToUpper(update.InTxID)] ||
+		(update.OutTxID != "" && s.txIDs[strings.ToUpper(update.OutTxID)]) ||
+		(update.FromAddress != "" && s.addresses[strings.ToLower(update.FromAddress)]) ||
+		(update.ToAddress != "" && s.addresses[strings.ToLower(update.ToAddress)])
+}
+
+// txLifecycleHub follows the committed blocks and fans the tx lifecycle updates
+// out to the subscribers. Blocks are only fetched while there are subscribers.
+type txLifecycleHub struct {
+	cliCtx      client.Context
+	mu          sync.Mutex
+	subscribers map[*txLifecycleSubscriber]bool
+	once        sync.Once
+}
+
+func newTxLifecycleHub(cliCtx client.Context) *txLifecycleHub {
+	return &txLifecycleHub{
+		cliCtx:      cliCtx,
+		subscribers: make(map[*txLifecycleSubscriber]bool),
+	}
+}
+
+func (h *txLifecycleHub) subscribe(sub *txLifecycleSubscriber) error {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	if len(h.subscribers) >= config.GetThornode().API.SubscriptionsMax {
+		return fmt.Errorf("maximum number of subscriptions reached")
+	}
+	h.subscribers[sub] = true
+	h.once.Do(func() { go h.run() })
+	return nil
+}
+
+func (h *txLifecycleHub) unsubscribe(sub *txLifecycleSubscriber) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	if h.subscribers[sub] {
+		delete(h.subscribers, sub)
+		close(sub.updates)
+	}
+}
+
+func (h *txLifecycleHub) hasSubscribers() bool {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	return len(h.subscribers) > 0
+}
+
+// publish sends the update to the matching subscribers, a subscriber with a full
+// buffer is dropped rather than blocking the others
+func (h *txLifecycleHub) publish(update TxLifecycleUpdate) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	for sub := range h.subscribers {
+		if !sub.matches(update) {
+			continue
+		}
+		select {
+		case sub.updates <- update:
+		default:
+			log.Info().Msg("tx lifecycle subscriber is too slow, disconnecting")
+			delete(h.subscribers, sub)
+			close(sub.updates)
+		}
+	}
+}
+
+func (h *txLifecycleHub) run() {
+	var last int64
+	interval := config.GetThornode().API.SubscriptionPollInterval
+	if interval <= 0 {
+		log.Warn().Dur("interval", interval).Msg("invalid subscription poll interval, using default")
+		interval = defaultSubscriptionPollInterval
+	}
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for range ticker.C {
+		status, err := h.cliCtx.Client.Status(context.Background())
+		if err != nil {
+			log.Error().Err(err).Msg("fail to get node status")
+			continue
+		}
+		latest := status.SyncInfo.LatestBlockHeight
+		if last == 0 || !h.hasSubscribers() {
+			last = latest
+			continue
+		}
+		for height := last + 1; height <= latest; height++ {
+			if err := h.processBlock(height); err != nil {
+				log.Error().Err(err).Int64("height", height).Msg("fail to process block events")
+				break
+			}
+			last = height
+		}
+	}
+}
+
+func (h *txLifecycleHub) processBlock(height int64) error {
+	results, err := h.cliCtx.Client.BlockResults(context.Background(), &height)
+	if err != nil {
+		return fmt.Errorf("fail to get block results: %w", err)
+	}
+	events := results.BeginBlockEvents
+	for _, tx := range results.TxsResults {
+		events = append(events, tx.Events...)
+	}
+	events = append(events, results.EndBlockEvents...)
+	for _, evt := range events {
+		if update, ok := newTxLifecycleUpdate(height, evt); ok {
+			h.publish(update)
+		}
+	}
+	return nil
+}
+
+// checkSubscriptionOrigin returns true when the request origin is one of the
+// allowed origins. Requests without an origin don't come from a browser and are
+// always allowed, with no allowed origins only same origin requests are.
+func checkSubscriptionOrigin(allowed []string, r *http.Request) bool {
+	origin := r.Header.Get("Origin")
+	if origin == "" {
+		return true
+	}
+	if len(allowed) == 0 {
+		u, err := url.Parse(origin)
+		return err == nil && strings.EqualFold(u.Host, r.Host)
+	}
+	for _, o := range allowed {
+		if o == "*" || strings.EqualFold(strings.TrimSuffix(o, "/"), origin) {
+			return true
+		}
+	}
+	return false
+}
+
+var upgrader = websocket.Upgrader{
+	ReadBufferSize:  1024,
+	WriteBufferSize: 1024,
+	CheckOrigin: func(r *http.Request) bool {
+		return checkSubscriptionOrigin(config.GetThornode().API.SubscriptionAllowedOrigins, r)
+	},
+}
+
+// subscribeHandler upgrades the request to a websocket pushing the lifecycle
+// updates of the txs and addresses given in the comma separated `tx_id` and
+// `address` query parameters.
+func subscribeHandler(hub *txLifecycleHub) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		sub := &txLifecycleSubscriber{
+			txIDs:     make(map[string]bool),
+			addresses: make(map[string]bool),
+			updates:   make(chan TxLifecycleUpdate, subscriptionBufferSize),
+		}
+		for _, param := range r.URL.Query()["tx_id"] {
+			for _, id := range strings.Split(param, ",") {
+				if id != "" {
+					sub.txIDs[strings.ToUpper(id)] = true
+				}
+			}
+		}
+		for _, param := range r.URL.Query()["address"] {
+			for _, addr := range strings.Split(param, ",") {
+				if addr != "" {
+					sub.addresses[strings.ToLower(addr)] = true
+				}
+			}
+		}
+		if len(sub.txIDs) == 0 && len(sub.addresses) == 0 {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, "at least one tx_id or address is required")
+			return
+		}
+		if err := hub.subscribe(sub); err != nil {
+			rest.WriteErrorResponse(w, http.StatusServiceUnavailable, err.Error())
+			return
+		}
+
+		conn, err := upgrader.Upgrade(w, r, nil)
+		if err != nil {
+			hub.unsubscribe(sub)
+			log.Debug().Err(err).Msg("fail to upgrade to websocket")
+			return
+		}
+		defer conn.Close()
+		defer hub.unsubscribe(sub)
+
+		// the client doesn't send anything, reading detects the disconnection
+		closed := make(chan struct{})
+		go func() {
+			defer close(closed)
+			for {
+				if _, _, err := conn.ReadMessage(); err != nil {
+					return
+				}
+			}
+		}()
+
+		ping := time.NewTicker(subscriptionPingPeriod)
+		defer ping.Stop()
+		for {
+			select {
+			case <-closed:
+				return
+			case <-ping.C:
+				if err := conn.WriteControl(websocket.PingMessage, nil, time.Now().Add(subscriptionWriteWait)); err != nil {
+					return
+				}
+			case update, ok := <-sub.updates:
+				if !ok {
+					_ = conn.WriteControl(websocket.CloseMessage,
+						websocket.FormatCloseMessage(websocket.CloseTryAgainLater, "subscriber too slow"),
+						time.Now().Add(subscriptionWriteWait))
+					return
+				}
+				_ = conn.SetWriteDeadline(time.Now().Add(subscriptionWriteWait))
+				if err := conn.WriteJSON(update); err != nil {
+					return
+				}
+			}
+		}
+	}
+}
@@ -0,0 +1,142 @@
+package rest
+
+import (
+	"net/http/httptest"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+	. "gopkg.in/check.v1"
+)
+
+type SubscribeSuite struct{}
+
+var _ = Suite(&SubscribeSuite{})
+
+func newTestEvent(typ string, attrs ...string) abci.Event {
+	evt := abci.Event{Type: typ}
+	for i := 0; i+1 < len(attrs); i += 2 {
+		evt.Attributes = append(evt.Attributes, abci.EventAttribute{
+			Key:   []byte(attrs[i]),
+			Value: []byte(attrs[i+1]),
+		})
+	}
+	return evt
+}
+
+func (s *SubscribeSuite) TestNewTxLifecycleUpdate(c *C) {
+	testCases := []struct {
+		name     string
+		evt      abci.Event
+		ok       bool
+		expected TxLifecycleUpdate
+	}{
+		{
+			name: "swap",
+			evt: newTestEvent("swap",
+				"id", "IN", "chain", "BTC", "from", "bc1from", "to", "bc1to",
+				"coin", "100 BTC.BTC", "memo", "=:ETH.ETH:0xabc", "pool", "BTC.BTC",
+				"emit_asset", "50 THOR.RUNE", "swap_slip", "12", "liquidity_fee", "3"),
+			ok: true,
+			expected: TxLifecycleUpdate{
+				Height: 10, Type: "swap", InTxID: "IN", Chain: "BTC",
+				FromAddress: "bc1from", ToAddress: "bc1to", Coin: "100 BTC.BTC",
+				Memo: "=:ETH.ETH:0xabc", Pool: "BTC.BTC", EmitAsset: "50 THOR.RUNE",
+				SwapSlip: "12", LiquidityFee: "3",
+			},
+		},
+		{
+			name: "refund",
+			evt: newTestEvent("refund",
+				"id", "IN", "chain", "BTC", "from", "bc1from", "to", "bc1to",
+				"coin", "100 BTC.BTC", "memo", "bad", "code", "105", "reason", "invalid memo"),
+			ok: true,
+			expected: TxLifecycleUpdate{
+				Height: 10, Type: "refund", InTxID: "IN", Chain: "BTC",
+				FromAddress: "bc1from", ToAddress: "bc1to", Coin: "100 BTC.BTC",
+				Memo: "bad", Code: "105", Reason: "invalid memo",
+			},
+		},
+		{
+			name: "scheduled outbound",
+			evt: newTestEvent("scheduled_outbound",
+				"in_hash", "IN", "out_hash", "", "chain", "ETH", "to_address", "0xabc",
+				"coin_amount", "100", "coin_asset", "ETH.ETH", "memo", "OUT:IN"),
+			ok: true,
+			expected: TxLifecycleUpdate{
+				Height: 10, Type: "scheduled_outbound", InTxID: "IN", Chain: "ETH",
+				ToAddress: "0xabc", Coin: "100 ETH.ETH", Memo: "OUT:IN",
+			},
+		},
+		{
+			name: "outbound",
+			evt: newTestEvent("outbound",
+				"in_tx_id", "IN", "id", "OUT", "chain", "ETH", "from", "0xvault",
+				"to", "0xabc", "coin", "100 ETH.ETH", "memo", "OUT:IN"),
+			ok: true,
+			expected: TxLifecycleUpdate{
+				Height: 10, Type: "outbound", InTxID: "IN", OutTxID: "OUT", Chain: "ETH",
+				FromAddress: "0xvault", ToAddress: "0xabc", Coin: "100 ETH.ETH", Memo: "OUT:IN",
+			},
+		},
+		{
+			name: "not part of the tx lifecycle",
+			evt:  newTestEvent("pool", "pool", "BTC.BTC", "pool_status", "Available"),
+			ok:   false,
+		},
+	}
+
+	for _, tc := range testCases {
+		update, ok := newTxLifecycleUpdate(10, tc.evt)
+		c.Assert(ok, Equals, tc.ok, Commentf("%s", tc.name))
+		if tc.ok {
+			c.Check(update, DeepEquals, tc.expected, Commentf("%s", tc.name))
+		}
+	}
+}
+
+func (s *SubscribeSuite) TestTxLifecycleSubscriberMatches(c *C) {
+	sub := &txLifecycleSubscriber{
+		txIDs:     map[string]bool{"ABC": true},
+		addresses: map[string]bool{"0xdef": true},
+	}
+
+	testCases := []struct {
+		name     string
+		update   TxLifecycleUpdate
+		expected bool
+	}{
+		{"inbound tx id", TxLifecycleUpdate{InTxID: "abc"}, true},
+		{"outbound tx id", TxLifecycleUpdate{InTxID: "OTHER", OutTxID: "Abc"}, true},
+		{"from address", TxLifecycleUpdate{InTxID: "OTHER", FromAddress: "0xDEF"}, true},
+		{"to address", TxLifecycleUpdate{InTxID: "OTHER", ToAddress: "0xdef"}, true},
+		{"other tx", TxLifecycleUpdate{InTxID: "OTHER", OutTxID: "OUT", FromAddress: "0x123", ToAddress: "0x456"}, false},
+		{"empty ids don't match", TxLifecycleUpdate{}, false},
+	}
+
+	for _, tc := range testCases {
+		c.Check(sub.matches(tc.update), Equals, tc.expected, Commentf("%s", tc.name))
+	}
+}
+
+func (s *SubscribeSuite) TestCheckSubscriptionOrigin(c *C) {
+	testCases := []struct {
+		name     string
+		allowed  []string
+		origin   string
+		expected bool
+	}{
+		{"no origin", nil, "", true},
+		{"same origin", nil, "https://thornode.example.com", true},
+		{"cross origin", nil, "https://evil.example.com", false},
+		{"allowed origin", []string{"https://app.example.com/"}, "https://APP.example.com", true},
+		{"not allowed origin", []string{"https://app.example.com"}, "https://evil.example.com", false},
+		{"any origin", []string{"*"}, "https://evil.example.com", true},
+	}
+
+	for _, tc := range testCases {
+		r := httptest.NewRequest("GET", "https://thornode.example.com/thorchain/subscribe", nil)
+		if tc.origin != "" {
+			r.Header.Set("Origin", tc.origin)
+		}
+		c.Check(checkSubscriptionOrigin(tc.allowed, r), Equals, tc.expected, Commentf("%s", tc.name))
+	}
+}
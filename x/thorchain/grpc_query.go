@@ -0,0 +1,465 @@
+package thorchain
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"gitlab.com/thorchain/thornode/common"
+	"gitlab.com/thorchain/thornode/common/cosmos"
+	"gitlab.com/thorchain/thornode/constants"
+	"gitlab.com/thorchain/thornode/x/thorchain/types"
+)
+
+// queryServer implements the gRPC query service of the thorchain module. It
+// reads the same state as the legacy querier, but returns the typed records
+// instead of the json views.
+type queryServer struct {
+	mgr *Mgrs
+}
+
+var _ types.QueryServer = queryServer{}
+
+// NewQueryServerImpl returns the gRPC query server of the thorchain module
+func NewQueryServerImpl(mgr *Mgrs) types.QueryServer {
+	return queryServer{mgr: mgr}
+}
+
+// unwrapContext returns the sdk context of a gRPC query
+func (s queryServer) unwrapContext(c context.Context) cosmos.Context {
+	ctx := sdk.UnwrapSDKContext(c)
+	initManager(s.mgr, ctx) // NOOP except regtest
+	return ctx
+}
+
+// newGRPCQueryPage builds the page of a list query from its gRPC request fields
+func newGRPCQueryPage(cursor string, limit int64) (queryPage, error) {
+	if limit < 0 {
+		return queryPage{}, fmt.Errorf("invalid limit: %d", limit)
+	}
+	return queryPage{cursor: cursor, limit: int(limit)}, nil
+}
+
+func (s queryServer) Pool(c context.Context, req *types.QueryPoolRequest) (*types.QueryPoolResponse, error) {
+	ctx := s.unwrapContext(c)
+	asset, err := common.NewAsset(req.Asset)
+	if err != nil {
+		return nil, fmt.Errorf("could not parse asset: %w", err)
+	}
+	pool, err := s.mgr.Keeper().GetPool(ctx, asset)
+	if err != nil {
+		return nil, fmt.Errorf("could not get pool: %w", err)
+	}
+	if pool.IsEmpty() {
+		return nil, fmt.Errorf("pool: %s doesn't exist", req.Asset)
+	}
+	synthSupply := s.mgr.Keeper().GetTotalSupply(ctx, pool.Asset.GetSyntheticAsset())
+	pool.CalcUnits(s.mgr.GetVersion(), synthSupply)
+	return &types.QueryPoolResponse{Pool: pool}, nil
+}
+
+func (s queryServer) Pools(c context.Context, req *types.QueryPoolsRequest) (*types.QueryPoolsResponse, error) {
+	ctx := s.unwrapContext(c)
+	pools := make([]Pool, 0)
+	iterator := s.mgr.Keeper().GetPoolIterator(ctx)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var pool Pool
+		if err := s.mgr.Keeper().Cdc().Unmarshal(iterator.Value(), &pool); err != nil {
+			return nil, fmt.Errorf("fail to unmarshal pool: %w", err)
+		}
+		// ignore pool if no liquidity provider units, and savers vaults
+		if pool.LPUnits.IsZero() || pool.Asset.IsVaultAsset() {
+			continue
+		}
+		synthSupply := s.mgr.Keeper().GetTotalSupply(ctx, pool.Asset.GetSyntheticAsset())
+		pool.CalcUnits(s.mgr.GetVersion(), synthSupply)
+		pools = append(pools, pool)
+	}
+	return &types.QueryPoolsResponse{Pools: pools}, nil
+}
+
+// parsePoolAsset parses the asset of a liquidity provider request. Savers are
+// requested with the L1 asset and stored under the savers vault asset.
+func parsePoolAsset(assetStr string, isSavers bool) (common.Asset, error) {
+	asset, err := common.NewAsset(assetStr)
+	if err != nil {
+		return common.EmptyAsset, fmt.Errorf("fail to parse asset: %w", err)
+	}
+	if asset.IsDerivedAsset() || asset.IsSyntheticAsset() || asset.IsVaultAsset() {
+		return common.EmptyAsset, fmt.Errorf("asset must be a L1 pool asset")
+	}
+	if isSavers {
+		return asset.GetSyntheticAsset(), nil
+	}
+	return asset, nil
+}
+
+func (s queryServer) getLiquidityProvider(ctx cosmos.Context, assetStr, addrStr string, isSavers bool) (LiquidityProvider, error) {
+	asset, err := parsePoolAsset(assetStr, isSavers)
+	if err != nil {
+		return LiquidityProvider{}, err
+	}
+	addr, err := common.NewAddress(addrStr)
+	if err != nil {
+		return LiquidityProvider{}, fmt.Errorf("fail to parse address: %w", err)
+	}
+	lp, err := s.mgr.Keeper().GetLiquidityProvider(ctx, asset, addr)
+	if err != nil {
+		return LiquidityProvider{}, fmt.Errorf("fail to get liquidity provider: %w", err)
+	}
+	return lp, nil
+}
+
+func (s queryServer) listLiquidityProviders(ctx cosmos.Context, assetStr, cursor string, limit int64, minUnitsStr string, isSavers bool) ([]LiquidityProvider, error) {
+	asset, err := parsePoolAsset(assetStr, isSavers)
+	if err != nil {
+		return nil, err
+	}
+	page, err := newGRPCQueryPage(cursor, limit)
+	if err != nil {
+		return nil, err
+	}
+	minUnits := cosmos.ZeroUint()
+	if minUnitsStr != "" {
+		minUnits, err = cosmos.ParseUint(minUnitsStr)
+		if err != nil {
+			return nil, fmt.Errorf("fail to parse min_units: %w", err)
+		}
+	}
+
+	lps := make([]LiquidityProvider, 0)
+	iterator := s.mgr.Keeper().GetLiquidityProviderIteratorAfter(ctx, asset, page.cursor)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var lp LiquidityProvider
+		s.mgr.Keeper().Cdc().MustUnmarshal(iterator.Value(), &lp)
+		if lp.Units.LT(minUnits) {
+			continue
+		}
+		if page.full(len(lps)) {
+			break
+		}
+		lps = append(lps, lp)
+	}
+	return lps, nil
+}
+
+func (s queryServer) LiquidityProvider(c context.Context, req *types.QueryLiquidityProviderRequest) (*types.QueryLiquidityProviderResponse, error) {
+	lp, err := s.getLiquidityProvider(s.unwrapContext(c), req.Asset, req.Address, false)
+	if err != nil {
+		return nil, err
+	}
+	return &types.QueryLiquidityProviderResponse{LiquidityProvider: lp}, nil
+}
+
+func (s queryServer) LiquidityProviders(c context.Context, req *types.QueryLiquidityProvidersRequest) (*types.QueryLiquidityProvidersResponse, error) {
+	lps, err := s.listLiquidityProviders(s.unwrapContext(c), req.Asset, req.Cursor, req.Limit, req.MinUnits, false)
+	if err != nil {
+		return nil, err
+	}
+	return &types.QueryLiquidityProvidersResponse{LiquidityProviders: lps}, nil
+}
+
+func (s queryServer) Saver(c context.Context, req *types.QuerySaverRequest) (*types.QuerySaverResponse, error) {
+	saver, err := s.getLiquidityProvider(s.unwrapContext(c), req.Asset, req.Address, true)
+	if err != nil {
+		return nil, err
+	}
+	return &types.QuerySaverResponse{Saver: saver}, nil
+}
+
+func (s queryServer) Savers(c context.Context, req *types.QuerySaversRequest) (*types.QuerySaversResponse, error) {
+	savers, err := s.listLiquidityProviders(s.unwrapContext(c), req.Asset, req.Cursor, req.Limit, req.MinUnits, true)
+	if err != nil {
+		return nil, err
+	}
+	return &types.QuerySaversResponse{Savers: savers}, nil
+}
+
+func (s queryServer) Node(c context.Context, req *types.QueryNodeRequest) (*types.QueryNodeResponse, error) {
+	ctx := s.unwrapContext(c)
+	addr, err := cosmos.AccAddressFromBech32(req.Address)
+	if err != nil {
+		return nil, cosmos.ErrInvalidAddress(req.Address)
+	}
+	node, err := s.mgr.Keeper().GetNodeAccount(ctx, addr)
+	if err != nil {
+		return nil, fmt.Errorf("fail to get node accounts: %w", err)
+	}
+	if node.IsEmpty() {
+		return nil, fmt.Errorf("node: %s doesn't exist", req.Address)
+	}
+	return &types.QueryNodeResponse{Node: node}, nil
+}
+
+func (s queryServer) Nodes(c context.Context, req *types.QueryNodesRequest) (*types.QueryNodesResponse, error) {
+	ctx := s.unwrapContext(c)
+	page, err := newGRPCQueryPage(req.Cursor, req.Limit)
+	if err != nil {
+		return nil, err
+	}
+	if req.Status != "" {
+		if _, ok := types.NodeStatus_value[strings.Title(strings.ToLower(req.Status))]; !ok { // nolint SA1019
+			return nil, fmt.Errorf("invalid node status: %s", req.Status)
+		}
+	}
+
+	nodes := make([]NodeAccount, 0)
+	iterator := s.mgr.Keeper().GetNodeAccountIteratorAfter(ctx, page.cursor)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var na NodeAccount
+		if err := s.mgr.Keeper().Cdc().Unmarshal(iterator.Value(), &na); err != nil {
+			return nil, fmt.Errorf("fail to unmarshal node account: %w", err)
+		}
+		if na.Type != NodeTypeValidator || na.Bond.IsZero() {
+			continue
+		}
+		if na.RequestedToLeave && na.Bond.LTE(cosmos.NewUint(common.One)) {
+			// ignore the node , it left and also has very little bond
+			continue
+		}
+		if req.Status != "" && !strings.EqualFold(na.Status.String(), req.Status) {
+			continue
+		}
+		if page.full(len(nodes)) {
+			break
+		}
+		nodes = append(nodes, na)
+	}
+	return &types.QueryNodesResponse{Nodes: nodes}, nil
+}
+
+func (s queryServer) Vault(c context.Context, req *types.QueryVaultRequest) (*types.QueryVaultResponse, error) {
+	ctx := s.unwrapContext(c)
+	pubkey, err := common.NewPubKey(req.PubKey)
+	if err != nil {
+		return nil, fmt.Errorf("%s is invalid pubkey", req.PubKey)
+	}
+	v, err := s.mgr.Keeper().GetVault(ctx, pubkey)
+	if err != nil {
+		return nil, fmt.Errorf("fail to get vault with pubkey(%s),err:%w", pubkey, err)
+	}
+	if v.IsEmpty() {
+		return nil, errors.New("vault not found")
+	}
+	return &types.QueryVaultResponse{Vault: v}, nil
+}
+
+func (s queryServer) AsgardVaults(c context.Context, req *types.QueryAsgardVaultsRequest) (*types.QueryAsgardVaultsResponse, error) {
+	ctx := s.unwrapContext(c)
+	vaults, err := s.mgr.Keeper().GetAsgardVaults(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("fail to get asgard vaults: %w", err)
+	}
+	result := make([]Vault, 0)
+	for _, vault := range vaults {
+		if vault.Status == InactiveVault || !vault.IsAsgard() {
+			continue
+		}
+		// Being in a RetiringVault blocks a node from unbonding, so display them even if having no funds.
+		if vault.HasFunds() || vault.Status == ActiveVault || vault.Status == RetiringVault {
+			result = append(result, vault)
+		}
+	}
+	return &types.QueryAsgardVaultsResponse{Vaults: result}, nil
+}
+
+func (s queryServer) Mimir(c context.Context, req *types.QueryMimirRequest) (*types.QueryMimirResponse, error) {
+	ctx := s.unwrapContext(c)
+	if req.Key != "" {
+		v, err := s.mgr.Keeper().GetMimir(ctx, req.Key)
+		if err != nil {
+			return nil, fmt.Errorf("fail to get mimir with key:%s, err : %w", req.Key, err)
+		}
+		return &types.QueryMimirResponse{Mimirs: map[string]int64{strings.ToUpper(req.Key): v}}, nil
+	}
+
+	res, err := queryMimirValues(ctx, nil, abci.RequestQuery{}, s.mgr)
+	if err != nil {
+		return nil, err
+	}
+	mimirs := make(map[string]int64)
+	if err := json.Unmarshal(res, &mimirs); err != nil {
+		return nil, fmt.Errorf("fail to unmarshal mimir values: %w", err)
+	}
+	return &types.QueryMimirResponse{Mimirs: mimirs}, nil
+}
+
+func (s queryServer) THORName(c context.Context, req *types.QueryTHORNameRequest) (*types.QueryTHORNameResponse, error) {
+	ctx := s.unwrapContext(c)
+	if !s.mgr.Keeper().THORNameExists(ctx, req.Name) {
+		return nil, fmt.Errorf("THORName: %s doesn't exist", req.Name)
+	}
+	name, err := s.mgr.Keeper().GetTHORName(ctx, req.Name)
+	if err != nil {
+		return nil, ErrInternal(err, "fail to fetch THORName")
+	}
+	affRune, err := s.mgr.Keeper().GetAffiliateCollectorRune(ctx, name.Name)
+	if err != nil {
+		return nil, ErrInternal(err, "fail to fetch affiliate collector rune")
+	}
+	return &types.QueryTHORNameResponse{THORName: name, AffiliateCollectorRune: affRune}, nil
+}
+
+// SwapQueue returns the queued swaps. The cursor is a tx id, and a page never
+// splits the swaps of a tx.
+func (s queryServer) SwapQueue(c context.Context, req *types.QuerySwapQueueRequest) (*types.QuerySwapQueueResponse, error) {
+	ctx := s.unwrapContext(c)
+	page, err := newGRPCQueryPage(req.Cursor, req.Limit)
+	if err != nil {
+		return nil, err
+	}
+
+	swaps := make([]MsgSwap, 0)
+	iterator := s.mgr.Keeper().GetSwapQueueIteratorAfter(ctx, page.cursor)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var msg MsgSwap
+		if err := s.mgr.Keeper().Cdc().Unmarshal(iterator.Value(), &msg); err != nil {
+			continue
+		}
+		if page.full(len(swaps)) && !swaps[len(swaps)-1].Tx.ID.Equals(msg.Tx.ID) {
+			break
+		}
+		swaps = append(swaps, msg)
+	}
+	return &types.QuerySwapQueueResponse{Swaps: swaps}, nil
+}
+
+// OutboundQueue returns the pending outbounds grouped by the height they were
+// scheduled at. The cursor is a height, and a page never splits a block.
+func (s queryServer) OutboundQueue(c context.Context, req *types.QueryOutboundQueueRequest) (*types.QueryOutboundQueueResponse, error) {
+	ctx := s.unwrapContext(c)
+	page, err := newGRPCQueryPage(req.Cursor, req.Limit)
+	if err != nil {
+		return nil, err
+	}
+	var chain common.Chain
+	if req.Chain != "" {
+		chain, err = common.NewChain(req.Chain)
+		if err != nil {
+			return nil, fmt.Errorf("fail to parse chain: %w", err)
+		}
+	}
+
+	signingTransactionPeriod := s.mgr.GetConstants().GetInt64Value(constants.SigningTransactionPeriod)
+	startHeight := ctx.BlockHeight() - signingTransactionPeriod
+	if page.cursor != "" {
+		cursor, err := strconv.ParseInt(page.cursor, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("fail to parse cursor: %w", err)
+		}
+		if cursor >= startHeight {
+			startHeight = cursor + 1
+		}
+	}
+
+	outbounds := make([]TxOut, 0)
+	count := 0
+	for height := startHeight; height <= ctx.BlockHeight() && !page.full(count); height++ {
+		txs, err := s.mgr.Keeper().GetTxOut(ctx, height)
+		if err != nil {
+			return nil, fmt.Errorf("fail to get tx out array from key value store: %w", err)
+		}
+		pending := NewTxOut(height)
+		for _, tx := range txs.TxArray {
+			if !tx.OutHash.IsEmpty() {
+				continue
+			}
+			if !chain.IsEmpty() && !tx.Chain.Equals(chain) {
+				continue
+			}
+			pending.TxArray = append(pending.TxArray, tx)
+		}
+		if len(pending.TxArray) > 0 {
+			outbounds = append(outbounds, *pending)
+			count += len(pending.TxArray)
+		}
+	}
+	return &types.QueryOutboundQueueResponse{Outbounds: outbounds}, nil
+}
+
+// quote runs a legacy quote query with the given parameters, and decodes its
+// json response into resp
+func (s queryServer) quote(ctx cosmos.Context, path string, params url.Values, fn cosmos.Querier, resp any) error {
+	// drop the parameters that were not set, quotes treat them as provided
+	for k, v := range params {
+		if len(v) == 0 || v[0] == "" {
+			params.Del(k)
+		}
+	}
+	data := fmt.Sprintf("%s?%s", path, params.Encode())
+	res, err := fn(ctx, nil, abci.RequestQuery{Data: []byte(data)})
+	if err != nil {
+		return err
+	}
+	var quoteErr struct {
+		Error string `json:"error"`
+	}
+	if err := json.Unmarshal(res, &quoteErr); err == nil && quoteErr.Error != "" {
+		return errors.New(quoteErr.Error)
+	}
+	return json.Unmarshal(res, resp)
+}
+
+// querier binds a legacy query function to the managers of the server
+func (s queryServer) querier(fn func(cosmos.Context, []string, abci.RequestQuery, *Mgrs) ([]byte, error)) cosmos.Querier {
+	return func(ctx cosmos.Context, path []string, req abci.RequestQuery) ([]byte, error) {
+		return fn(ctx, path, req, s.mgr)
+	}
+}
+
+func (s queryServer) QuoteSwap(c context.Context, req *types.QueryQuoteSwapRequest) (*types.QueryQuoteSwapResponse, error) {
+	params := url.Values{
+		fromAssetParam:            {req.FromAsset},
+		toAssetParam:              {req.ToAsset},
+		amountParam:               {req.Amount},
+		destinationParam:          {req.Destination},
+		fromAddressParam:          {req.FromAddress},
+		toleranceBasisPointsParam: {req.ToleranceBps},
+		affiliateParam:            {req.Affiliate},
+		affiliateBpsParam:         {req.AffiliateBps},
+		streamingIntervalParam:    {req.StreamingInterval},
+		streamingQuantityParam:    {req.StreamingQuantity},
+		compareSynthParam:         {req.CompareSynth},
+	}
+	resp := &types.QueryQuoteSwapResponse{}
+	if err := s.quote(s.unwrapContext(c), "/thorchain/quote/swap", params, s.querier(queryQuoteSwap), resp); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+func (s queryServer) QuoteSaverDeposit(c context.Context, req *types.QueryQuoteSaverDepositRequest) (*types.QueryQuoteSaverDepositResponse, error) {
+	params := url.Values{
+		assetParam:  {req.Asset},
+		amountParam: {req.Amount},
+	}
+	resp := &types.QueryQuoteSaverDepositResponse{}
+	if err := s.quote(s.unwrapContext(c), "/thorchain/quote/saver/deposit", params, s.querier(queryQuoteSaverDeposit), resp); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+func (s queryServer) QuoteSaverWithdraw(c context.Context, req *types.QueryQuoteSaverWithdrawRequest) (*types.QueryQuoteSaverWithdrawResponse, error) {
+	params := url.Values{
+		assetParam:               {req.Asset},
+		addressParam:             {req.Address},
+		withdrawBasisPointsParam: {req.WithdrawBps},
+	}
+	resp := &types.QueryQuoteSaverWithdrawResponse{}
+	if err := s.quote(s.unwrapContext(c), "/thorchain/quote/saver/withdraw", params, s.querier(queryQuoteSaverWithdraw), resp); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
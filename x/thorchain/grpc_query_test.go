@@ -0,0 +1,82 @@
+package thorchain
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	. "gopkg.in/check.v1"
+
+	"gitlab.com/thorchain/thornode/common"
+	"gitlab.com/thorchain/thornode/common/cosmos"
+	"gitlab.com/thorchain/thornode/x/thorchain/types"
+)
+
+type GRPCQuerySuite struct{}
+
+var _ = Suite(&GRPCQuerySuite{})
+
+func (s *GRPCQuerySuite) TestPool(c *C) {
+	ctx, mgr := setupManagerForTest(c)
+	server := NewQueryServerImpl(mgr)
+
+	pool := NewPool()
+	pool.Asset = common.BTCAsset
+	pool.BalanceRune = cosmos.NewUint(100 * common.One)
+	pool.BalanceAsset = cosmos.NewUint(10 * common.One)
+	pool.LPUnits = cosmos.NewUint(100)
+	c.Assert(mgr.Keeper().SetPool(ctx, pool), IsNil)
+
+	resp, err := server.Pool(sdk.WrapSDKContext(ctx), &types.QueryPoolRequest{Asset: "BTC.BTC"})
+	c.Assert(err, IsNil)
+	c.Check(resp.Pool.Asset.Equals(common.BTCAsset), Equals, true)
+	c.Check(resp.Pool.BalanceRune.Equal(pool.BalanceRune), Equals, true)
+
+	pools, err := server.Pools(sdk.WrapSDKContext(ctx), &types.QueryPoolsRequest{})
+	c.Assert(err, IsNil)
+	c.Check(pools.Pools, HasLen, 1)
+
+	_, err = server.Pool(sdk.WrapSDKContext(ctx), &types.QueryPoolRequest{Asset: "ETH.ETH"})
+	c.Assert(err, NotNil)
+}
+
+func (s *GRPCQuerySuite) TestSavers(c *C) {
+	ctx, mgr := setupManagerForTest(c)
+	server := NewQueryServerImpl(mgr)
+
+	for _, units := range []uint64{10, 20, 30} {
+		mgr.Keeper().SetLiquidityProvider(ctx, LiquidityProvider{
+			Asset:        common.BTCAsset.GetSyntheticAsset(),
+			AssetAddress: GetRandomBTCAddress(),
+			Units:        cosmos.NewUint(units),
+		})
+	}
+
+	resp, err := server.Savers(sdk.WrapSDKContext(ctx), &types.QuerySaversRequest{Asset: "BTC.BTC"})
+	c.Assert(err, IsNil)
+	c.Assert(resp.Savers, HasLen, 3)
+
+	page, err := server.Savers(sdk.WrapSDKContext(ctx), &types.QuerySaversRequest{Asset: "BTC.BTC", Limit: 2})
+	c.Assert(err, IsNil)
+	c.Assert(page.Savers, HasLen, 2)
+	cursor := page.Savers[1].GetAddress().String()
+	page, err = server.Savers(sdk.WrapSDKContext(ctx), &types.QuerySaversRequest{Asset: "BTC.BTC", Limit: 2, Cursor: cursor})
+	c.Assert(err, IsNil)
+	c.Assert(page.Savers, HasLen, 1)
+	c.Check(page.Savers[0].AssetAddress.Equals(resp.Savers[2].AssetAddress), Equals, true)
+
+	saver, err := server.Saver(sdk.WrapSDKContext(ctx), &types.QuerySaverRequest{Asset: "BTC.BTC", Address: cursor})
+	c.Assert(err, IsNil)
+	c.Check(saver.Saver.AssetAddress.String(), Equals, cursor)
+
+	// savers are requested with the L1 asset
+	_, err = server.Savers(sdk.WrapSDKContext(ctx), &types.QuerySaversRequest{Asset: "BTC/BTC"})
+	c.Assert(err, NotNil)
+}
+
+func (s *GRPCQuerySuite) TestQuoteError(c *C) {
+	ctx, mgr := setupManagerForTest(c)
+	server := NewQueryServerImpl(mgr)
+
+	// errors in the json quote response are returned as errors
+	_, err := server.QuoteSwap(sdk.WrapSDKContext(ctx), &types.QueryQuoteSwapRequest{FromAsset: "BTC.BTC"})
+	c.Assert(err, NotNil)
+	c.Check(err.Error(), Equals, "missing required parameter to_asset")
+}
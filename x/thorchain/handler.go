@@ -152,6 +152,7 @@ func getInternalHandlerMapping(mgr Manager) map[string]MsgHandler {
 	m[MsgManageTHORName{}.Type()] = NewManageTHORNameHandler(mgr)
 	m[MsgLoanOpen{}.Type()] = NewLoanOpenHandler(mgr)
 	m[MsgLoanRepayment{}.Type()] = NewLoanRepaymentHandler(mgr)
+	m[MsgModifyLimitOrder{}.Type()] = NewModifyLimitOrderHandler(mgr)
 	return m
 }
 
@@ -159,7 +160,11 @@ func getMsgSwapFromMemo(memo SwapMemo, tx ObservedTx, signer cosmos.AccAddress)
 	if memo.Destination.IsEmpty() {
 		memo.Destination = tx.Tx.FromAddress
 	}
-	return NewMsgSwap(tx.Tx, memo.GetAsset(), memo.Destination, memo.SlipLimit, memo.AffiliateAddress, memo.AffiliateBasisPoints, memo.GetDexAggregator(), memo.GetDexTargetAddress(), memo.GetDexTargetLimit(), memo.GetOrderType(), signer), nil
+	msg := NewMsgSwap(tx.Tx, memo.GetAsset(), memo.Destination, memo.SlipLimit, memo.AffiliateAddress, memo.AffiliateBasisPoints, memo.GetDexAggregator(), memo.GetDexTargetAddress(), memo.GetDexTargetLimit(), memo.GetOrderType(), signer)
+	msg.StreamInterval = memo.GetStreamInterval()
+	msg.StreamQuantity = memo.GetStreamQuantity()
+	msg.Affiliates = memo.GetAffiliates()
+	return msg, nil
 }
 
 func getMsgWithdrawFromMemo(memo WithdrawLiquidityMemo, tx ObservedTx, signer cosmos.AccAddress) (cosmos.Msg, error) {
@@ -190,7 +195,9 @@ func getMsgAddLiquidityFromMemo(ctx cosmos.Context, memo AddLiquidityMemo, tx Ob
 		assetAddr = runeAddr
 	}
 
-	return NewMsgAddLiquidity(tx.Tx, memo.GetAsset(), runeCoin.Amount, assetCoin.Amount, runeAddr, assetAddr, memo.AffiliateAddress, memo.AffiliateBasisPoints, signer), nil
+	msg := NewMsgAddLiquidity(tx.Tx, memo.GetAsset(), runeCoin.Amount, assetCoin.Amount, runeAddr, assetAddr, memo.AffiliateAddress, memo.AffiliateBasisPoints, signer)
+	msg.Affiliates = memo.GetAffiliates()
+	return msg, nil
 }
 
 func getMsgDonateFromMemo(memo DonateMemo, tx ObservedTx, signer cosmos.AccAddress) (cosmos.Msg, error) {
@@ -227,6 +234,10 @@ func getMsgLoanRepaymentFromMemo(memo LoanRepaymentMemo, from common.Address, co
 	return NewMsgLoanRepayment(memo.Owner, memo.Asset, memo.MinOut, from, coin, signer), nil
 }
 
+func getMsgModifyLimitOrderFromMemo(memo ModifyLimitOrderMemo, tx ObservedTx, signer cosmos.AccAddress) (cosmos.Msg, error) {
+	return NewMsgModifyLimitOrder(tx.Tx, memo.GetTxID(), memo.ModifiedTargetAmount, signer), nil
+}
+
 func getMsgBondFromMemo(memo BondMemo, tx ObservedTx, signer cosmos.AccAddress) (cosmos.Msg, error) {
 	coin := tx.Tx.Coins.GetCoin(common.RuneAsset())
 	return NewMsgBond(tx.Tx, memo.GetAccAddress(), coin.Amount, tx.Tx.FromAddress, memo.BondProviderAddress, signer, memo.NodeOperatorFee), nil
@@ -240,7 +251,9 @@ func getMsgManageTHORNameFromMemo(memo ManageTHORNameMemo, tx ObservedTx, signer
 	if len(tx.Tx.Coins) == 0 {
 		return nil, fmt.Errorf("transaction must have rune in it")
 	}
-	return NewMsgManageTHORName(memo.Name, memo.Chain, memo.Address, tx.Tx.Coins[0], memo.Expire, memo.PreferredAsset, memo.Owner, signer), nil
+	msg := NewMsgManageTHORName(memo.Name, memo.Chain, memo.Address, tx.Tx.Coins[0], memo.Expire, memo.PreferredAsset, memo.Owner, signer)
+	msg.Managers = memo.Managers
+	return msg, nil
 }
 
 func processOneTxIn(ctx cosmos.Context, version semver.Version, keeper keeper.Keeper, tx ObservedTx, signer cosmos.AccAddress) (cosmos.Msg, error) {
@@ -273,6 +286,9 @@ func processOneTxInV107(ctx cosmos.Context, keeper keeper.Keeper, tx ObservedTx,
 		m.Asset = fuzzyAssetMatch(ctx, keeper, m.Asset)
 		m.DexTargetAddress = externalAssetMatch(keeper.GetVersion(), m.Asset.GetChain(), m.DexTargetAddress)
 		newMsg, err = getMsgSwapFromMemo(m, tx, signer)
+		if swapMsg, ok := newMsg.(*MsgSwap); ok && m.GetOrderTTL() > 0 {
+			swapMsg.ExpiryHeight = ctx.BlockHeight() + m.GetOrderTTL()
+		}
 	case DonateMemo:
 		m.Asset = fuzzyAssetMatch(ctx, keeper, m.Asset)
 		newMsg, err = getMsgDonateFromMemo(m, tx, signer)
@@ -315,6 +331,8 @@ func processOneTxInV107(ctx cosmos.Context, keeper keeper.Keeper, tx ObservedTx,
 			from = tx.Tx.FromAddress
 		}
 		newMsg, err = getMsgLoanRepaymentFromMemo(m, from, tx.Tx.Coins[0], signer)
+	case ModifyLimitOrderMemo:
+		newMsg, err = getMsgModifyLimitOrderFromMemo(m, tx, signer)
 	default:
 		return nil, errInvalidMemo
 	}
@@ -220,6 +220,8 @@ func (h AddLiquidityHandler) validateV112(ctx cosmos.Context, msg MsgAddLiquidit
 func (h AddLiquidityHandler) handle(ctx cosmos.Context, msg MsgAddLiquidity) error {
 	version := h.mgr.GetVersion()
 	switch {
+	case version.GTE(semver.MustParse("1.114.0")):
+		return h.handleV114(ctx, msg)
 	case version.GTE(semver.MustParse("1.107.0")):
 		return h.handleV107(ctx, msg)
 	case version.GTE(semver.MustParse("1.98.0")):
@@ -235,10 +237,10 @@ func (h AddLiquidityHandler) handle(ctx cosmos.Context, msg MsgAddLiquidity) err
 	}
 }
 
-func (h AddLiquidityHandler) handleV107(ctx cosmos.Context, msg MsgAddLiquidity) (errResult error) {
+func (h AddLiquidityHandler) handleV114(ctx cosmos.Context, msg MsgAddLiquidity) (errResult error) {
 	// check if we need to swap before adding asset
 	if h.needsSwap(msg) {
-		return h.swapV93(ctx, msg)
+		return h.swapV114(ctx, msg)
 	}
 
 	pool, err := h.mgr.Keeper().GetPool(ctx, msg.Asset)
@@ -293,7 +295,8 @@ func (h AddLiquidityHandler) handleV107(ctx cosmos.Context, msg MsgAddLiquidity)
 		}
 	}
 
-	if msg.AffiliateBasisPoints.IsZero() {
+	affiliates := msg.GetAffiliates()
+	if len(affiliates) == 0 {
 		return h.addLiquidity(
 			ctx,
 			msg.Asset,
@@ -306,11 +309,18 @@ func (h AddLiquidityHandler) handleV107(ctx cosmos.Context, msg MsgAddLiquidity)
 			h.mgr.GetConstants())
 	}
 
-	// add liquidity has an affiliate fee, add liquidity for both the user and their affiliate
-	affiliateRune := common.GetSafeShare(msg.AffiliateBasisPoints, cosmos.NewUint(10000), msg.RuneAmount)
-	affiliateAsset := common.GetSafeShare(msg.AffiliateBasisPoints, cosmos.NewUint(10000), msg.AssetAmount)
-	userRune := common.SafeSub(msg.RuneAmount, affiliateRune)
-	userAsset := common.SafeSub(msg.AssetAmount, affiliateAsset)
+	// add liquidity has affiliate fees, add liquidity for both the user and
+	// each of their affiliates
+	affiliateRunes := make([]cosmos.Uint, len(affiliates))
+	affiliateAssets := make([]cosmos.Uint, len(affiliates))
+	userRune := msg.RuneAmount
+	userAsset := msg.AssetAmount
+	for i, aff := range affiliates {
+		affiliateRunes[i] = common.GetSafeShare(aff.BasisPoints, cosmos.NewUint(10000), msg.RuneAmount)
+		affiliateAssets[i] = common.GetSafeShare(aff.BasisPoints, cosmos.NewUint(10000), msg.AssetAmount)
+		userRune = common.SafeSub(userRune, affiliateRunes[i])
+		userAsset = common.SafeSub(userAsset, affiliateAssets[i])
+	}
 
 	err = h.addLiquidity(
 		ctx,
@@ -327,33 +337,53 @@ func (h AddLiquidityHandler) handleV107(ctx cosmos.Context, msg MsgAddLiquidity)
 		return err
 	}
 
-	affiliateRuneAddress := common.NoAddress
-	affiliateAssetAddress := common.NoAddress
-	if msg.AffiliateAddress.IsChain(common.THORChain) {
-		affiliateRuneAddress = msg.AffiliateAddress
-	} else {
-		affiliateAssetAddress = msg.AffiliateAddress
-	}
+	for i, aff := range affiliates {
+		if affiliateRunes[i].IsZero() && affiliateAssets[i].IsZero() {
+			continue
+		}
 
-	err = h.addLiquidity(
-		ctx,
-		msg.Asset,
-		affiliateRune,
-		affiliateAsset,
-		affiliateRuneAddress,
-		affiliateAssetAddress,
-		msg.Tx.ID,
-		false,
-		h.mgr.GetConstants(),
-	)
-	if err != nil {
-		ctx.Logger().Error("fail to add liquidity for affiliate", "address", msg.AffiliateAddress, "error", err)
-		return err
+		affiliateRuneAddress := common.NoAddress
+		affiliateAssetAddress := common.NoAddress
+		if aff.Address.IsChain(common.THORChain) {
+			affiliateRuneAddress = aff.Address
+		} else {
+			affiliateAssetAddress = aff.Address
+		}
+
+		err = h.addLiquidity(
+			ctx,
+			msg.Asset,
+			affiliateRunes[i],
+			affiliateAssets[i],
+			affiliateRuneAddress,
+			affiliateAssetAddress,
+			msg.Tx.ID,
+			false,
+			h.mgr.GetConstants(),
+		)
+		if err != nil {
+			ctx.Logger().Error("fail to add liquidity for affiliate", "address", aff.Address, "error", err)
+			return err
+		}
+
+		fees := common.Coins{
+			common.NewCoin(common.RuneAsset(), affiliateRunes[i]),
+			common.NewCoin(msg.Asset, affiliateAssets[i]),
+		}
+		for _, fee := range fees {
+			if fee.IsEmpty() {
+				continue
+			}
+			evt := NewEventAffiliateFee(msg.Tx.ID, aff.Address, aff.BasisPoints, fee)
+			if err := h.mgr.EventMgr().EmitEvent(ctx, evt); err != nil {
+				ctx.Logger().Error("fail to emit affiliate fee event", "error", err)
+			}
+		}
 	}
 	return nil
 }
 
-func (h AddLiquidityHandler) swapV93(ctx cosmos.Context, msg MsgAddLiquidity) error {
+func (h AddLiquidityHandler) swapV114(ctx cosmos.Context, msg MsgAddLiquidity) error {
 	// ensure TxID does NOT have a collision with another swap, this could
 	// happen if the user submits two identical loan requests in the same
 	// block
@@ -361,11 +391,18 @@ func (h AddLiquidityHandler) swapV93(ctx cosmos.Context, msg MsgAddLiquidity) er
 		return fmt.Errorf("txn hash conflict")
 	}
 
+	affAddrs := make([]string, 0)
+	affPts := make([]string, 0)
+	for _, aff := range msg.GetAffiliates() {
+		affAddrs = append(affAddrs, aff.Address.String())
+		affPts = append(affPts, aff.BasisPoints.String())
+	}
+
 	// sanity check, ensure address or asset doesn't have separator within them
-	if strings.Contains(fmt.Sprintf("%s%s", msg.Asset, msg.AffiliateAddress), ":") {
+	if strings.Contains(fmt.Sprintf("%s%s", msg.Asset, strings.Join(affAddrs, "")), ":") {
 		return fmt.Errorf("illegal character")
 	}
-	memo := fmt.Sprintf("+:%s::%s:%d", msg.Asset, msg.AffiliateAddress, msg.AffiliateBasisPoints.Uint64())
+	memo := fmt.Sprintf("+:%s::%s:%s", msg.Asset, strings.Join(affAddrs, "/"), strings.Join(affPts, "/"))
 	msg.Tx.Memo = memo
 	swapMsg := NewMsgSwap(msg.Tx, msg.Asset, common.NoopAddress, cosmos.ZeroUint(), common.NoAddress, cosmos.ZeroUint(), "", "", nil, MarketOrder, msg.Signer)
 
@@ -480,6 +517,8 @@ func (h AddLiquidityHandler) addLiquidity(ctx cosmos.Context,
 ) error {
 	version := h.mgr.GetVersion()
 	switch {
+	case version.GTE(semver.MustParse("1.114.0")):
+		return h.addLiquidityV114(ctx, asset, addRuneAmount, addAssetAmount, runeAddr, assetAddr, requestTxHash, stage, constAccessor)
 	case version.GTE(semver.MustParse("1.107.0")):
 		return h.addLiquidityV107(ctx, asset, addRuneAmount, addAssetAmount, runeAddr, assetAddr, requestTxHash, stage, constAccessor)
 	case version.GTE(semver.MustParse("1.98.0")):
@@ -497,7 +536,7 @@ func (h AddLiquidityHandler) addLiquidity(ctx cosmos.Context,
 	}
 }
 
-func (h AddLiquidityHandler) addLiquidityV107(ctx cosmos.Context,
+func (h AddLiquidityHandler) addLiquidityV114(ctx cosmos.Context,
 	asset common.Asset,
 	addRuneAmount, addAssetAmount cosmos.Uint,
 	runeAddr, assetAddr common.Address,
@@ -687,6 +726,15 @@ func (h AddLiquidityHandler) addLiquidityV107(ctx cosmos.Context,
 			return err
 		}
 
+		polPool, err := h.mgr.Keeper().GetPOLPool(ctx, pool.Asset)
+		if err != nil {
+			return err
+		}
+		polPool.RuneDeposited = polPool.RuneDeposited.Add(pendingRuneAmt)
+		if err := h.mgr.Keeper().SetPOLPool(ctx, pool.Asset, polPool); err != nil {
+			return err
+		}
+
 		ctx.Logger().Info("POL deposit", "pool", pool.Asset, "rune", pendingRuneAmt)
 		telemetry.IncrCounterWithLabels(
 			[]string{"thornode", "pol", "pool", "rune_deposited"},
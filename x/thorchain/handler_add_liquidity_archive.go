@@ -3,6 +3,7 @@ package thorchain
 import (
 	"errors"
 	"fmt"
+	"strings"
 
 	"github.com/armon/go-metrics"
 	"github.com/cosmos/cosmos-sdk/telemetry"
@@ -548,6 +549,153 @@ func (h AddLiquidityHandler) validateV76(ctx cosmos.Context, msg MsgAddLiquidity
 	return nil
 }
 
+func (h AddLiquidityHandler) handleV107(ctx cosmos.Context, msg MsgAddLiquidity) (errResult error) {
+	// check if we need to swap before adding asset
+	if h.needsSwap(msg) {
+		return h.swapV93(ctx, msg)
+	}
+
+	pool, err := h.mgr.Keeper().GetPool(ctx, msg.Asset)
+	if err != nil {
+		return ErrInternal(err, "fail to get pool")
+	}
+
+	if pool.IsEmpty() {
+		ctx.Logger().Info("pool doesn't exist yet, creating a new one...", "symbol", msg.Asset.String(), "creator", msg.RuneAddress)
+
+		pool.Asset = msg.Asset
+
+		defaultPoolStatus := PoolAvailable.String()
+		// only set the pool to default pool status if not for gas asset on the chain
+		if !pool.Asset.Equals(pool.Asset.GetChain().GetGasAsset()) &&
+			!pool.Asset.IsVaultAsset() {
+			defaultPoolStatus = h.mgr.GetConstants().GetStringValue(constants.DefaultPoolStatus)
+		}
+		pool.Status = GetPoolStatus(defaultPoolStatus)
+
+		if err := h.mgr.Keeper().SetPool(ctx, pool); err != nil {
+			return ErrInternal(err, "fail to save pool to key value store")
+		}
+	}
+
+	// if the pool decimals hasn't been set, it will still be 0. If we have a
+	// pool asset coin, get the decimals from that transaction. This will only
+	// set the decimals once.
+	if pool.Decimals == 0 {
+		coin := msg.GetTx().Coins.GetCoin(pool.Asset)
+		if !coin.IsEmpty() {
+			if coin.Decimals > 0 {
+				pool.Decimals = coin.Decimals
+			}
+			ctx.Logger().Info("try update pool decimals", "asset", msg.Asset, "pool decimals", pool.Decimals)
+			if err := h.mgr.Keeper().SetPool(ctx, pool); err != nil {
+				return ErrInternal(err, "fail to save pool to key value store")
+			}
+		}
+	}
+
+	// figure out if we need to stage the funds and wait for a follow on
+	// transaction to commit all funds atomically. For pools of native assets
+	// only, stage is always false
+	stage := false
+	if !msg.Asset.IsVaultAsset() {
+		if !msg.AssetAddress.IsEmpty() && msg.AssetAmount.IsZero() {
+			stage = true
+		}
+		if !msg.RuneAddress.IsEmpty() && msg.RuneAmount.IsZero() {
+			stage = true
+		}
+	}
+
+	if msg.AffiliateBasisPoints.IsZero() {
+		return h.addLiquidity(
+			ctx,
+			msg.Asset,
+			msg.RuneAmount,
+			msg.AssetAmount,
+			msg.RuneAddress,
+			msg.AssetAddress,
+			msg.Tx.ID,
+			stage,
+			h.mgr.GetConstants())
+	}
+
+	// add liquidity has an affiliate fee, add liquidity for both the user and their affiliate
+	affiliateRune := common.GetSafeShare(msg.AffiliateBasisPoints, cosmos.NewUint(10000), msg.RuneAmount)
+	affiliateAsset := common.GetSafeShare(msg.AffiliateBasisPoints, cosmos.NewUint(10000), msg.AssetAmount)
+	userRune := common.SafeSub(msg.RuneAmount, affiliateRune)
+	userAsset := common.SafeSub(msg.AssetAmount, affiliateAsset)
+
+	err = h.addLiquidity(
+		ctx,
+		msg.Asset,
+		userRune,
+		userAsset,
+		msg.RuneAddress,
+		msg.AssetAddress,
+		msg.Tx.ID,
+		stage,
+		h.mgr.GetConstants(),
+	)
+	if err != nil {
+		return err
+	}
+
+	affiliateRuneAddress := common.NoAddress
+	affiliateAssetAddress := common.NoAddress
+	if msg.AffiliateAddress.IsChain(common.THORChain) {
+		affiliateRuneAddress = msg.AffiliateAddress
+	} else {
+		affiliateAssetAddress = msg.AffiliateAddress
+	}
+
+	err = h.addLiquidity(
+		ctx,
+		msg.Asset,
+		affiliateRune,
+		affiliateAsset,
+		affiliateRuneAddress,
+		affiliateAssetAddress,
+		msg.Tx.ID,
+		false,
+		h.mgr.GetConstants(),
+	)
+	if err != nil {
+		ctx.Logger().Error("fail to add liquidity for affiliate", "address", msg.AffiliateAddress, "error", err)
+		return err
+	}
+	return nil
+}
+
+func (h AddLiquidityHandler) swapV93(ctx cosmos.Context, msg MsgAddLiquidity) error {
+	// ensure TxID does NOT have a collision with another swap, this could
+	// happen if the user submits two identical loan requests in the same
+	// block
+	if ok := h.mgr.Keeper().HasSwapQueueItem(ctx, msg.Tx.ID, 0); ok {
+		return fmt.Errorf("txn hash conflict")
+	}
+
+	// sanity check, ensure address or asset doesn't have separator within them
+	if strings.Contains(fmt.Sprintf("%s%s", msg.Asset, msg.AffiliateAddress), ":") {
+		return fmt.Errorf("illegal character")
+	}
+	memo := fmt.Sprintf("+:%s::%s:%d", msg.Asset, msg.AffiliateAddress, msg.AffiliateBasisPoints.Uint64())
+	msg.Tx.Memo = memo
+	swapMsg := NewMsgSwap(msg.Tx, msg.Asset, common.NoopAddress, cosmos.ZeroUint(), common.NoAddress, cosmos.ZeroUint(), "", "", nil, MarketOrder, msg.Signer)
+
+	// sanity check swap msg
+	handler := NewSwapHandler(h.mgr)
+	if err := handler.validate(ctx, *swapMsg); err != nil {
+		return err
+	}
+	if err := h.mgr.Keeper().SetSwapQueueItem(ctx, *swapMsg, 0); err != nil {
+		ctx.Logger().Error("fail to add swap to queue", "error", err)
+		return err
+	}
+
+	return nil
+}
+
 func (h AddLiquidityHandler) handleV98(ctx cosmos.Context, msg MsgAddLiquidity) (errResult error) {
 	// check if we need to swap before adding asset
 	if h.needsSwap(msg) {
@@ -1010,6 +1158,206 @@ func calculatePoolUnitsV1(oldPoolUnits, poolRune, poolAsset, addRune, addAsset c
 	return pUnits, sUnits, nil
 }
 
+func (h AddLiquidityHandler) addLiquidityV107(ctx cosmos.Context,
+	asset common.Asset,
+	addRuneAmount, addAssetAmount cosmos.Uint,
+	runeAddr, assetAddr common.Address,
+	requestTxHash common.TxID,
+	stage bool,
+	constAccessor constants.ConstantValues,
+) (err error) {
+	ctx.Logger().Info("liquidity provision", "asset", asset, "rune amount", addRuneAmount, "asset amount", addAssetAmount)
+	if err := h.validateAddLiquidityMessage(ctx, h.mgr.Keeper(), asset, requestTxHash, runeAddr, assetAddr); err != nil {
+		return fmt.Errorf("add liquidity message fail validation: %w", err)
+	}
+
+	pool, err := h.mgr.Keeper().GetPool(ctx, asset)
+	if err != nil {
+		return ErrInternal(err, fmt.Sprintf("fail to get pool(%s)", asset))
+	}
+	synthSupply := h.mgr.Keeper().GetTotalSupply(ctx, pool.Asset.GetSyntheticAsset())
+	originalUnits := pool.CalcUnits(h.mgr.GetVersion(), synthSupply)
+
+	fetchAddr := runeAddr
+	if fetchAddr.IsEmpty() {
+		fetchAddr = assetAddr
+	}
+	su, err := h.mgr.Keeper().GetLiquidityProvider(ctx, asset, fetchAddr)
+	if err != nil {
+		return ErrInternal(err, "fail to get liquidity provider")
+	}
+
+	su.LastAddHeight = ctx.BlockHeight()
+	if su.Units.IsZero() {
+		if su.PendingTxID.IsEmpty() {
+			if su.RuneAddress.IsEmpty() {
+				su.RuneAddress = runeAddr
+			}
+			if su.AssetAddress.IsEmpty() {
+				su.AssetAddress = assetAddr
+			}
+		}
+
+		if asset.IsVaultAsset() {
+			// new SU, by default, places the thor address to the rune address,
+			// but here we want it to be on the asset address only
+			su.AssetAddress = assetAddr
+			su.RuneAddress = common.NoAddress // no rune to add/withdraw
+		} else {
+			// ensure input addresses match LP position addresses
+			if !runeAddr.Equals(su.RuneAddress) {
+				return errAddLiquidityMismatchAddr
+			}
+			if !assetAddr.Equals(su.AssetAddress) {
+				return errAddLiquidityMismatchAddr
+			}
+		}
+	}
+
+	if asset.IsVaultAsset() {
+		if su.AssetAddress.IsEmpty() || !su.AssetAddress.IsChain(asset.GetLayer1Asset().GetChain()) {
+			return errAddLiquidityMismatchAddr
+		}
+	} else if !assetAddr.IsEmpty() && !su.AssetAddress.Equals(assetAddr) {
+		// mismatch of asset addresses from what is known to the address
+		// given. Refund it.
+		return errAddLiquidityMismatchAddr
+	}
+
+	// get tx hashes
+	runeTxID := requestTxHash
+	assetTxID := requestTxHash
+	if addRuneAmount.IsZero() {
+		runeTxID = su.PendingTxID
+	} else {
+		assetTxID = su.PendingTxID
+	}
+
+	pendingRuneAmt := su.PendingRune.Add(addRuneAmount)
+	pendingAssetAmt := su.PendingAsset.Add(addAssetAmount)
+
+	// if we have an asset address and no asset amount, put the rune pending
+	if stage && pendingAssetAmt.IsZero() {
+		pool.PendingInboundRune = pool.PendingInboundRune.Add(addRuneAmount)
+		su.PendingRune = pendingRuneAmt
+		su.PendingTxID = requestTxHash
+		h.mgr.Keeper().SetLiquidityProvider(ctx, su)
+		if err := h.mgr.Keeper().SetPool(ctx, pool); err != nil {
+			ctx.Logger().Error("fail to save pool pending inbound rune", "error", err)
+		}
+
+		// add pending liquidity event
+		evt := NewEventPendingLiquidity(pool.Asset, AddPendingLiquidity, su.RuneAddress, addRuneAmount, su.AssetAddress, cosmos.ZeroUint(), requestTxHash, common.TxID(""))
+		if err := h.mgr.EventMgr().EmitEvent(ctx, evt); err != nil {
+			return ErrInternal(err, "fail to emit partial add liquidity event")
+		}
+		return nil
+	}
+
+	// if we have a rune address and no rune asset, put the asset in pending
+	if stage && pendingRuneAmt.IsZero() {
+		pool.PendingInboundAsset = pool.PendingInboundAsset.Add(addAssetAmount)
+		su.PendingAsset = pendingAssetAmt
+		su.PendingTxID = requestTxHash
+		h.mgr.Keeper().SetLiquidityProvider(ctx, su)
+		if err := h.mgr.Keeper().SetPool(ctx, pool); err != nil {
+			ctx.Logger().Error("fail to save pool pending inbound asset", "error", err)
+		}
+		evt := NewEventPendingLiquidity(pool.Asset, AddPendingLiquidity, su.RuneAddress, cosmos.ZeroUint(), su.AssetAddress, addAssetAmount, common.TxID(""), requestTxHash)
+		if err := h.mgr.EventMgr().EmitEvent(ctx, evt); err != nil {
+			return ErrInternal(err, "fail to emit partial add liquidity event")
+		}
+		return nil
+	}
+
+	pool.PendingInboundRune = common.SafeSub(pool.PendingInboundRune, su.PendingRune)
+	pool.PendingInboundAsset = common.SafeSub(pool.PendingInboundAsset, su.PendingAsset)
+	su.PendingAsset = cosmos.ZeroUint()
+	su.PendingRune = cosmos.ZeroUint()
+	su.PendingTxID = ""
+
+	ctx.Logger().Info("pre add liquidity", "pool", pool.Asset, "rune", pool.BalanceRune, "asset", pool.BalanceAsset, "LP units", pool.LPUnits, "synth units", pool.SynthUnits)
+	ctx.Logger().Info("adding liquidity", "rune", addRuneAmount, "asset", addAssetAmount)
+
+	balanceRune := pool.BalanceRune
+	balanceAsset := pool.BalanceAsset
+
+	oldPoolUnits := pool.GetPoolUnits()
+	var newPoolUnits, liquidityUnits cosmos.Uint
+	if asset.IsVaultAsset() {
+		pendingRuneAmt = cosmos.ZeroUint() // sanity check
+		newPoolUnits, liquidityUnits = calculateVaultUnitsV1(oldPoolUnits, balanceAsset, pendingAssetAmt)
+	} else {
+		newPoolUnits, liquidityUnits, err = h.calculatePoolUnits(oldPoolUnits, balanceRune, balanceAsset, pendingRuneAmt, pendingAssetAmt)
+		if err != nil {
+			return ErrInternal(err, "fail to calculate pool unit")
+		}
+	}
+
+	ctx.Logger().Info("current pool status", "pool units", newPoolUnits, "liquidity units", liquidityUnits)
+	poolRune := balanceRune.Add(pendingRuneAmt)
+	poolAsset := balanceAsset.Add(pendingAssetAmt)
+	pool.LPUnits = pool.LPUnits.Add(liquidityUnits)
+	pool.BalanceRune = poolRune
+	pool.BalanceAsset = poolAsset
+	ctx.Logger().Info("post add liquidity", "pool", pool.Asset, "rune", pool.BalanceRune, "asset", pool.BalanceAsset, "LP units", pool.LPUnits, "synth units", pool.SynthUnits, "add liquidity units", liquidityUnits)
+	if (pool.BalanceRune.IsZero() && !asset.IsVaultAsset()) || pool.BalanceAsset.IsZero() {
+		return ErrInternal(err, "pool cannot have zero rune or asset balance")
+	}
+	if err := h.mgr.Keeper().SetPool(ctx, pool); err != nil {
+		return ErrInternal(err, "fail to save pool")
+	}
+	if originalUnits.IsZero() && !pool.GetPoolUnits().IsZero() {
+		poolEvent := NewEventPool(pool.Asset, pool.Status)
+		if err := h.mgr.EventMgr().EmitEvent(ctx, poolEvent); err != nil {
+			ctx.Logger().Error("fail to emit pool event", "error", err)
+		}
+	}
+
+	su.Units = su.Units.Add(liquidityUnits)
+	if pool.Status == PoolAvailable {
+		if su.AssetDepositValue.IsZero() && su.RuneDepositValue.IsZero() {
+			su.RuneDepositValue = common.GetSafeShare(su.Units, pool.GetPoolUnits(), pool.BalanceRune)
+			su.AssetDepositValue = common.GetSafeShare(su.Units, pool.GetPoolUnits(), pool.BalanceAsset)
+		} else {
+			su.RuneDepositValue = su.RuneDepositValue.Add(common.GetSafeShare(liquidityUnits, pool.GetPoolUnits(), pool.BalanceRune))
+			su.AssetDepositValue = su.AssetDepositValue.Add(common.GetSafeShare(liquidityUnits, pool.GetPoolUnits(), pool.BalanceAsset))
+		}
+	}
+	h.mgr.Keeper().SetLiquidityProvider(ctx, su)
+
+	evt := NewEventAddLiquidity(asset, liquidityUnits, su.RuneAddress, pendingRuneAmt, pendingAssetAmt, runeTxID, assetTxID, su.AssetAddress)
+	if err := h.mgr.EventMgr().EmitEvent(ctx, evt); err != nil {
+		return ErrInternal(err, "fail to emit add liquidity event")
+	}
+
+	// if its the POL is adding, track rune added
+	polAddress, err := h.mgr.Keeper().GetModuleAddress(ReserveName)
+	if err != nil {
+		return err
+	}
+
+	if polAddress.Equals(su.RuneAddress) {
+		pol, err := h.mgr.Keeper().GetPOL(ctx)
+		if err != nil {
+			return err
+		}
+		pol.RuneDeposited = pol.RuneDeposited.Add(pendingRuneAmt)
+
+		if err := h.mgr.Keeper().SetPOL(ctx, pol); err != nil {
+			return err
+		}
+
+		ctx.Logger().Info("POL deposit", "pool", pool.Asset, "rune", pendingRuneAmt)
+		telemetry.IncrCounterWithLabels(
+			[]string{"thornode", "pol", "pool", "rune_deposited"},
+			telem(pendingRuneAmt),
+			[]metrics.Label{telemetry.NewLabel("pool", pool.Asset.String())},
+		)
+	}
+	return nil
+}
+
 func (h AddLiquidityHandler) addLiquidityV98(ctx cosmos.Context,
 	asset common.Asset,
 	addRuneAmount, addAssetAmount cosmos.Uint,
@@ -23,6 +23,7 @@ type MockAddLiquidityKeeper struct {
 	failGetPool       bool
 	lp                LiquidityProvider
 	pol               ProtocolOwnedLiquidity
+	polPool           ProtocolOwnedLiquidity
 	polAddress        common.Address
 }
 
@@ -59,6 +60,15 @@ func (m *MockAddLiquidityKeeper) SetPOL(_ cosmos.Context, pol ProtocolOwnedLiqui
 	return nil
 }
 
+func (m *MockAddLiquidityKeeper) GetPOLPool(_ cosmos.Context, _ common.Asset) (ProtocolOwnedLiquidity, error) {
+	return m.polPool, nil
+}
+
+func (m *MockAddLiquidityKeeper) SetPOLPool(_ cosmos.Context, _ common.Asset, pol ProtocolOwnedLiquidity) error {
+	m.polPool = pol
+	return nil
+}
+
 func (m *MockAddLiquidityKeeper) ListValidatorsWithBond(_ cosmos.Context) (NodeAccounts, error) {
 	return NodeAccounts{m.activeNodeAccount}, nil
 }
@@ -495,6 +505,14 @@ func (p *AddLiquidityTestKeeper) SetPOL(_ cosmos.Context, pol ProtocolOwnedLiqui
 	return nil
 }
 
+func (p *AddLiquidityTestKeeper) GetPOLPool(_ cosmos.Context, _ common.Asset) (ProtocolOwnedLiquidity, error) {
+	return NewProtocolOwnedLiquidity(), nil
+}
+
+func (p *AddLiquidityTestKeeper) SetPOLPool(_ cosmos.Context, _ common.Asset, pol ProtocolOwnedLiquidity) error {
+	return nil
+}
+
 func (p *AddLiquidityTestKeeper) GetLiquidityProvider(ctx cosmos.Context, asset common.Asset, addr common.Address) (LiquidityProvider, error) {
 	if notExistLiquidityProviderAsset.Equals(asset) {
 		return LiquidityProvider{}, errors.New("simulate error for test")
@@ -1065,6 +1083,7 @@ func (s *HandlerAddLiquiditySuite) TestAddLiquidityPOL(c *C) {
 			AssetDepositValue: cosmos.ZeroUint(),
 		},
 		pol:        NewProtocolOwnedLiquidity(),
+		polPool:    NewProtocolOwnedLiquidity(),
 		polAddress: polAddr,
 	}
 	mgr.K = k
@@ -1106,4 +1125,7 @@ func (s *HandlerAddLiquiditySuite) TestAddLiquidityPOL(c *C) {
 	pol, err := mgr.Keeper().GetPOL(ctx)
 	c.Assert(err, IsNil)
 	c.Check(pol.RuneDeposited.Uint64(), Equals, uint64(10000000000))
+	pol, err = mgr.Keeper().GetPOLPool(ctx, common.BNBAsset)
+	c.Assert(err, IsNil)
+	c.Check(pol.RuneDeposited.Uint64(), Equals, uint64(10000000000))
 }
@@ -235,6 +235,8 @@ func (h DepositHandler) handleV113(ctx cosmos.Context, msg MsgDeposit) (*cosmos.
 func (h DepositHandler) addSwap(ctx cosmos.Context, msg MsgSwap) {
 	version := h.mgr.GetVersion()
 	switch {
+	case version.GTE(semver.MustParse("1.114.0")):
+		h.addSwapV114(ctx, msg)
 	case version.GTE(semver.MustParse("1.98.0")):
 		h.addSwapV98(ctx, msg)
 	default:
@@ -242,7 +244,7 @@ func (h DepositHandler) addSwap(ctx cosmos.Context, msg MsgSwap) {
 	}
 }
 
-func (h DepositHandler) addSwapV98(ctx cosmos.Context, msg MsgSwap) {
+func (h DepositHandler) addSwapV114(ctx cosmos.Context, msg MsgSwap) {
 	if h.mgr.Keeper().OrderBooksEnabled(ctx) {
 		source := msg.Tx.Coins[0]
 		target := common.NewCoin(msg.TargetAsset, msg.TradeTarget)
@@ -250,11 +252,33 @@ func (h DepositHandler) addSwapV98(ctx cosmos.Context, msg MsgSwap) {
 		if err := h.mgr.EventMgr().EmitEvent(ctx, evt); err != nil {
 			ctx.Logger().Error("fail to emit limit order event", "error", err)
 		}
-		if err := h.mgr.Keeper().SetOrderBookItem(ctx, msg); err != nil {
+		if err := h.mgr.OrderBookMgr().AddOrderBookItem(ctx, msg); err != nil {
 			ctx.Logger().Error("fail to add swap to queue", "error", err)
 		}
-	} else {
-		h.addSwapV65(ctx, msg)
+		return
+	}
+
+	affiliateFees := takeAffiliateFees(&msg)
+	if err := h.mgr.Keeper().SetSwapQueueItem(ctx, msg, 0); err != nil {
+		ctx.Logger().Error("fail to add swap to queue", "error", err)
+	}
+	for i, fee := range affiliateFees {
+		if fee.swap.Tx.Coins[0].Asset.IsNativeRune() {
+			if err := payAffiliateFeeRune(ctx, h.mgr, fee); err != nil {
+				ctx.Logger().Error("fail to pay affiliate fee", "address", fee.affiliate.Address, "error", err)
+				continue
+			}
+		} else {
+			// fees in any other asset (ie synths) are swapped to RUNE first,
+			// queued after the swap itself
+			if err := h.mgr.Keeper().SetSwapQueueItem(ctx, fee.swap, i+1); err != nil {
+				ctx.Logger().Error("fail to add affiliate fee swap to queue", "error", err)
+				continue
+			}
+		}
+		if err := h.mgr.EventMgr().EmitEvent(ctx, fee.event()); err != nil {
+			ctx.Logger().Error("fail to emit affiliate fee event", "error", err)
+		}
 	}
 }
 
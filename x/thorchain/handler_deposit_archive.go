@@ -756,6 +756,22 @@ func (h DepositHandler) handleV67(ctx cosmos.Context, msg MsgDeposit) (*cosmos.R
 	return result, nil
 }
 
+func (h DepositHandler) addSwapV98(ctx cosmos.Context, msg MsgSwap) {
+	if h.mgr.Keeper().OrderBooksEnabled(ctx) {
+		source := msg.Tx.Coins[0]
+		target := common.NewCoin(msg.TargetAsset, msg.TradeTarget)
+		evt := NewEventLimitOrder(source, target, msg.Tx.ID)
+		if err := h.mgr.EventMgr().EmitEvent(ctx, evt); err != nil {
+			ctx.Logger().Error("fail to emit limit order event", "error", err)
+		}
+		if err := h.mgr.Keeper().SetOrderBookItem(ctx, msg); err != nil {
+			ctx.Logger().Error("fail to add swap to queue", "error", err)
+		}
+	} else {
+		h.addSwapV65(ctx, msg)
+	}
+}
+
 func (h DepositHandler) addSwapV65(ctx cosmos.Context, msg MsgSwap) {
 	amt := cosmos.ZeroUint()
 	swapSourceAsset := msg.Tx.Coins[0].Asset
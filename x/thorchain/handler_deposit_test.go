@@ -246,6 +246,64 @@ func (s *HandlerDepositSuite) TestAddSwapV64(c *C) {
 	c.Assert(acct3.AmountOf(synthAsset.Native()).String(), Equals, strconv.FormatInt(common.One/10, 10))
 }
 
+func (s *HandlerDepositSuite) TestAddSwapV114AffiliateFee(c *C) {
+	ctx, mgr := setupManagerForTest(c)
+	handler := NewDepositHandler(mgr)
+
+	affAddr := GetRandomTHORAddress()
+	name := NewTHORName("alice", ctx.BlockHeight()+100, []THORNameAlias{
+		{Chain: common.THORChain, Address: affAddr},
+		{Chain: common.BTCChain, Address: GetRandomBTCAddress()},
+	})
+	name.Owner = GetRandomBech32Addr()
+	name.PreferredAsset = common.BTCAsset
+	mgr.Keeper().SetTHORName(ctx, name)
+	// hold the fees in the affiliate collector
+	mgr.Keeper().SetMimir(ctx, constants.PreferredAssetOutboundFeeMultiplier.String(), 1_000_000)
+
+	newMsg := func(coin common.Coin) *MsgSwap {
+		tx := common.NewTx(GetRandomTxHash(), GetRandomTHORAddress(), GetRandomTHORAddress(), common.Coins{coin}, nil, "")
+		c.Assert(mgr.Keeper().MintToModule(ctx, ModuleName, coin), IsNil)
+		c.Assert(mgr.Keeper().SendFromModuleToModule(ctx, ModuleName, AsgardName, tx.Coins), IsNil)
+		msg := NewMsgSwap(tx, common.BTCAsset, GetRandomBTCAddress(), cosmos.ZeroUint(), affAddr, cosmos.NewUint(1000), "", "", nil, MarketOrder, GetRandomBech32Addr())
+		msg.Affiliates = []Affiliate{{Address: affAddr, BasisPoints: cosmos.NewUint(1000), THORName: "alice"}}
+		return msg
+	}
+
+	// a RUNE fee is accrued to the THORName straight away
+	msg := newMsg(common.NewCoin(common.RuneNative, cosmos.NewUint(common.One)))
+	handler.addSwapV114(ctx, *msg)
+	swap, err := mgr.Keeper().GetSwapQueueItem(ctx, msg.Tx.ID, 0)
+	c.Assert(err, IsNil)
+	c.Check(swap.Tx.Coins[0].Amount.Uint64(), Equals, uint64(common.One/10*9))
+	c.Check(mgr.Keeper().HasSwapQueueItem(ctx, msg.Tx.ID, 1), Equals, false)
+	accrued, err := mgr.Keeper().GetAffiliateCollectorRune(ctx, "alice")
+	c.Assert(err, IsNil)
+	c.Check(accrued.Uint64(), Equals, uint64(common.One/10))
+
+	// a synth fee is not accrued as RUNE, it is swapped to RUNE first
+	asgardRune := mgr.Keeper().GetRuneBalanceOfModule(ctx, AsgardName)
+	synth := common.BTCAsset.GetSyntheticAsset()
+	msg = newMsg(common.NewCoin(synth, cosmos.NewUint(common.One)))
+	handler.addSwapV114(ctx, *msg)
+	swap, err = mgr.Keeper().GetSwapQueueItem(ctx, msg.Tx.ID, 0)
+	c.Assert(err, IsNil)
+	c.Check(swap.Tx.Coins[0].Amount.Uint64(), Equals, uint64(common.One/10*9))
+	feeSwap, err := mgr.Keeper().GetSwapQueueItem(ctx, msg.Tx.ID, 1)
+	c.Assert(err, IsNil)
+	c.Check(feeSwap.Tx.Coins[0].Asset.Equals(synth), Equals, true)
+	c.Check(feeSwap.Tx.Coins[0].Amount.Uint64(), Equals, uint64(common.One/10))
+	c.Check(feeSwap.TargetAsset.IsNativeRune(), Equals, true)
+	c.Check(feeSwap.AffiliateTHORName, Equals, "alice")
+
+	accrued, err = mgr.Keeper().GetAffiliateCollectorRune(ctx, "alice")
+	c.Assert(err, IsNil)
+	c.Check(accrued.Uint64(), Equals, uint64(common.One/10))
+	c.Check(mgr.Keeper().GetRuneBalanceOfModule(ctx, AsgardName).Equal(asgardRune), Equals, true)
+	collected := mgr.Keeper().GetBalanceOfModule(ctx, AffiliateCollectorName, synth.Native())
+	c.Check(collected.IsZero(), Equals, true)
+}
+
 func (s *HandlerDepositSuite) TestTargetModule(c *C) {
 	fee := common.NewCoin(common.RuneAsset(), cosmos.NewUint(2000000))
 	acctAddr := GetRandomBech32Addr()
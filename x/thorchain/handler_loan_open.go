@@ -157,6 +157,8 @@ func (h LoanOpenHandler) handle(ctx cosmos.Context, msg MsgLoanOpen) error {
 func (h LoanOpenHandler) openLoan(ctx cosmos.Context, msg MsgLoanOpen) error {
 	version := h.mgr.GetVersion()
 	switch {
+	case version.GTE(semver.MustParse("1.114.0")):
+		return h.openLoanV114(ctx, msg)
 	case version.GTE(semver.MustParse("1.113.0")):
 		return h.openLoanV113(ctx, msg)
 	case version.GTE(semver.MustParse("1.112.0")):
@@ -196,7 +198,7 @@ func (h LoanOpenHandler) handleV113(ctx cosmos.Context, msg MsgLoanOpen) error {
 	}
 }
 
-func (h LoanOpenHandler) openLoanV113(ctx cosmos.Context, msg MsgLoanOpen) error {
+func (h LoanOpenHandler) openLoanV114(ctx cosmos.Context, msg MsgLoanOpen) error {
 	var err error
 	zero := cosmos.ZeroUint()
 
@@ -218,6 +220,12 @@ func (h LoanOpenHandler) openLoanV113(ctx cosmos.Context, msg MsgLoanOpen) error
 		ctx.Logger().Error("fail to get loan", "error", err)
 		return err
 	}
+	// accrue the interest owed on the existing debt before adding to it
+	loan, err = h.mgr.LendingMgr().AccrueInterest(ctx, loan)
+	if err != nil {
+		ctx.Logger().Error("fail to accrue loan interest", "error", err)
+		return err
+	}
 	totalCollateral, err := h.mgr.Keeper().GetTotalCollateral(ctx, msg.CollateralAsset)
 	if err != nil {
 		return err
@@ -125,6 +125,8 @@ func (h LoanRepaymentHandler) handle(ctx cosmos.Context, msg MsgLoanRepayment) e
 func (h LoanRepaymentHandler) repay(ctx cosmos.Context, msg MsgLoanRepayment) error {
 	version := h.mgr.GetVersion()
 	switch {
+	case version.GTE(semver.MustParse("1.114.0")):
+		return h.repayV114(ctx, msg)
 	case version.GTE(semver.MustParse("1.113.0")):
 		return h.repayV113(ctx, msg)
 	case version.GTE(semver.MustParse("1.111.0")):
@@ -166,7 +168,7 @@ func (h LoanRepaymentHandler) handleV113(ctx cosmos.Context, msg MsgLoanRepaymen
 	}
 }
 
-func (h LoanRepaymentHandler) repayV113(ctx cosmos.Context, msg MsgLoanRepayment) error {
+func (h LoanRepaymentHandler) repayV114(ctx cosmos.Context, msg MsgLoanRepayment) error {
 	// collect data
 	lendAddr, err := h.mgr.Keeper().GetModuleAddress(LendingName)
 	if err != nil {
@@ -183,6 +185,13 @@ func (h LoanRepaymentHandler) repayV113(ctx cosmos.Context, msg MsgLoanRepayment
 		ctx.Logger().Error("fail to get loan", "error", err)
 		return err
 	}
+	// the interest owed since the loan was last touched is added to the debt
+	// first, so it has to be repaid before the collateral is returned
+	loan, err = h.mgr.LendingMgr().AccrueInterest(ctx, loan)
+	if err != nil {
+		ctx.Logger().Error("fail to accrue loan interest", "error", err)
+		return err
+	}
 	totalCollateral, err := h.mgr.Keeper().GetTotalCollateral(ctx, msg.CollateralAsset)
 	if err != nil {
 		return err
@@ -4,6 +4,7 @@ import (
 	"errors"
 	"fmt"
 	"regexp"
+	"strings"
 
 	"github.com/blang/semver"
 
@@ -44,6 +45,8 @@ func (h ManageTHORNameHandler) Run(ctx cosmos.Context, m cosmos.Msg) (*cosmos.Re
 func (h ManageTHORNameHandler) validate(ctx cosmos.Context, msg MsgManageTHORName) error {
 	version := h.mgr.GetVersion()
 	switch {
+	case version.GTE(semver.MustParse("1.114.0")):
+		return h.validateV114(ctx, msg)
 	case version.GTE(semver.MustParse("1.112.0")):
 		return h.validateV112(ctx, msg)
 	case version.GTE(semver.MustParse("1.110.0")):
@@ -66,7 +69,26 @@ func (h ManageTHORNameHandler) validateNameV1(n string) error {
 	return nil
 }
 
-func (h ManageTHORNameHandler) validateV112(ctx cosmos.Context, msg MsgManageTHORName) error {
+// validateNameV114 validates each dot separated part of a THORName. The last
+// part of a sub-name can't be a chain, as it would read as a chain suffix
+// (name.CHAIN) in memos.
+func (h ManageTHORNameHandler) validateNameV114(n string) error {
+	parts := strings.Split(n, ".")
+	for _, part := range parts {
+		if err := h.validateNameV1(part); err != nil {
+			return err
+		}
+	}
+	if len(parts) > 1 {
+		chain, err := common.NewChain(parts[len(parts)-1])
+		if err == nil && chain.IsKnown() {
+			return fmt.Errorf("sub-names of %s are not allowed", chain)
+		}
+	}
+	return nil
+}
+
+func (h ManageTHORNameHandler) validateV114(ctx cosmos.Context, msg MsgManageTHORName) error {
 	if err := msg.ValidateBasic(); err != nil {
 		return err
 	}
@@ -76,43 +98,98 @@ func (h ManageTHORNameHandler) validateV112(ctx cosmos.Context, msg MsgManageTHO
 		return fmt.Errorf("address(%s) is not same network", msg.Address)
 	}
 
+	tn := THORName{Name: msg.Name}
+	if tn.IsSubName() {
+		// sub-names expire along with their parent
+		if !msg.Coin.Amount.IsZero() {
+			return errors.New("sub-names can't be funded")
+		}
+	}
+
 	exists := h.mgr.Keeper().THORNameExists(ctx, msg.Name)
 
 	if !exists {
 		// thorname doesn't appear to exist, let's validate the name
-		if err := h.validateNameV1(msg.Name); err != nil {
+		if err := h.validateNameV114(msg.Name); err != nil {
 			return err
 		}
+		if tn.IsSubName() {
+			// only the owner of the parent can create a sub-name
+			parent, err := h.getParentTHORName(ctx, tn)
+			if err != nil {
+				return err
+			}
+			if !parent.Owner.Equals(msg.Signer) {
+				ctx.Logger().Error("no authorization", "owner", parent.Owner)
+				return fmt.Errorf("no authorization: %s owned by %s", parent.Name, parent.Owner)
+			}
+			return nil
+		}
 		registrationFee := h.mgr.Keeper().GetTHORNameRegisterFee(ctx)
 		if msg.Coin.Amount.LTE(registrationFee) {
 			return fmt.Errorf("not enough funds")
 		}
-	} else {
-		name, err := h.mgr.Keeper().GetTHORName(ctx, msg.Name)
-		if err != nil {
-			return err
-		}
+		return nil
+	}
 
-		// if this thorname is already owned, check signer has ownership. If
-		// expiration is past, allow different user to take ownership
-		if !name.Owner.Equals(msg.Signer) && ctx.BlockHeight() <= name.ExpireBlockHeight {
-			ctx.Logger().Error("no authorization", "owner", name.Owner)
-			return fmt.Errorf("no authorization: owned by %s", name.Owner)
+	name, err := h.mgr.Keeper().GetTHORName(ctx, msg.Name)
+	if err != nil {
+		return err
+	}
+
+	// ensure user isn't inflating their expire block height artificaially
+	if name.ExpireBlockHeight < msg.ExpireBlockHeight {
+		return errors.New("cannot artificially inflate expire block height")
+	}
+
+	if name.Owner.Equals(msg.Signer) {
+		return nil
+	}
+	// the owner of the parent of a sub-name has the same authority as the
+	// owner of the sub-name, so it can revoke it
+	if name.IsSubName() {
+		parent, err := h.getParentTHORName(ctx, name)
+		if err == nil && parent.Owner.Equals(msg.Signer) {
+			return nil
 		}
+	}
 
-		// ensure user isn't inflating their expire block height artificaially
-		if name.ExpireBlockHeight < msg.ExpireBlockHeight {
-			return errors.New("cannot artificially inflate expire block height")
+	// managers can update the aliases, but nothing else
+	if name.IsManager(msg.Signer) {
+		if !msg.Owner.Empty() && !msg.Owner.Equals(name.Owner) {
+			return errors.New("managers cannot transfer ownership")
+		}
+		if !msg.PreferredAsset.IsEmpty() && !msg.PreferredAsset.Equals(name.PreferredAsset) {
+			return errors.New("managers cannot update the preferred asset")
 		}
+		if msg.ExpireBlockHeight > 0 {
+			return errors.New("managers cannot update the expire block height")
+		}
+		if len(msg.Managers) > 0 {
+			return errors.New("managers cannot update the managers")
+		}
+		return nil
 	}
 
-	return nil
+	ctx.Logger().Error("no authorization", "owner", name.Owner)
+	return fmt.Errorf("no authorization: owned by %s", name.Owner)
+}
+
+// getParentTHORName returns the parent of the given sub-name, which has to
+// exist
+func (h ManageTHORNameHandler) getParentTHORName(ctx cosmos.Context, tn THORName) (THORName, error) {
+	if !h.mgr.Keeper().THORNameExists(ctx, tn.GetParent()) {
+		return THORName{}, fmt.Errorf("parent THORName doesn't exist: %s", tn.GetParent())
+	}
+	return h.mgr.Keeper().GetTHORName(ctx, tn.GetParent())
 }
 
 // handle process MsgManageTHORName
 func (h ManageTHORNameHandler) handle(ctx cosmos.Context, msg MsgManageTHORName) (*cosmos.Result, error) {
 	version := h.mgr.GetVersion()
 	switch {
+	case version.GTE(semver.MustParse("1.114.0")):
+		return h.handleV114(ctx, msg)
 	case version.GTE(semver.MustParse("1.112.0")):
 		return h.handleV112(ctx, msg)
 	case version.GTE(semver.MustParse("0.1.0")):
@@ -122,7 +199,7 @@ func (h ManageTHORNameHandler) handle(ctx cosmos.Context, msg MsgManageTHORName)
 }
 
 // handle process MsgManageTHORName
-func (h ManageTHORNameHandler) handleV112(ctx cosmos.Context, msg MsgManageTHORName) (*cosmos.Result, error) {
+func (h ManageTHORNameHandler) handleV114(ctx cosmos.Context, msg MsgManageTHORName) (*cosmos.Result, error) {
 	var err error
 
 	enable, _ := h.mgr.Keeper().GetMimir(ctx, "THORNames")
@@ -142,6 +219,19 @@ func (h ManageTHORNameHandler) handleV112(ctx cosmos.Context, msg MsgManageTHORN
 	registrationFeePaid := cosmos.ZeroUint()
 	fundPaid := cosmos.ZeroUint()
 
+	// sub-names expire along with their parent. The expiration is reset when
+	// the owner of the parent manages the sub-name, and can't outlive the
+	// parent otherwise.
+	if tn.IsSubName() {
+		parent, err := h.mgr.Keeper().GetTHORName(ctx, tn.GetParent())
+		if err != nil {
+			return nil, err
+		}
+		if !exists || parent.Owner.Equals(msg.Signer) || tn.ExpireBlockHeight > parent.ExpireBlockHeight {
+			tn.ExpireBlockHeight = parent.ExpireBlockHeight
+		}
+	}
+
 	// check if user is trying to extend expiration
 	if !msg.Coin.Amount.IsZero() {
 		// check that THORName is still valid, can't top up an invalid THORName
@@ -178,8 +268,15 @@ func (h ManageTHORNameHandler) handleV112(ctx cosmos.Context, msg MsgManageTHORN
 	}
 
 	tn.SetAlias(msg.Chain, msg.Address) // update address
-	if !msg.Owner.Empty() {
+	if !msg.Owner.Empty() && !msg.Owner.Equals(tn.Owner) {
 		tn.Owner = msg.Owner // update owner
+		tn.Managers = nil    // managers don't carry over to the new owner
+	}
+	if len(msg.Managers) > 0 {
+		tn.Managers = msg.Managers // update managers
+	}
+	if err := h.settleAffiliateCollector(ctx, tn, exists); err != nil {
+		return nil, err
 	}
 	h.mgr.Keeper().SetTHORName(ctx, tn)
 
@@ -190,3 +287,33 @@ func (h ManageTHORNameHandler) handleV112(ctx cosmos.Context, msg MsgManageTHORN
 
 	return &cosmos.Result{}, nil
 }
+
+// settleAffiliateCollector settles the RUNE accrued by the affiliate collector
+// on behalf of the given THORName, before its changes are saved. The RUNE is
+// refunded to the previous owner when the name changes hands, or when an
+// expired name is registered again, and paid out to the previous destination
+// when the preferred asset or its alias change.
+func (h ManageTHORNameHandler) settleAffiliateCollector(ctx cosmos.Context, tn THORName, exists bool) error {
+	accrued, err := h.mgr.Keeper().GetAffiliateCollectorRune(ctx, tn.Name)
+	if err != nil {
+		return fmt.Errorf("fail to get affiliate collector rune: %w", err)
+	}
+	if accrued.IsZero() {
+		return nil
+	}
+	prev, err := h.mgr.Keeper().GetTHORNameRecord(ctx, tn.Name)
+	if err != nil {
+		return fmt.Errorf("fail to get THORName: %w", err)
+	}
+
+	chain := prev.PreferredAsset.GetChain()
+	payable := !prev.PreferredAsset.IsEmpty() && !prev.PreferredAsset.IsNativeRune() && !prev.GetAlias(chain).IsEmpty()
+	switch {
+	case !exists || !prev.Owner.Equals(tn.Owner) || !payable:
+		return refundAffiliateCollector(ctx, h.mgr, prev, accrued)
+	case !prev.PreferredAsset.Equals(tn.PreferredAsset) || !prev.GetAlias(chain).Equals(tn.GetAlias(chain)):
+		return payoutAffiliateCollector(ctx, h.mgr, prev, accrued)
+	default:
+		return nil
+	}
+}
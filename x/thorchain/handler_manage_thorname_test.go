@@ -126,3 +126,232 @@ func (s *HandlerManageTHORNameSuite) TestHandler(c *C) {
 	c.Check(name.Owner.Empty(), Equals, true)
 	c.Check(name.ExpireBlockHeight, Equals, int64(0))
 }
+
+func (s *HandlerManageTHORNameSuite) TestSubName(c *C) {
+	ctx, mgr := setupManagerForTest(c)
+	handler := NewManageTHORNameHandler(mgr)
+	noFunds := common.NewCoin(common.RuneAsset(), cosmos.ZeroUint())
+
+	ownerAddr := GetRandomTHORAddress()
+	owner, _ := ownerAddr.AccAddress()
+	for _, n := range []string{"wallet", "btc"} {
+		parent := NewTHORName(n, 1000, []THORNameAlias{{Chain: common.THORChain, Address: ownerAddr}})
+		parent.Owner = owner
+		mgr.Keeper().SetTHORName(ctx, parent)
+	}
+
+	aliceAddr := GetRandomTHORAddress()
+	alice, _ := aliceAddr.AccAddress()
+
+	// fail: only the owner of the parent can create a sub-name
+	msg := NewMsgManageTHORName("alice.wallet", common.THORChain, aliceAddr, noFunds, 0, common.EmptyAsset, alice, alice)
+	c.Assert(handler.validate(ctx, *msg), NotNil)
+
+	// fail: sub-names can't be funded
+	msg.Signer = owner
+	msg.Coin = common.NewCoin(common.RuneAsset(), cosmos.NewUint(common.One))
+	c.Assert(handler.validate(ctx, *msg), NotNil)
+
+	// fail: parent doesn't exist
+	msg.Coin = noFunds
+	msg.Name = "alice.nowhere"
+	c.Assert(handler.validate(ctx, *msg), NotNil)
+
+	// fail: sub-names of a chain would read as a chain suffix
+	msg.Name = "alice.btc"
+	c.Assert(handler.validate(ctx, *msg), NotNil)
+
+	// happy path, the owner of the parent creates a sub-name for alice
+	msg.Name = "alice.wallet"
+	c.Assert(handler.validate(ctx, *msg), IsNil)
+	_, err := handler.handle(ctx, *msg)
+	c.Assert(err, IsNil)
+	name, err := mgr.Keeper().GetTHORName(ctx, "alice.wallet")
+	c.Assert(err, IsNil)
+	c.Check(name.Owner.Equals(alice), Equals, true)
+	c.Check(name.ExpireBlockHeight, Equals, int64(1000))
+	c.Check(name.GetAlias(common.THORChain).Equals(aliceAddr), Equals, true)
+
+	// happy path, alice updates her alias
+	bnbAddr := GetRandomBNBAddress()
+	msg = NewMsgManageTHORName("alice.wallet", common.BNBChain, bnbAddr, noFunds, 0, common.EmptyAsset, nil, alice)
+	c.Assert(handler.validate(ctx, *msg), IsNil)
+	_, err = handler.handle(ctx, *msg)
+	c.Assert(err, IsNil)
+	name, err = mgr.Keeper().GetTHORName(ctx, "alice.wallet")
+	c.Assert(err, IsNil)
+	c.Check(name.GetAlias(common.BNBChain).Equals(bnbAddr), Equals, true)
+
+	// happy path, the owner of the parent revokes the sub-name
+	msg = NewMsgManageTHORName("alice.wallet", common.THORChain, aliceAddr, noFunds, 1, common.EmptyAsset, nil, owner)
+	c.Assert(handler.validate(ctx, *msg), IsNil)
+	_, err = handler.handle(ctx, *msg)
+	c.Assert(err, IsNil)
+	c.Check(mgr.Keeper().THORNameExists(ctx, "alice.wallet"), Equals, false)
+
+	// fail: alice can't manage the revoked sub-name
+	msg = NewMsgManageTHORName("alice.wallet", common.BNBChain, bnbAddr, noFunds, 0, common.EmptyAsset, nil, alice)
+	c.Assert(handler.validate(ctx, *msg), NotNil)
+}
+
+func (s *HandlerManageTHORNameSuite) TestManagers(c *C) {
+	ctx, mgr := setupManagerForTest(c)
+	handler := NewManageTHORNameHandler(mgr)
+	noFunds := common.NewCoin(common.RuneAsset(), cosmos.ZeroUint())
+
+	ownerAddr := GetRandomTHORAddress()
+	owner, _ := ownerAddr.AccAddress()
+	tn := NewTHORName("hello", 1000, []THORNameAlias{{Chain: common.THORChain, Address: ownerAddr}})
+	tn.Owner = owner
+	mgr.Keeper().SetTHORName(ctx, tn)
+	manager := GetRandomBech32Addr()
+
+	// fail: only the owner can manage the THORName
+	bnbAddr := GetRandomBNBAddress()
+	msg := NewMsgManageTHORName("hello", common.BNBChain, bnbAddr, noFunds, 0, common.EmptyAsset, nil, manager)
+	c.Assert(handler.validate(ctx, *msg), NotNil)
+
+	// happy path, the owner delegates to a manager
+	msg = NewMsgManageTHORName("hello", common.THORChain, ownerAddr, noFunds, 0, common.EmptyAsset, nil, owner)
+	msg.Managers = []cosmos.AccAddress{manager}
+	c.Assert(handler.validate(ctx, *msg), IsNil)
+	_, err := handler.handle(ctx, *msg)
+	c.Assert(err, IsNil)
+	name, err := mgr.Keeper().GetTHORName(ctx, "hello")
+	c.Assert(err, IsNil)
+	c.Check(name.IsManager(manager), Equals, true)
+
+	// happy path, the manager updates an alias
+	msg = NewMsgManageTHORName("hello", common.BNBChain, bnbAddr, noFunds, 0, common.EmptyAsset, nil, manager)
+	c.Assert(handler.validate(ctx, *msg), IsNil)
+	_, err = handler.handle(ctx, *msg)
+	c.Assert(err, IsNil)
+	name, err = mgr.Keeper().GetTHORName(ctx, "hello")
+	c.Assert(err, IsNil)
+	c.Check(name.GetAlias(common.BNBChain).Equals(bnbAddr), Equals, true)
+	c.Check(name.Owner.Equals(owner), Equals, true)
+
+	// fail: the manager can't transfer ownership
+	msg.Owner = manager
+	c.Assert(handler.validate(ctx, *msg), NotNil)
+
+	// fail: the manager can't update the preferred asset
+	msg.Owner = nil
+	msg.PreferredAsset = common.BTCAsset
+	c.Assert(handler.validate(ctx, *msg), NotNil)
+
+	// fail: the manager can't release the THORName
+	msg.PreferredAsset = common.EmptyAsset
+	msg.ExpireBlockHeight = 1
+	c.Assert(handler.validate(ctx, *msg), NotNil)
+
+	// happy path, managers don't carry over to a new owner
+	newOwner := GetRandomBech32Addr()
+	msg = NewMsgManageTHORName("hello", common.THORChain, ownerAddr, noFunds, 0, common.EmptyAsset, newOwner, owner)
+	c.Assert(handler.validate(ctx, *msg), IsNil)
+	_, err = handler.handle(ctx, *msg)
+	c.Assert(err, IsNil)
+	name, err = mgr.Keeper().GetTHORName(ctx, "hello")
+	c.Assert(err, IsNil)
+	c.Check(name.Owner.Equals(newOwner), Equals, true)
+	c.Check(name.IsManager(manager), Equals, false)
+}
+
+func (s *HandlerManageTHORNameSuite) TestAffiliateCollector(c *C) {
+	ctx, mgr := setupManagerForTest(c)
+	handler := NewManageTHORNameHandler(mgr)
+	noCoin := common.NewCoin(common.RuneAsset(), cosmos.ZeroUint())
+
+	thorAddr := GetRandomTHORAddress()
+	owner, err := thorAddr.AccAddress()
+	c.Assert(err, IsNil)
+	btcAddr := GetRandomBTCAddress()
+	setName := func() THORName {
+		name := NewTHORName("hello", ctx.BlockHeight()+100, []THORNameAlias{
+			{Chain: common.THORChain, Address: thorAddr},
+			{Chain: common.BTCChain, Address: btcAddr},
+		})
+		name.Owner = owner
+		name.PreferredAsset = common.BTCAsset
+		mgr.Keeper().SetTHORName(ctx, name)
+		return name
+	}
+	accrue := func(amt uint64) {
+		coin := common.NewCoin(common.RuneNative, cosmos.NewUint(amt))
+		c.Assert(mgr.Keeper().MintToModule(ctx, ModuleName, coin), IsNil)
+		c.Assert(mgr.Keeper().SendFromModuleToModule(ctx, ModuleName, AffiliateCollectorName, common.NewCoins(coin)), IsNil)
+		mgr.Keeper().SetAffiliateCollectorRune(ctx, "hello", cosmos.NewUint(amt))
+	}
+	checkAccrued := func(amt uint64) {
+		accrued, err := mgr.Keeper().GetAffiliateCollectorRune(ctx, "hello")
+		c.Assert(err, IsNil)
+		c.Check(accrued.Uint64(), Equals, amt)
+	}
+	popPayout := func() MsgSwap {
+		items := make([]MsgSwap, 0)
+		iter := mgr.Keeper().GetSwapQueueIterator(ctx)
+		for ; iter.Valid(); iter.Next() {
+			var msg MsgSwap
+			c.Assert(mgr.Keeper().Cdc().Unmarshal(iter.Value(), &msg), IsNil)
+			items = append(items, msg)
+		}
+		iter.Close()
+		c.Assert(items, HasLen, 1)
+		mgr.Keeper().RemoveSwapQueueItem(ctx, items[0].Tx.ID, 0)
+		return items[0]
+	}
+
+	// unrelated changes leave the accrued rune alone
+	setName()
+	accrue(100 * common.One)
+	msg := NewMsgManageTHORName("hello", common.BNBChain, GetRandomBNBAddress(), noCoin, 0, common.EmptyAsset, owner, owner)
+	_, err = handler.handle(ctx, *msg)
+	c.Assert(err, IsNil)
+	checkAccrued(100 * common.One)
+
+	// a new preferred asset pays out to the previous one
+	msg = NewMsgManageTHORName("hello", common.ETHChain, GetRandomETHAddress(), noCoin, 0, common.ETHAsset, owner, owner)
+	_, err = handler.handle(ctx, *msg)
+	c.Assert(err, IsNil)
+	checkAccrued(0)
+	payout := popPayout()
+	c.Check(payout.TargetAsset.Equals(common.BTCAsset), Equals, true)
+	c.Check(payout.Destination.Equals(btcAddr), Equals, true)
+	c.Check(payout.Tx.Coins[0].Amount.Uint64(), Equals, uint64(100*common.One))
+
+	// a new alias of the preferred asset pays out to the previous alias
+	setName()
+	accrue(50 * common.One)
+	msg = NewMsgManageTHORName("hello", common.BTCChain, GetRandomBTCAddress(), noCoin, 0, common.EmptyAsset, owner, owner)
+	_, err = handler.handle(ctx, *msg)
+	c.Assert(err, IsNil)
+	checkAccrued(0)
+	payout = popPayout()
+	c.Check(payout.Destination.Equals(btcAddr), Equals, true)
+	c.Check(payout.Tx.Coins[0].Amount.Uint64(), Equals, uint64(50*common.One))
+
+	// a new owner refunds the previous owner
+	setName()
+	accrue(20 * common.One)
+	newOwner := GetRandomBech32Addr()
+	msg = NewMsgManageTHORName("hello", common.THORChain, thorAddr, noCoin, 0, common.EmptyAsset, newOwner, owner)
+	_, err = handler.handle(ctx, *msg)
+	c.Assert(err, IsNil)
+	checkAccrued(0)
+	bal := mgr.Keeper().GetBalance(ctx, owner)
+	c.Check(bal.AmountOf(common.RuneNative.Native()).Uint64(), Equals, uint64(20*common.One))
+
+	// registering an expired name refunds the previous owner
+	name := setName()
+	accrue(10 * common.One)
+	ctx = ctx.WithBlockHeight(name.ExpireBlockHeight + 1)
+	registrant := GetRandomBech32Addr()
+	coin := common.NewCoin(common.RuneAsset(), cosmos.NewUint(100*common.One))
+	msg = NewMsgManageTHORName("hello", common.THORChain, GetRandomTHORAddress(), coin, 0, common.EmptyAsset, registrant, registrant)
+	_, err = handler.handle(ctx, *msg)
+	c.Assert(err, IsNil)
+	checkAccrued(0)
+	bal = mgr.Keeper().GetBalance(ctx, owner)
+	c.Check(bal.AmountOf(common.RuneNative.Native()).Uint64(), Equals, uint64(30*common.One))
+	c.Check(mgr.Keeper().GetBalanceOfModule(ctx, AffiliateCollectorName, common.RuneNative.Native()).IsZero(), Equals, true)
+}
@@ -0,0 +1,181 @@
+package thorchain
+
+import (
+	"fmt"
+
+	"github.com/blang/semver"
+
+	"gitlab.com/thorchain/thornode/common"
+	"gitlab.com/thorchain/thornode/common/cosmos"
+)
+
+// ModifyLimitOrderHandler is to handle modify limit order message
+type ModifyLimitOrderHandler struct {
+	mgr Manager
+}
+
+// NewModifyLimitOrderHandler create a new instance of ModifyLimitOrderHandler
+func NewModifyLimitOrderHandler(mgr Manager) ModifyLimitOrderHandler {
+	return ModifyLimitOrderHandler{
+		mgr: mgr,
+	}
+}
+
+// Run is the main entry point to execute modify limit order logic
+func (h ModifyLimitOrderHandler) Run(ctx cosmos.Context, m cosmos.Msg) (*cosmos.Result, error) {
+	msg, ok := m.(*MsgModifyLimitOrder)
+	if !ok {
+		return nil, errInvalidMessage
+	}
+	ctx.Logger().Info("receive msg modify limit order", "tx_id", msg.Tx.ID, "order_tx_id", msg.OrderTxID)
+	if err := h.validate(ctx, *msg); err != nil {
+		ctx.Logger().Error("msg modify limit order failed validation", "error", err)
+		return nil, err
+	}
+	if err := h.handle(ctx, *msg); err != nil {
+		ctx.Logger().Error("fail to process msg modify limit order", "error", err)
+		return nil, err
+	}
+	return &cosmos.Result{}, nil
+}
+
+func (h ModifyLimitOrderHandler) validate(ctx cosmos.Context, msg MsgModifyLimitOrder) error {
+	version := h.mgr.GetVersion()
+	if version.GTE(semver.MustParse("1.114.0")) {
+		return h.validateV114(ctx, msg)
+	}
+	return errBadVersion
+}
+
+func (h ModifyLimitOrderHandler) validateV114(ctx cosmos.Context, msg MsgModifyLimitOrder) error {
+	if err := msg.ValidateBasic(); err != nil {
+		return err
+	}
+	if !h.mgr.Keeper().OrderBooksEnabled(ctx) {
+		return fmt.Errorf("order books are disabled")
+	}
+	if !h.mgr.Keeper().HasOrderBookItem(ctx, msg.OrderTxID) {
+		return fmt.Errorf("limit order (%s) not found", msg.OrderTxID)
+	}
+	order, err := h.mgr.Keeper().GetOrderBookItem(ctx, msg.OrderTxID)
+	if err != nil {
+		return ErrInternal(err, fmt.Sprintf("fail to get order book item (%s)", msg.OrderTxID))
+	}
+	if order.OrderType != LimitOrder {
+		return fmt.Errorf("only limit orders can be modified")
+	}
+	if !order.Tx.FromAddress.Equals(msg.Tx.FromAddress) {
+		return errNotAuthorized
+	}
+	if !msg.IsCancel() && msg.ModifiedTargetAmount.Equal(order.TradeTarget) {
+		return fmt.Errorf("modified target amount is the same as the current target amount")
+	}
+	// the coins sent with the memo are kept as a fee, only accept coins that
+	// can be moved to the reserve or donated to their pool
+	for _, coin := range msg.Tx.Coins {
+		if coin.IsEmpty() || coin.Asset.IsNativeRune() {
+			continue
+		}
+		if coin.Asset.IsRune() || coin.Asset.IsSyntheticAsset() || coin.Asset.IsDerivedAsset() {
+			return fmt.Errorf("%s cannot be used to pay the limit order modify fee", coin.Asset)
+		}
+		pool, err := h.mgr.Keeper().GetPool(ctx, coin.Asset)
+		if err != nil {
+			return ErrInternal(err, fmt.Sprintf("fail to get pool for (%s)", coin.Asset))
+		}
+		if pool.IsEmpty() {
+			return fmt.Errorf("pool (%s) does not exist", coin.Asset)
+		}
+	}
+	return nil
+}
+
+// handle process MsgModifyLimitOrder, which either cancels the limit order
+// (refunding the held input) or changes its target amount
+func (h ModifyLimitOrderHandler) handle(ctx cosmos.Context, msg MsgModifyLimitOrder) error {
+	version := h.mgr.GetVersion()
+	if version.GTE(semver.MustParse("1.114.0")) {
+		return h.handleV114(ctx, msg)
+	}
+	return errBadVersion
+}
+
+func (h ModifyLimitOrderHandler) handleV114(ctx cosmos.Context, msg MsgModifyLimitOrder) error {
+	order, err := h.mgr.Keeper().GetOrderBookItem(ctx, msg.OrderTxID)
+	if err != nil {
+		return ErrInternal(err, fmt.Sprintf("fail to get order book item (%s)", msg.OrderTxID))
+	}
+	source := order.Tx.Coins[0]
+	target := common.NewCoin(order.TargetAsset, order.TradeTarget)
+
+	if msg.IsCancel() {
+		if err := h.mgr.Keeper().RemoveOrderBookItem(ctx, order.Tx.ID); err != nil {
+			return ErrInternal(err, "fail to remove order book item")
+		}
+
+		// use the vault that observed the order to refund the held input
+		observedTx := ObservedTx{Tx: order.Tx}
+		voter, err := h.mgr.Keeper().GetObservedTxInVoter(ctx, order.Tx.ID)
+		if err == nil && !voter.Tx.IsEmpty() {
+			observedTx.ObservedPubKey = voter.Tx.ObservedPubKey
+		}
+		if err := refundTx(ctx, observedTx, h.mgr, CodeSwapFail, "limit order cancelled", ""); err != nil {
+			return ErrInternal(err, "fail to refund limit order")
+		}
+
+		evt := NewEventLimitOrderCancel(source, target, order.Tx.ID)
+		if err := h.mgr.EventMgr().EmitEvent(ctx, evt); err != nil {
+			ctx.Logger().Error("fail to emit limit order cancel event", "error", err)
+		}
+	} else {
+		// the index key is derived from the trade target, so re-index the
+		// order under its new ratio while keeping the same order book item
+		if err := h.mgr.Keeper().RemoveOrderBookIndex(ctx, order); err != nil {
+			return ErrInternal(err, "fail to remove order book index")
+		}
+		order.TradeTarget = msg.ModifiedTargetAmount
+		// add the order back through the order book manager, so it is
+		// evaluated against its new target at the end of this block
+		if err := h.mgr.OrderBookMgr().AddOrderBookItem(ctx, order); err != nil {
+			return ErrInternal(err, "fail to save order book item")
+		}
+
+		modified := common.NewCoin(order.TargetAsset, msg.ModifiedTargetAmount)
+		evt := NewEventLimitOrderModify(source, target, modified, order.Tx.ID)
+		if err := h.mgr.EventMgr().EmitEvent(ctx, evt); err != nil {
+			ctx.Logger().Error("fail to emit limit order modify event", "error", err)
+		}
+	}
+
+	return h.collectFee(ctx, msg)
+}
+
+// collectFee keeps the coins sent along with the modify memo, native RUNE is
+// moved to the reserve and layer1 assets are donated to their pool. Any other
+// asset is rejected by validate.
+func (h ModifyLimitOrderHandler) collectFee(ctx cosmos.Context, msg MsgModifyLimitOrder) error {
+	for _, coin := range msg.Tx.Coins {
+		if coin.IsEmpty() {
+			continue
+		}
+		if coin.Asset.IsNativeRune() {
+			if err := h.mgr.Keeper().SendFromModuleToModule(ctx, AsgardName, ReserveName, common.NewCoins(coin)); err != nil {
+				return ErrInternal(err, "fail to transfer fee to reserve")
+			}
+			continue
+		}
+		pool, err := h.mgr.Keeper().GetPool(ctx, coin.Asset)
+		if err != nil {
+			return ErrInternal(err, fmt.Sprintf("fail to get pool for (%s)", coin.Asset))
+		}
+		pool.BalanceAsset = pool.BalanceAsset.Add(coin.Amount)
+		if err := h.mgr.Keeper().SetPool(ctx, pool); err != nil {
+			return ErrInternal(err, fmt.Sprintf("fail to set pool(%s)", pool))
+		}
+		donateEvt := NewEventDonate(pool.Asset, msg.Tx)
+		if err := h.mgr.EventMgr().EmitEvent(ctx, donateEvt); err != nil {
+			return cosmos.Wrapf(errFailSaveEvent, "fail to save donate events: %w", err)
+		}
+	}
+	return nil
+}
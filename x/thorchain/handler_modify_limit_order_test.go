@@ -0,0 +1,147 @@
+package thorchain
+
+import (
+	"errors"
+
+	. "gopkg.in/check.v1"
+
+	"gitlab.com/thorchain/thornode/common"
+	"gitlab.com/thorchain/thornode/common/cosmos"
+	"gitlab.com/thorchain/thornode/constants"
+)
+
+type HandlerModifyLimitOrderSuite struct{}
+
+var _ = Suite(&HandlerModifyLimitOrderSuite{})
+
+func (s *HandlerModifyLimitOrderSuite) TestModifyLimitOrder(c *C) {
+	ctx, mgr := setupManagerForTest(c)
+	mgr.txOutStore = NewTxStoreDummy()
+	handler := NewModifyLimitOrderHandler(mgr)
+
+	pool := NewPool()
+	pool.Asset = common.BNBAsset
+	pool.BalanceAsset = cosmos.NewUint(1000 * common.One)
+	pool.BalanceRune = cosmos.NewUint(1000 * common.One)
+	pool.Status = PoolAvailable
+	c.Assert(mgr.Keeper().SetPool(ctx, pool), IsNil)
+
+	bnbAddr := GetRandomBNBAddress()
+	tx := GetRandomTx()
+	tx.FromAddress = bnbAddr
+	tx.Coins = common.NewCoins(common.NewCoin(common.BNBAsset, cosmos.NewUint(10*common.One)))
+	order := NewMsgSwap(
+		tx, common.RuneAsset(), GetRandomTHORAddress(), cosmos.NewUint(20*common.One),
+		common.NoAddress, cosmos.ZeroUint(),
+		"", "", nil,
+		LimitOrder,
+		GetRandomBech32Addr())
+	c.Assert(mgr.Keeper().SetOrderBookItem(ctx, *order), IsNil)
+
+	modifyTx := GetRandomTx()
+	modifyTx.FromAddress = bnbAddr
+	signer := GetRandomBech32Addr()
+
+	// order books disabled
+	msg := NewMsgModifyLimitOrder(modifyTx, order.Tx.ID, cosmos.NewUint(15*common.One), signer)
+	_, err := handler.Run(ctx, msg)
+	c.Assert(err, NotNil)
+
+	mgr.Keeper().SetMimir(ctx, constants.EnableOrderBooks.String(), 1)
+
+	// only the original sender can modify the order
+	badTx := GetRandomTx()
+	msg = NewMsgModifyLimitOrder(badTx, order.Tx.ID, cosmos.NewUint(15*common.One), signer)
+	_, err = handler.Run(ctx, msg)
+	c.Assert(errors.Is(err, errNotAuthorized), Equals, true)
+
+	// order must exist
+	msg = NewMsgModifyLimitOrder(modifyTx, GetRandomTxHash(), cosmos.NewUint(15*common.One), signer)
+	_, err = handler.Run(ctx, msg)
+	c.Assert(err, NotNil)
+
+	// same target amount
+	msg = NewMsgModifyLimitOrder(modifyTx, order.Tx.ID, order.TradeTarget, signer)
+	_, err = handler.Run(ctx, msg)
+	c.Assert(err, NotNil)
+
+	// synths cannot pay the modify fee
+	synthTx := GetRandomTx()
+	synthTx.FromAddress = bnbAddr
+	synthTx.Coins = common.NewCoins(common.NewCoin(common.BNBAsset.GetSyntheticAsset(), cosmos.NewUint(common.One)))
+	msg = NewMsgModifyLimitOrder(synthTx, order.Tx.ID, cosmos.NewUint(15*common.One), signer)
+	_, err = handler.Run(ctx, msg)
+	c.Assert(err, NotNil)
+
+	// neither can assets without a pool
+	noPoolTx := GetRandomTx()
+	noPoolTx.FromAddress = bnbAddr
+	noPoolTx.Coins = common.NewCoins(common.NewCoin(common.BTCAsset, cosmos.NewUint(common.One)))
+	msg = NewMsgModifyLimitOrder(noPoolTx, order.Tx.ID, cosmos.NewUint(15*common.One), signer)
+	_, err = handler.Run(ctx, msg)
+	c.Assert(err, NotNil)
+
+	// happy path, modify the target amount
+	msg = NewMsgModifyLimitOrder(modifyTx, order.Tx.ID, cosmos.NewUint(15*common.One), signer)
+	_, err = handler.Run(ctx, msg)
+	c.Assert(err, IsNil)
+
+	item, err := mgr.Keeper().GetOrderBookItem(ctx, order.Tx.ID)
+	c.Assert(err, IsNil)
+	c.Check(item.TradeTarget.Uint64(), Equals, uint64(15*common.One))
+	ok, err := mgr.Keeper().HasOrderBookIndex(ctx, *order)
+	c.Assert(err, IsNil)
+	c.Check(ok, Equals, false)
+	ok, err = mgr.Keeper().HasOrderBookIndex(ctx, item)
+	c.Assert(err, IsNil)
+	c.Check(ok, Equals, true)
+
+	// the modified order is picked up by the order book this block
+	ob, ok := mgr.OrderBookMgr().(*OrderBookV114)
+	c.Assert(ok, Equals, true)
+	c.Assert(ob.limitOrders, HasLen, 1)
+	c.Check(ob.limitOrders[0].msg.Tx.ID.Equals(order.Tx.ID), Equals, true)
+	c.Check(ob.limitOrders[0].msg.TradeTarget.Uint64(), Equals, uint64(15*common.One))
+
+	// the coins sent with the modify memo are donated to the pool
+	pool, err = mgr.Keeper().GetPool(ctx, common.BNBAsset)
+	c.Assert(err, IsNil)
+	c.Check(pool.BalanceAsset.Uint64(), Equals, uint64(1000*common.One+1))
+
+	// happy path, cancel the order
+	msg = NewMsgModifyLimitOrder(modifyTx, order.Tx.ID, cosmos.ZeroUint(), signer)
+	_, err = handler.Run(ctx, msg)
+	c.Assert(err, IsNil)
+	c.Check(mgr.Keeper().HasOrderBookItem(ctx, order.Tx.ID), Equals, false)
+	ok, err = mgr.Keeper().HasOrderBookIndex(ctx, item)
+	c.Assert(err, IsNil)
+	c.Check(ok, Equals, false)
+
+	items, err := mgr.TxOutStore().GetOutboundItems(ctx)
+	c.Assert(err, IsNil)
+	c.Assert(items, HasLen, 1)
+	c.Check(items[0].InHash.Equals(order.Tx.ID), Equals, true)
+	c.Check(items[0].ToAddress.Equals(bnbAddr), Equals, true)
+
+	// order no longer exists
+	_, err = handler.Run(ctx, msg)
+	c.Assert(err, NotNil)
+
+	// market orders cannot be modified
+	tx = GetRandomTx()
+	tx.FromAddress = bnbAddr
+	market := NewMsgSwap(
+		tx, common.RuneAsset(), GetRandomTHORAddress(), cosmos.ZeroUint(),
+		common.NoAddress, cosmos.ZeroUint(),
+		"", "", nil,
+		MarketOrder,
+		GetRandomBech32Addr())
+	c.Assert(mgr.Keeper().SetOrderBookItem(ctx, *market), IsNil)
+	msg = NewMsgModifyLimitOrder(modifyTx, market.Tx.ID, cosmos.ZeroUint(), signer)
+	_, err = handler.Run(ctx, msg)
+	c.Assert(err, NotNil)
+
+	// invalid msg
+	_, err = handler.Run(ctx, NewMsgMimir("what", 1, signer))
+	c.Assert(errors.Is(err, errInvalidMessage), Equals, true)
+}
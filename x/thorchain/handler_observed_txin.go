@@ -69,6 +69,8 @@ func (h ObservedTxInHandler) validateV1(ctx cosmos.Context, msg MsgObservedTxIn)
 func (h ObservedTxInHandler) handle(ctx cosmos.Context, msg MsgObservedTxIn) (*cosmos.Result, error) {
 	version := h.mgr.GetVersion()
 	switch {
+	case version.GTE(semver.MustParse("1.114.0")):
+		return h.handleV114(ctx, msg)
 	case version.GTE(semver.MustParse("1.113.0")):
 		return h.handleV113(ctx, msg)
 	case version.GTE(semver.MustParse("1.112.0")):
@@ -83,7 +85,17 @@ func (h ObservedTxInHandler) handle(ctx cosmos.Context, msg MsgObservedTxIn) (*c
 	return nil, errBadVersion
 }
 
-func (h ObservedTxInHandler) preflightV1(ctx cosmos.Context, voter ObservedTxVoter, nas NodeAccounts, tx ObservedTx, signer cosmos.AccAddress) (ObservedTxVoter, bool) {
+func (h ObservedTxInHandler) preflight(ctx cosmos.Context, voter ObservedTxVoter, nas NodeAccounts, tx ObservedTx, signer cosmos.AccAddress) (ObservedTxVoter, bool) {
+	version := h.mgr.GetVersion()
+	switch {
+	case version.GTE(semver.MustParse("1.114.0")):
+		return h.preflightV114(ctx, voter, nas, tx, signer)
+	default:
+		return h.preflightV1(ctx, voter, nas, tx, signer)
+	}
+}
+
+func (h ObservedTxInHandler) preflightV114(ctx cosmos.Context, voter ObservedTxVoter, nas NodeAccounts, tx ObservedTx, signer cosmos.AccAddress) (ObservedTxVoter, bool) {
 	observeSlashPoints := h.mgr.GetConstants().GetInt64Value(constants.ObserveSlashPoints)
 	observeFlex := h.mgr.GetConstants().GetInt64Value(constants.ObservationDelayFlexibility)
 
@@ -122,9 +134,11 @@ func (h ObservedTxInHandler) preflightV1(ctx cosmos.Context, voter ObservedTxVot
 
 			// tx has consensus now, so decrease the slashing points for all the signers whom had voted for it
 			h.mgr.Slasher().DecSlashPoints(slashCtx, observeSlashPoints, voter.Tx.GetSigners()...)
-		} else if ctx.BlockHeight() <= (voter.Height+observeFlex) && voter.Tx.Equals(tx) {
+		} else if ctx.BlockHeight() <= (voter.Height+observeFlex) && voter.Tx.ObservedPubKey.Equals(tx.ObservedPubKey) && voter.Tx.Tx.EqualsEx(tx.Tx) {
 			// event the tx had been processed , given the signer just a bit late , so still take away their slash points
-			// but only when the tx signer are voting is the tx that already reached consensus
+			// but only when the tx signer are voting is the tx that already reached consensus. Consensus on a tx that
+			// is not final doesn't depend on the block heights, pending EVM txs are observed from the mempool at the
+			// local height of each node, so the heights are not compared here either
 			h.mgr.Slasher().DecSlashPoints(slashCtx, observeSlashPoints, signer)
 		}
 	}
@@ -135,7 +149,7 @@ func (h ObservedTxInHandler) preflightV1(ctx cosmos.Context, voter ObservedTxVot
 	return voter, ok
 }
 
-func (h ObservedTxInHandler) handleV113(ctx cosmos.Context, msg MsgObservedTxIn) (*cosmos.Result, error) {
+func (h ObservedTxInHandler) handleV114(ctx cosmos.Context, msg MsgObservedTxIn) (*cosmos.Result, error) {
 	activeNodeAccounts, err := h.mgr.Keeper().ListActiveValidators(ctx)
 	if err != nil {
 		return nil, wrapError(ctx, err, "fail to get list of active node accounts")
@@ -154,7 +168,7 @@ func (h ObservedTxInHandler) handleV113(ctx cosmos.Context, msg MsgObservedTxIn)
 			continue
 		}
 
-		voter, ok := h.preflightV1(ctx, voter, activeNodeAccounts, tx, msg.Signer)
+		voter, ok := h.preflight(ctx, voter, activeNodeAccounts, tx, msg.Signer)
 		if !ok {
 			if voter.Height == ctx.BlockHeight() || voter.FinalisedHeight == ctx.BlockHeight() {
 				// we've already process the transaction, but we should still
@@ -183,7 +197,9 @@ func (h ObservedTxInHandler) handleV113(ctx cosmos.Context, msg MsgObservedTxIn)
 			continue
 		}
 
-		if vault.IsAsgard() {
+		// pending txs are observed from the mempool and might never be included in a block,
+		// so those are only credited to the vault once finalised
+		if vault.IsAsgard() && (!voter.Tx.Pending || voter.HasFinalised(activeNodeAccounts)) {
 			if !voter.UpdatedVault {
 				vault.AddFunds(tx.Tx.Coins)
 				voter.UpdatedVault = true
@@ -306,6 +322,8 @@ func (h ObservedTxInHandler) handleV113(ctx cosmos.Context, msg MsgObservedTxIn)
 func (h ObservedTxInHandler) addSwap(ctx cosmos.Context, msg MsgSwap) {
 	version := h.mgr.GetVersion()
 	switch {
+	case version.GTE(semver.MustParse("1.114.0")):
+		h.addSwapV114(ctx, msg)
 	case version.GTE(semver.MustParse("1.98.0")):
 		h.addSwapV98(ctx, msg)
 	default:
@@ -313,22 +331,33 @@ func (h ObservedTxInHandler) addSwap(ctx cosmos.Context, msg MsgSwap) {
 	}
 }
 
-func (h ObservedTxInHandler) addSwapV98(ctx cosmos.Context, msg MsgSwap) {
+func (h ObservedTxInHandler) addSwapV114(ctx cosmos.Context, msg MsgSwap) {
 	if h.mgr.Keeper().OrderBooksEnabled(ctx) {
-		// TODO: swap to synth if layer1 asset (follow on PR)
-		// TODO: create handler to modify/cancel an order (follow on PR)
-
 		source := msg.Tx.Coins[0]
 		target := common.NewCoin(msg.TargetAsset, msg.TradeTarget)
 		evt := NewEventLimitOrder(source, target, msg.Tx.ID)
 		if err := h.mgr.EventMgr().EmitEvent(ctx, evt); err != nil {
 			ctx.Logger().Error("fail to emit swap event", "error", err)
 		}
-		if err := h.mgr.Keeper().SetOrderBookItem(ctx, msg); err != nil {
+		if err := h.mgr.OrderBookMgr().AddOrderBookItem(ctx, msg); err != nil {
 			ctx.Logger().Error("fail to add swap to queue", "error", err)
 		}
-	} else {
-		h.addSwapV63(ctx, msg)
+		return
+	}
+
+	affiliateFees := takeAffiliateFees(&msg)
+	if err := h.mgr.Keeper().SetSwapQueueItem(ctx, msg, 0); err != nil {
+		ctx.Logger().Error("fail to add swap to queue", "error", err)
+	}
+	// each affiliate fee swap is queued after the swap itself
+	for i, fee := range affiliateFees {
+		if err := h.mgr.Keeper().SetSwapQueueItem(ctx, fee.swap, i+1); err != nil {
+			ctx.Logger().Error("fail to add swap to queue", "error", err)
+			continue
+		}
+		if err := h.mgr.EventMgr().EmitEvent(ctx, fee.event()); err != nil {
+			ctx.Logger().Error("fail to emit affiliate fee event", "error", err)
+		}
 	}
 }
 
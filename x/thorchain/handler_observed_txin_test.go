@@ -10,6 +10,7 @@ import (
 	"gitlab.com/thorchain/thornode/common"
 	"gitlab.com/thorchain/thornode/common/cosmos"
 	"gitlab.com/thorchain/thornode/x/thorchain/keeper"
+	"gitlab.com/thorchain/thornode/x/thorchain/types"
 )
 
 type HandlerObservedTxInSuite struct{}
@@ -386,6 +387,124 @@ func (s *HandlerObservedTxInSuite) testHandleWithVersion(c *C) {
 	c.Assert(bnbCoin.Amount.Equal(cosmos.OneUint()), Equals, true)
 }
 
+func (s *HandlerObservedTxInSuite) TestHandlePendingEVMTx(c *C) {
+	var err error
+	ctx, mgr := setupManagerForTest(c)
+	tx := GetRandomTx()
+	tx.Chain = common.ETHChain
+	tx.FromAddress = types.GetRandomETHAddress()
+	tx.Coins = common.NewCoins(common.NewCoin(common.ETHAsset, cosmos.NewUint(100)))
+	tx.Memo = "SWAP:BTC.BTC:" + GetRandomBTCAddress().String()
+	// pending txs are observed from the mempool with the gas limit
+	tx.Gas = common.Gas{common.NewCoin(common.ETHAsset, cosmos.NewUint(800))}
+	pk := GetRandomPubKey()
+	tx.ToAddress, err = pk.GetAddress(common.ETHChain)
+	c.Assert(err, IsNil)
+	vault := GetRandomVault()
+	vault.PubKey = GetRandomPubKey()
+
+	keeper := &TestObservedTxInHandleKeeper{
+		nas: NodeAccounts{
+			GetRandomValidatorNode(NodeActive),
+			GetRandomValidatorNode(NodeActive),
+			GetRandomValidatorNode(NodeActive),
+		},
+		vault: vault,
+		pool: Pool{
+			Asset:        common.ETHAsset,
+			BalanceRune:  cosmos.NewUint(200),
+			BalanceAsset: cosmos.NewUint(300),
+		},
+		yggExists: true,
+	}
+	mgr.K = keeper
+	handler := NewObservedTxInHandler(mgr)
+
+	// every node observes the pending tx at its own scanner height, which is not final
+	for i, height := range []int64{12, 14} {
+		obTx := NewObservedTx(tx, height, vault.PubKey, height+1)
+		obTx.Pending = true
+		msg := NewMsgObservedTxIn(ObservedTxs{obTx}, keeper.nas[i].NodeAddress)
+		_, err = handler.handle(ctx, *msg)
+		c.Assert(err, IsNil)
+	}
+	voter, err := keeper.GetObservedTxInVoter(ctx, tx.ID)
+	c.Assert(err, IsNil)
+	c.Assert(voter.Height, Equals, int64(18))
+	c.Assert(voter.FinalisedHeight, Equals, int64(0))
+	c.Assert(voter.Tx.Tx.Gas.Equals(tx.Gas), Equals, true)
+	// the tx reached consensus, but the funds are not credited to the vault until finalised
+	c.Assert(voter.UpdatedVault, Equals, false)
+	c.Assert(keeper.vault.HasFunds(), Equals, false)
+
+	// once the tx is included in a block, the finalised observation reports the gas used
+	finalTx := tx
+	finalTx.Gas = common.Gas{common.NewCoin(common.ETHAsset, cosmos.NewUint(500))}
+	for i := range keeper.nas[:2] {
+		obTx := NewObservedTx(finalTx, 20, vault.PubKey, 20)
+		msg := NewMsgObservedTxIn(ObservedTxs{obTx}, keeper.nas[i].NodeAddress)
+		_, err = handler.handle(ctx, *msg)
+		c.Assert(err, IsNil)
+	}
+	voter, err = keeper.GetObservedTxInVoter(ctx, tx.ID)
+	c.Assert(err, IsNil)
+	c.Assert(voter.Height, Equals, int64(18))
+	c.Assert(voter.FinalisedHeight, Equals, int64(18))
+	c.Assert(voter.Tx.IsFinal(), Equals, true)
+	c.Assert(voter.Tx.Tx.Gas.Equals(finalTx.Gas), Equals, true)
+	c.Assert(voter.UpdatedVault, Equals, true)
+	ethCoin := keeper.vault.Coins.GetCoin(common.ETHAsset)
+	c.Assert(ethCoin.Amount.Equal(cosmos.NewUint(100)), Equals, true)
+	c.Assert(keeper.msg.Tx.ID.Equals(tx.ID), Equals, true)
+}
+
+func (s *HandlerObservedTxInSuite) TestHandleNonFinalEVMTx(c *C) {
+	var err error
+	ctx, mgr := setupManagerForTest(c)
+	tx := GetRandomTx()
+	tx.Chain = common.ETHChain
+	tx.FromAddress = types.GetRandomETHAddress()
+	tx.Coins = common.NewCoins(common.NewCoin(common.ETHAsset, cosmos.NewUint(100)))
+	tx.Memo = "SWAP:BTC.BTC:" + GetRandomBTCAddress().String()
+	pk := GetRandomPubKey()
+	tx.ToAddress, err = pk.GetAddress(common.ETHChain)
+	c.Assert(err, IsNil)
+	vault := GetRandomVault()
+	vault.PubKey = GetRandomPubKey()
+
+	keeper := &TestObservedTxInHandleKeeper{
+		nas:   NodeAccounts{GetRandomValidatorNode(NodeActive)},
+		vault: vault,
+		pool: Pool{
+			Asset:        common.ETHAsset,
+			BalanceRune:  cosmos.NewUint(200),
+			BalanceAsset: cosmos.NewUint(300),
+		},
+		yggExists: true,
+	}
+	mgr.K = keeper
+	handler := NewObservedTxInHandler(mgr)
+
+	// a tx in a block waiting for confirmations is credited on the first consensus
+	msg := NewMsgObservedTxIn(ObservedTxs{NewObservedTx(tx, 12, vault.PubKey, 14)}, keeper.nas[0].NodeAddress)
+	_, err = handler.handle(ctx, *msg)
+	c.Assert(err, IsNil)
+	c.Assert(keeper.voter.Height, Equals, int64(18))
+	c.Assert(keeper.voter.FinalisedHeight, Equals, int64(0))
+	c.Assert(keeper.voter.UpdatedVault, Equals, true)
+	ethCoin := keeper.vault.Coins.GetCoin(common.ETHAsset)
+	c.Assert(ethCoin.Amount.Equal(cosmos.NewUint(100)), Equals, true)
+
+	// the finalised observation doesn't credit the vault again
+	msg = NewMsgObservedTxIn(ObservedTxs{NewObservedTx(tx, 14, vault.PubKey, 14)}, keeper.nas[0].NodeAddress)
+	_, err = handler.handle(ctx, *msg)
+	c.Assert(err, IsNil)
+	c.Assert(keeper.voter.FinalisedHeight, Equals, int64(18))
+	c.Assert(keeper.voter.UpdatedVault, Equals, true)
+	ethCoin = keeper.vault.Coins.GetCoin(common.ETHAsset)
+	c.Assert(ethCoin.Amount.Equal(cosmos.NewUint(100)), Equals, true)
+}
+
 // Test migrate memo
 func (s *HandlerObservedTxInSuite) TestMigrateMemo(c *C) {
 	var err error
@@ -44,6 +44,8 @@ func (h SwapHandler) Run(ctx cosmos.Context, m cosmos.Msg) (*cosmos.Result, erro
 func (h SwapHandler) validate(ctx cosmos.Context, msg MsgSwap) error {
 	version := h.mgr.GetVersion()
 	switch {
+	case version.GTE(semver.MustParse("1.114.0")):
+		return h.validateV114(ctx, msg)
 	case version.GTE(semver.MustParse("1.113.0")):
 		return h.validateV113(ctx, msg)
 	case version.GTE(semver.MustParse("1.112.0")):
@@ -65,7 +67,7 @@ func (h SwapHandler) validate(ctx cosmos.Context, msg MsgSwap) error {
 	}
 }
 
-func (h SwapHandler) validateV113(ctx cosmos.Context, msg MsgSwap) error {
+func (h SwapHandler) validateV114(ctx cosmos.Context, msg MsgSwap) error {
 	if err := msg.ValidateBasicV63(); err != nil {
 		return err
 	}
@@ -142,6 +144,15 @@ func (h SwapHandler) validateV113(ctx cosmos.Context, msg MsgSwap) error {
 		}
 	}
 
+	if msg.IsStreaming() {
+		if msg.OrderType == LimitOrder {
+			return errors.New("limit orders cannot be streamed")
+		}
+		if target.IsDerivedAsset() || msg.Tx.Coins[0].Asset.IsDerivedAsset() {
+			return errors.New("swaps to/from a derived asset cannot be streamed")
+		}
+	}
+
 	if len(msg.Aggregator) > 0 {
 		swapOutDisabled := h.mgr.Keeper().GetConfigInt64(ctx, constants.SwapOutDexAggregationDisabled)
 		if swapOutDisabled > 0 {
@@ -170,6 +181,8 @@ func (h SwapHandler) handle(ctx cosmos.Context, msg MsgSwap) (*cosmos.Result, er
 	ctx.Logger().Info("receive MsgSwap", "request tx hash", msg.Tx.ID, "source asset", msg.Tx.Coins[0].Asset, "target asset", msg.TargetAsset, "signer", msg.Signer.String())
 	version := h.mgr.GetVersion()
 	switch {
+	case version.GTE(semver.MustParse("1.114.0")):
+		return h.handleV114(ctx, msg)
 	case version.GTE(semver.MustParse("1.110.0")):
 		return h.handleV110(ctx, msg)
 	case version.GTE(semver.MustParse("1.108.0")):
@@ -193,7 +206,7 @@ func (h SwapHandler) handle(ctx cosmos.Context, msg MsgSwap) (*cosmos.Result, er
 	}
 }
 
-func (h SwapHandler) handleV110(ctx cosmos.Context, msg MsgSwap) (*cosmos.Result, error) {
+func (h SwapHandler) handleV114(ctx cosmos.Context, msg MsgSwap) (*cosmos.Result, error) {
 	// test that the network we are running matches the destination network
 	// Don't change msg.Destination here; this line was introduced to avoid people from swapping mainnet asset,
 	// but using testnet address.
@@ -225,12 +238,26 @@ func (h SwapHandler) handleV110(ctx cosmos.Context, msg MsgSwap) (*cosmos.Result
 		return nil, err
 	}
 
+	// the output of a streaming sub-swap is held back, and paid out by the
+	// swap queue once the streaming swap is complete
+	destination := msg.Destination
+	if msg.IsStreaming() {
+		destination = common.NoopAddress
+	}
+
+	// affiliate fees directed at a THORName with a preferred asset are held
+	// back, and accrued to the name once swapped to RUNE
+	affiliateTHORName, accrueAffiliateFees := getAffiliateCollectorTHORName(ctx, h.mgr, msg.AffiliateTHORName)
+	if accrueAffiliateFees && msg.TargetAsset.IsNativeRune() {
+		destination = common.NoopAddress
+	}
+
 	emit, _, swapErr := swapper.Swap(
 		ctx,
 		h.mgr.Keeper(),
 		msg.Tx,
 		msg.TargetAsset,
-		msg.Destination,
+		destination,
 		msg.TradeTarget,
 		dexAgg,
 		dexAggTargetAsset,
@@ -250,6 +277,24 @@ func (h SwapHandler) handleV110(ctx cosmos.Context, msg MsgSwap) (*cosmos.Result
 		}
 	}
 
+	if msg.IsStreaming() {
+		swp, err := h.mgr.Keeper().GetStreamingSwap(ctx, msg.Tx.ID)
+		if err != nil {
+			return nil, ErrInternal(err, "fail to get streaming swap")
+		}
+		swp.In = swp.In.Add(msg.Tx.Coins[0].Amount)
+		swp.Out = swp.Out.Add(emit)
+		h.mgr.Keeper().SetStreamingSwap(ctx, swp)
+		return &cosmos.Result{}, nil
+	}
+
+	if accrueAffiliateFees && msg.TargetAsset.IsNativeRune() {
+		if err := accrueAffiliateFee(ctx, h.mgr, affiliateTHORName, emit); err != nil {
+			return nil, ErrInternal(err, "fail to accrue affiliate fee")
+		}
+		return &cosmos.Result{}, nil
+	}
+
 	mem, err := ParseMemoWithTHORNames(ctx, h.mgr.Keeper(), msg.Tx.Memo)
 	if err != nil {
 		ctx.Logger().Error("swap handler failed to parse memo", "memo", msg.Tx.Memo, "error", err)
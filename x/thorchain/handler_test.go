@@ -87,11 +87,12 @@ func setupManagerForTest(c *C) (cosmos.Context, *Mgrs) {
 
 	pk := paramskeeper.NewKeeper(marshaler, legacyCodec, keyParams, tkeyParams)
 	ak := authkeeper.NewAccountKeeper(marshaler, keyAcc, pk.Subspace(authtypes.ModuleName), authtypes.ProtoBaseAccount, map[string][]string{
-		ModuleName:  {authtypes.Minter, authtypes.Burner},
-		AsgardName:  {},
-		BondName:    {},
-		ReserveName: {},
-		LendingName: {},
+		ModuleName:             {authtypes.Minter, authtypes.Burner},
+		AsgardName:             {},
+		BondName:               {},
+		ReserveName:            {},
+		LendingName:            {},
+		AffiliateCollectorName: {},
 	})
 
 	bk := bankkeeper.NewBaseKeeper(marshaler, keyBank, ak, pk.Subspace(banktypes.ModuleName), nil)
@@ -158,11 +159,12 @@ func setupKeeperForTest(c *C) (cosmos.Context, keeper.Keeper) {
 
 	pk := paramskeeper.NewKeeper(marshaler, legacyCodec, keyParams, tkeyParams)
 	ak := authkeeper.NewAccountKeeper(marshaler, keyAcc, pk.Subspace(authtypes.ModuleName), authtypes.ProtoBaseAccount, map[string][]string{
-		ModuleName:  {authtypes.Minter, authtypes.Burner},
-		AsgardName:  {},
-		BondName:    {},
-		ReserveName: {},
-		LendingName: {},
+		ModuleName:             {authtypes.Minter, authtypes.Burner},
+		AsgardName:             {},
+		BondName:               {},
+		ReserveName:            {},
+		LendingName:            {},
+		AffiliateCollectorName: {},
 	})
 
 	bk := bankkeeper.NewBaseKeeper(marshaler, keyBank, ak, pk.Subspace(banktypes.ModuleName), nil)
@@ -94,6 +94,8 @@ func (h WithdrawLiquidityHandler) validateV112(ctx cosmos.Context, msg MsgWithdr
 func (h WithdrawLiquidityHandler) handle(ctx cosmos.Context, msg MsgWithdrawLiquidity) (*cosmos.Result, error) {
 	version := h.mgr.GetVersion()
 	switch {
+	case version.GTE(semver.MustParse("1.114.0")):
+		return h.handleV114(ctx, msg)
 	case version.GTE(semver.MustParse("1.107.0")):
 		return h.handleV107(ctx, msg)
 	case version.GTE(semver.MustParse("1.100.0")):
@@ -123,7 +125,7 @@ func (h WithdrawLiquidityHandler) handle(ctx cosmos.Context, msg MsgWithdrawLiqu
 	return nil, errBadVersion
 }
 
-func (h WithdrawLiquidityHandler) handleV107(ctx cosmos.Context, msg MsgWithdrawLiquidity) (*cosmos.Result, error) {
+func (h WithdrawLiquidityHandler) handleV114(ctx cosmos.Context, msg MsgWithdrawLiquidity) (*cosmos.Result, error) {
 	lp, err := h.mgr.Keeper().GetLiquidityProvider(ctx, msg.Asset, msg.WithdrawAddress)
 	if err != nil {
 		return nil, multierror.Append(errFailGetLiquidityProvider, err)
@@ -249,6 +251,15 @@ func (h WithdrawLiquidityHandler) handleV107(ctx cosmos.Context, msg MsgWithdraw
 				return nil, err
 			}
 
+			polPool, err := h.mgr.Keeper().GetPOLPool(ctx, msg.Asset)
+			if err != nil {
+				return nil, err
+			}
+			polPool.RuneWithdrawn = polPool.RuneWithdrawn.Add(runeAmt)
+			if err := h.mgr.Keeper().SetPOLPool(ctx, msg.Asset, polPool); err != nil {
+				return nil, err
+			}
+
 			ctx.Logger().Info("POL withdrawn", "pool", msg.Asset, "rune", runeAmt)
 			telemetry.IncrCounterWithLabels(
 				[]string{"thornode", "pol", "pool", "rune_withdrawn"},
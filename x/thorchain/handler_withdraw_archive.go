@@ -38,6 +38,180 @@ func (h WithdrawLiquidityHandler) validateV96(ctx cosmos.Context, msg MsgWithdra
 	return nil
 }
 
+func (h WithdrawLiquidityHandler) handleV107(ctx cosmos.Context, msg MsgWithdrawLiquidity) (*cosmos.Result, error) {
+	lp, err := h.mgr.Keeper().GetLiquidityProvider(ctx, msg.Asset, msg.WithdrawAddress)
+	if err != nil {
+		return nil, multierror.Append(errFailGetLiquidityProvider, err)
+	}
+	runeAmt, assetAmt, impLossProtection, units, gasAsset, err := withdraw(ctx, msg, h.mgr)
+	if err != nil {
+		return nil, ErrInternal(err, "fail to process withdraw request")
+	}
+
+	memo := ""
+	if msg.Tx.ID.Equals(common.BlankTxID) {
+		// tx id is blank, must be triggered by the ragnarok protocol
+		memo = NewRagnarokMemo(ctx.BlockHeight()).String()
+	}
+
+	// Thanks to CacheContext, the withdraw event can be emitted before handling outbounds,
+	// since if there's a later error the event emission will not take place.
+	if units.IsZero() && impLossProtection.IsZero() {
+		// withdraw pending liquidity event
+		runeHash := common.TxID("")
+		assetHash := common.TxID("")
+		if msg.Tx.Chain.Equals(common.THORChain) {
+			runeHash = msg.Tx.ID
+		} else {
+			assetHash = msg.Tx.ID
+		}
+		evt := NewEventPendingLiquidity(
+			msg.Asset,
+			WithdrawPendingLiquidity,
+			lp.RuneAddress,
+			runeAmt,
+			lp.AssetAddress,
+			assetAmt,
+			runeHash,
+			assetHash,
+		)
+		if err := h.mgr.EventMgr().EmitEvent(ctx, evt); err != nil {
+			return nil, multierror.Append(errFailSaveEvent, err)
+		}
+	} else {
+		withdrawEvt := NewEventWithdraw(
+			msg.Asset,
+			units,
+			int64(msg.BasisPoints.Uint64()),
+			cosmos.ZeroDec(),
+			msg.Tx,
+			assetAmt,
+			runeAmt,
+			impLossProtection,
+		)
+		if err := h.mgr.EventMgr().EmitEvent(ctx, withdrawEvt); err != nil {
+			return nil, multierror.Append(errFailSaveEvent, err)
+		}
+	}
+
+	transfer := func(coin common.Coin, addr common.Address) error {
+		toi := TxOutItem{
+			Chain:     coin.Asset.GetChain(),
+			InHash:    msg.Tx.ID,
+			ToAddress: addr,
+			Coin:      coin,
+			Memo:      memo,
+		}
+		if !gasAsset.IsZero() {
+			// TODO: chain specific logic should be in a single location
+			if msg.Asset.IsBNB() {
+				toi.MaxGas = common.Gas{
+					common.NewCoin(common.RuneAsset().GetChain().GetGasAsset(), gasAsset.QuoUint64(2)),
+				}
+			} else if msg.Asset.GetChain().GetGasAsset().Equals(msg.Asset) {
+				toi.MaxGas = common.Gas{
+					common.NewCoin(msg.Asset.GetChain().GetGasAsset(), gasAsset),
+				}
+			}
+			toi.GasRate = int64(h.mgr.GasMgr().GetGasRate(ctx, msg.Asset.GetChain()).Uint64())
+		}
+
+		ok, err := h.mgr.TxOutStore().TryAddTxOutItem(ctx, h.mgr, toi, cosmos.ZeroUint())
+		if err != nil {
+			return multierror.Append(errFailAddOutboundTx, err)
+		}
+		if !ok {
+			return errFailAddOutboundTx
+		}
+
+		return nil
+	}
+
+	if !assetAmt.IsZero() {
+		coin := common.NewCoin(msg.Asset, assetAmt)
+		// TODO: this might be an issue for single sided/AVAX->ETH, ETH -> AVAX
+		if !msg.Asset.IsNativeRune() && !lp.AssetAddress.IsChain(msg.Asset.GetChain()) {
+			if err := h.swap(ctx, msg, coin, lp.AssetAddress); err != nil {
+				return nil, err
+			}
+		} else {
+			if err := transfer(coin, lp.AssetAddress); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	if !runeAmt.IsZero() {
+		coin := common.NewCoin(common.RuneAsset(), runeAmt)
+		if err := transfer(coin, lp.RuneAddress); err != nil {
+			return nil, err
+		}
+
+		// if its the POL withdrawing, track rune withdrawn
+		polAddress, err := h.mgr.Keeper().GetModuleAddress(ReserveName)
+		if err != nil {
+			return nil, err
+		}
+
+		if polAddress.Equals(lp.RuneAddress) {
+			pol, err := h.mgr.Keeper().GetPOL(ctx)
+			if err != nil {
+				return nil, err
+			}
+			pol.RuneWithdrawn = pol.RuneWithdrawn.Add(runeAmt)
+
+			if err := h.mgr.Keeper().SetPOL(ctx, pol); err != nil {
+				return nil, err
+			}
+
+			ctx.Logger().Info("POL withdrawn", "pool", msg.Asset, "rune", runeAmt)
+			telemetry.IncrCounterWithLabels(
+				[]string{"thornode", "pol", "pool", "rune_withdrawn"},
+				telem(runeAmt),
+				[]metrics.Label{telemetry.NewLabel("pool", msg.Asset.String())},
+			)
+		}
+	}
+
+	// any extra rune in the transaction will be donated to reserve
+	reserveCoin := msg.Tx.Coins.GetCoin(common.RuneAsset())
+	if !reserveCoin.IsEmpty() {
+		if err := h.mgr.Keeper().AddPoolFeeToReserve(ctx, reserveCoin.Amount); err != nil {
+			ctx.Logger().Error("fail to add fee to reserve", "error", err)
+			return nil, err
+		}
+	}
+
+	// any extra non-rune in the transaction will be donated to its pool, if existing
+	for _, withdrawalCoin := range msg.Tx.Coins {
+		if withdrawalCoin.IsEmpty() || withdrawalCoin.Asset == common.RuneAsset() {
+			continue
+		}
+
+		withdrawalCoinPool, err := h.mgr.Keeper().GetPool(ctx, withdrawalCoin.Asset)
+		if err != nil {
+			return nil, ErrInternal(err, "fail to get pool")
+		}
+
+		if withdrawalCoinPool.IsEmpty() {
+			continue
+		}
+
+		withdrawalCoinPool.BalanceAsset = withdrawalCoinPool.BalanceAsset.Add(withdrawalCoin.Amount)
+		if err := h.mgr.Keeper().SetPool(ctx, withdrawalCoinPool); err != nil {
+			return nil, ErrInternal(err, "fail to save pool to key value store")
+		}
+	}
+
+	telemetry.IncrCounterWithLabels(
+		[]string{"thornode", "withdraw", "implossprotection"},
+		telem(impLossProtection),
+		[]metrics.Label{telemetry.NewLabel("asset", msg.Asset.String())},
+	)
+
+	return &cosmos.Result{}, nil
+}
+
 func (h WithdrawLiquidityHandler) handleV100(ctx cosmos.Context, msg MsgWithdrawLiquidity) (*cosmos.Result, error) {
 	lp, err := h.mgr.Keeper().GetLiquidityProvider(ctx, msg.Asset, msg.WithdrawAddress)
 	if err != nil {
@@ -26,6 +26,7 @@ type MockWithdrawKeeper struct {
 	lp                    LiquidityProvider
 	keeper                keeper.Keeper
 	pol                   ProtocolOwnedLiquidity
+	polPool               ProtocolOwnedLiquidity
 	polAddress            common.Address
 }
 
@@ -68,6 +69,15 @@ func (mfp *MockWithdrawKeeper) SetPOL(_ cosmos.Context, pol ProtocolOwnedLiquidi
 	return nil
 }
 
+func (mfp *MockWithdrawKeeper) GetPOLPool(_ cosmos.Context, _ common.Asset) (ProtocolOwnedLiquidity, error) {
+	return mfp.polPool, nil
+}
+
+func (mfp *MockWithdrawKeeper) SetPOLPool(_ cosmos.Context, _ common.Asset, pol ProtocolOwnedLiquidity) error {
+	mfp.polPool = pol
+	return nil
+}
+
 func (mfp *MockWithdrawKeeper) GetNodeAccount(_ cosmos.Context, addr cosmos.AccAddress) (NodeAccount, error) {
 	if mfp.activeNodeAccount.NodeAddress.Equals(addr) {
 		return mfp.activeNodeAccount, nil
@@ -123,6 +133,7 @@ func (HandlerWithdrawSuite) TestWithdrawHandler(c *C) {
 			AssetDepositValue: cosmos.ZeroUint(),
 		},
 		pol:        NewProtocolOwnedLiquidity(),
+		polPool:    NewProtocolOwnedLiquidity(),
 		polAddress: runeAddr,
 	}
 	ver := GetCurrentVersion()
@@ -149,6 +160,10 @@ func (HandlerWithdrawSuite) TestWithdrawHandler(c *C) {
 	pol, err := k.GetPOL(ctx)
 	c.Assert(err, IsNil)
 	c.Check(pol.RuneWithdrawn.Uint64(), Equals, uint64(100*common.One))
+	pol, err = k.GetPOLPool(ctx, common.BNBAsset)
+	c.Assert(err, IsNil)
+	c.Check(pol.RuneDeposited.Uint64(), Equals, uint64(100*common.One))
+	c.Check(pol.RuneWithdrawn.Uint64(), Equals, uint64(100*common.One))
 
 	// Bad version should fail
 	_, err = withdrawHandler.Run(ctx, msgWithdraw)
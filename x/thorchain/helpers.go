@@ -555,6 +555,24 @@ func polPoolValue(ctx cosmos.Context, mgr Manager) (cosmos.Uint, error) {
 	return total, nil
 }
 
+// polTargetSynthPerPoolDepth returns the synth per pool depth (in basis points)
+// the protocol owned liquidity targets in the given pool, liquidity is withdrawn
+// from a pool that isn't available or that mimir forces out of POL
+func polTargetSynthPerPoolDepth(ctx cosmos.Context, mgr Manager, pool Pool) (cosmos.Uint, error) {
+	target := cosmos.NewUint(uint64(fetchConfigInt64(ctx, mgr, constants.POLTargetSynthPerPoolDepth)))
+
+	// The POL key for the ETH.ETH pool would be POL-ETH-ETH .
+	key := "POL-" + pool.Asset.MimirString()
+	val, err := mgr.Keeper().GetMimir(ctx, key)
+	if err != nil {
+		return target, err
+	}
+	if val == 2 || pool.Status != PoolAvailable {
+		return cosmos.NewUint(10_000), nil
+	}
+	return target, nil
+}
+
 func wrapError(ctx cosmos.Context, err error, wrap string) error {
 	err = fmt.Errorf("%s: %w", wrap, err)
 	ctx.Logger().Error(err.Error())
@@ -1088,3 +1106,242 @@ func isActionsItemDangling(voter ObservedTxVoter, i int) bool {
 	}
 	return true
 }
+
+// getMaxSwapQuantity returns the max number of sub-swaps a streaming swap can
+// be split into. Each sub-swap should pay at least StreamingSwapMinBPFee in
+// slip against the shallowest pool, should be worth more than the native
+// transaction fee in RUNE, and the streaming swap may not take longer than
+// StreamingSwapMaxLength blocks.
+func getMaxSwapQuantity(ctx cosmos.Context, mgr Manager, sourceAsset, targetAsset common.Asset, swp StreamingSwap) (uint64, error) {
+	if swp.Interval == 0 {
+		return 0, nil
+	}
+
+	// find the shallowest pool (in RUNE) involved in the swap
+	depositRune := swp.Deposit
+	shallowRuneDepth := cosmos.ZeroUint()
+	for _, asset := range []common.Asset{sourceAsset, targetAsset} {
+		if asset.IsRune() {
+			continue
+		}
+		pool, err := mgr.Keeper().GetPool(ctx, asset.GetLayer1Asset())
+		if err != nil {
+			return 0, fmt.Errorf("fail to get pool: %w", err)
+		}
+		if pool.IsEmpty() || pool.BalanceRune.IsZero() || pool.BalanceAsset.IsZero() {
+			return 0, fmt.Errorf("pool(%s) is empty", asset.GetLayer1Asset())
+		}
+		if asset.Equals(sourceAsset) {
+			depositRune = pool.AssetValueInRune(swp.Deposit)
+		}
+		if shallowRuneDepth.IsZero() || pool.BalanceRune.LT(shallowRuneDepth) {
+			shallowRuneDepth = pool.BalanceRune
+		}
+	}
+
+	maxLength := uint64(mgr.Keeper().GetConfigInt64(ctx, constants.StreamingSwapMaxLength))
+	maxQuantity := maxLength / swp.Interval
+
+	minBP := mgr.Keeper().GetConfigInt64(ctx, constants.StreamingSwapMinBPFee)
+	if minBP > 0 {
+		minSize := common.GetUncappedShare(cosmos.NewUint(uint64(minBP)), cosmos.NewUint(10_000), shallowRuneDepth)
+		if !minSize.IsZero() {
+			quantity := depositRune.Quo(minSize).Uint64()
+			if quantity < maxQuantity {
+				maxQuantity = quantity
+			}
+		}
+	}
+
+	// a sub-swap worth no more than the native tx fee would be fee-negative
+	nativeTxFee := mgr.Keeper().GetNativeTxFee(ctx)
+	if !nativeTxFee.IsZero() {
+		quantity := common.SafeSub(depositRune, cosmos.OneUint()).Quo(nativeTxFee).Uint64()
+		if quantity < maxQuantity {
+			maxQuantity = quantity
+		}
+	}
+
+	if maxQuantity < 1 {
+		maxQuantity = 1
+	}
+	return maxQuantity, nil
+}
+
+// affiliateFee is the fee owed to an affiliate of a swap, along with the swap
+// paying it
+type affiliateFee struct {
+	affiliate Affiliate
+	swap      MsgSwap
+}
+
+// event returns the event recording the affiliate fee taken from the inbound
+func (f affiliateFee) event() *EventAffiliateFee {
+	return NewEventAffiliateFee(f.swap.Tx.ID, f.affiliate.Address, f.affiliate.BasisPoints, f.swap.Tx.Coins[0])
+}
+
+// takeAffiliateFees takes the fees owed to each of the affiliates of the given
+// swap out of its inbound, and returns the swaps (to RUNE) paying them. Fees
+// are shares of the whole inbound, so the order of the affiliates doesn't
+// matter. The returned swaps don't share coins with the given swap, so either
+// can be modified without affecting the other.
+func takeAffiliateFees(msg *MsgSwap) []affiliateFee {
+	affiliates := msg.GetAffiliates()
+	if len(affiliates) == 0 || msg.Tx.Coins.IsEmpty() {
+		return nil
+	}
+
+	inbound := msg.Tx.Coins[0]
+	remaining := inbound.Amount
+	fees := make([]affiliateFee, 0, len(affiliates))
+	for _, aff := range affiliates {
+		if aff.BasisPoints.IsZero() || !aff.Address.IsChain(common.THORChain) {
+			continue
+		}
+		amt := common.GetSafeShare(aff.BasisPoints, cosmos.NewUint(10_000), inbound.Amount)
+		if amt.GT(remaining) {
+			amt = remaining
+		}
+		if amt.IsZero() {
+			continue
+		}
+		remaining = common.SafeSub(remaining, amt)
+
+		coin := inbound
+		coin.Amount = amt
+		tx := msg.Tx
+		tx.Coins = common.Coins{coin}
+		swap := NewMsgSwap(tx, common.RuneAsset(), aff.Address, cosmos.ZeroUint(), common.NoAddress, cosmos.ZeroUint(), "", "", nil, MarketOrder, msg.Signer)
+		swap.AffiliateTHORName = aff.THORName
+		fees = append(fees, affiliateFee{affiliate: aff, swap: *swap})
+	}
+
+	coin := inbound
+	coin.Amount = remaining
+	msg.Tx.Coins = common.Coins{coin}
+	return fees
+}
+
+// payAffiliateFeeRune pays an affiliate fee already in native RUNE, held in
+// asgard. Fees directed at a THORName with a preferred asset are accrued to the
+// name, other fees are sent directly to the affiliate.
+func payAffiliateFeeRune(ctx cosmos.Context, mgr Manager, fee affiliateFee) error {
+	coin := fee.swap.Tx.Coins[0]
+	if tn, ok := getAffiliateCollectorTHORName(ctx, mgr, fee.affiliate.THORName); ok {
+		return accrueAffiliateFee(ctx, mgr, tn, coin.Amount)
+	}
+	toAddress, err := fee.affiliate.Address.AccAddress()
+	if err != nil {
+		return fmt.Errorf("fail to convert address into AccAddress: %w", err)
+	}
+	// since native transaction fee has been charged to inbound from address, thus for affiliated fee , the network doesn't need to charge it again
+	if err := mgr.Keeper().SendFromModuleToAccount(ctx, AsgardName, toAddress, common.NewCoins(coin)); err != nil {
+		return fmt.Errorf("fail to send native asset to affiliate: %w", err)
+	}
+	return nil
+}
+
+// getAffiliateCollectorTHORName returns the THORName of the given name when
+// the affiliate fees directed at it should be accrued, that is when it has a
+// preferred asset other than RUNE, and an alias on the chain of that asset to
+// pay the fees out to
+func getAffiliateCollectorTHORName(ctx cosmos.Context, mgr Manager, name string) (THORName, bool) {
+	if name == "" || !mgr.Keeper().THORNameExists(ctx, name) {
+		return THORName{}, false
+	}
+	tn, err := mgr.Keeper().GetTHORName(ctx, name)
+	if err != nil {
+		ctx.Logger().Error("fail to get thorname", "name", name, "error", err)
+		return THORName{}, false
+	}
+	if tn.PreferredAsset.IsEmpty() || tn.PreferredAsset.IsNativeRune() {
+		return THORName{}, false
+	}
+	if tn.GetAlias(tn.PreferredAsset.GetChain()).IsEmpty() {
+		return THORName{}, false
+	}
+	return tn, true
+}
+
+// accrueAffiliateFee moves the given RUNE affiliate fee from asgard into the
+// affiliate collector module, on behalf of the given THORName. Once the fees
+// accrued by the name are worth enough outbound fees of its preferred asset,
+// they are paid out.
+func accrueAffiliateFee(ctx cosmos.Context, mgr Manager, tn THORName, amt cosmos.Uint) error {
+	if amt.IsZero() {
+		return nil
+	}
+	coins := common.NewCoins(common.NewCoin(common.RuneNative, amt))
+	if err := mgr.Keeper().SendFromModuleToModule(ctx, AsgardName, AffiliateCollectorName, coins); err != nil {
+		return fmt.Errorf("fail to send affiliate fee to affiliate collector: %w", err)
+	}
+	accrued, err := mgr.Keeper().GetAffiliateCollectorRune(ctx, tn.Name)
+	if err != nil {
+		return fmt.Errorf("fail to get affiliate collector rune: %w", err)
+	}
+	accrued = accrued.Add(amt)
+	mgr.Keeper().SetAffiliateCollectorRune(ctx, tn.Name, accrued)
+
+	multiplier := mgr.Keeper().GetConfigInt64(ctx, constants.PreferredAssetOutboundFeeMultiplier)
+	if multiplier < 0 {
+		multiplier = 0
+	}
+	outboundFee := mgr.GasMgr().GetFee(ctx, tn.PreferredAsset.GetChain(), common.RuneAsset())
+	if accrued.LT(outboundFee.MulUint64(uint64(multiplier))) {
+		return nil
+	}
+	return payoutAffiliateCollector(ctx, mgr, tn, accrued)
+}
+
+// refundAffiliateCollector sends the RUNE accrued by the given THORName back to
+// its owner, as is
+func refundAffiliateCollector(ctx cosmos.Context, mgr Manager, tn THORName, amt cosmos.Uint) error {
+	coins := common.NewCoins(common.NewCoin(common.RuneNative, amt))
+	if err := mgr.Keeper().SendFromModuleToAccount(ctx, AffiliateCollectorName, tn.Owner, coins); err != nil {
+		return fmt.Errorf("fail to refund accrued affiliate fees: %w", err)
+	}
+	mgr.Keeper().SetAffiliateCollectorRune(ctx, tn.Name, cosmos.ZeroUint())
+	return nil
+}
+
+// payoutAffiliateCollector swaps the RUNE accrued by the given THORName to its
+// preferred asset, and sends it to its alias on the chain of that asset. Should
+// the swap fail, the RUNE is refunded to the THOR alias of the name, or to its
+// owner.
+func payoutAffiliateCollector(ctx cosmos.Context, mgr Manager, tn THORName, amt cosmos.Uint) error {
+	coin := common.NewCoin(common.RuneNative, amt)
+	if err := mgr.Keeper().SendFromModuleToModule(ctx, AffiliateCollectorName, AsgardName, common.NewCoins(coin)); err != nil {
+		return fmt.Errorf("fail to send accrued affiliate fees to asgard: %w", err)
+	}
+	mgr.Keeper().SetAffiliateCollectorRune(ctx, tn.Name, cosmos.ZeroUint())
+
+	from := tn.GetAlias(common.THORChain)
+	if from.IsEmpty() {
+		from = common.Address(tn.Owner.String())
+	}
+	asgard, err := mgr.Keeper().GetModuleAddress(AsgardName)
+	if err != nil {
+		return fmt.Errorf("fail to get asgard address: %w", err)
+	}
+	txID, err := common.NewTxID(fmt.Sprintf("%X", sha256.Sum256([]byte(fmt.Sprintf("affiliate-collector/%s/%d/%s", tn.Name, ctx.BlockHeight(), amt)))))
+	if err != nil {
+		return fmt.Errorf("fail to create tx id: %w", err)
+	}
+	dest := tn.GetAlias(tn.PreferredAsset.GetChain())
+	memo := fmt.Sprintf("=:%s:%s", tn.PreferredAsset, dest)
+	tx := common.NewTx(txID, from, asgard, common.NewCoins(coin), common.Gas{}, memo)
+	tx.Chain = common.THORChain
+
+	signer := mgr.Keeper().GetModuleAccAddress(AffiliateCollectorName)
+	msg := NewMsgSwap(tx, tn.PreferredAsset, dest, cosmos.ZeroUint(), common.NoAddress, cosmos.ZeroUint(), "", "", nil, MarketOrder, signer)
+	if mgr.Keeper().OrderBooksEnabled(ctx) {
+		if err := mgr.OrderBookMgr().AddOrderBookItem(ctx, *msg); err != nil {
+			return fmt.Errorf("fail to add affiliate payout to order book: %w", err)
+		}
+		return nil
+	}
+	if err := mgr.Keeper().SetSwapQueueItem(ctx, *msg, 0); err != nil {
+		return fmt.Errorf("fail to add affiliate payout to swap queue: %w", err)
+	}
+	return nil
+}
@@ -993,6 +993,53 @@ func (s *HelperSuite) TestGetHardBondCap(c *C) {
 	c.Assert(getHardBondCap(nas).Uint64(), Equals, uint64(40), Commentf("%d", getHardBondCap(nas).Uint64()))
 }
 
+func (s *HelperSuite) TestTakeAffiliateFees(c *C) {
+	aff1 := GetRandomTHORAddress()
+	aff2 := GetRandomTHORAddress()
+	tx := GetRandomTx()
+	tx.Coins = common.NewCoins(common.NewCoin(common.BTCAsset, cosmos.NewUint(10_000)))
+	msg := NewMsgSwap(tx, common.RuneAsset(), GetRandomTHORAddress(), cosmos.ZeroUint(), aff1, cosmos.NewUint(150), "", "", nil, MarketOrder, GetRandomBech32Addr())
+
+	// a single affiliate
+	single := *msg
+	fees := takeAffiliateFees(&single)
+	c.Assert(fees, HasLen, 1)
+	c.Check(fees[0].affiliate.Address.Equals(aff1), Equals, true)
+	c.Check(fees[0].swap.Destination.Equals(aff1), Equals, true)
+	c.Check(fees[0].swap.TargetAsset.Equals(common.RuneAsset()), Equals, true)
+	c.Check(fees[0].swap.Tx.Coins[0].Amount.Uint64(), Equals, uint64(150))
+	c.Check(single.Tx.Coins[0].Amount.Uint64(), Equals, uint64(9_850))
+	// the inbound of the original message is left untouched
+	c.Check(msg.Tx.Coins[0].Amount.Uint64(), Equals, uint64(10_000))
+
+	// several affiliates, each get their own share of the inbound
+	multi := *msg
+	multi.Affiliates = []Affiliate{
+		NewAffiliate(aff1, cosmos.NewUint(100)),
+		NewAffiliate(aff2, cosmos.NewUint(50)),
+	}
+	fees = takeAffiliateFees(&multi)
+	c.Assert(fees, HasLen, 2)
+	c.Check(fees[0].swap.Destination.Equals(aff1), Equals, true)
+	c.Check(fees[0].swap.Tx.Coins[0].Amount.Uint64(), Equals, uint64(100))
+	c.Check(fees[1].swap.Destination.Equals(aff2), Equals, true)
+	c.Check(fees[1].swap.Tx.Coins[0].Amount.Uint64(), Equals, uint64(50))
+	c.Check(multi.Tx.Coins[0].Amount.Uint64(), Equals, uint64(9_850))
+
+	evt := fees[1].event()
+	c.Check(evt.TxID.Equals(tx.ID), Equals, true)
+	c.Check(evt.Affiliate.Equals(aff2), Equals, true)
+	c.Check(evt.BasisPoints.Uint64(), Equals, uint64(50))
+	c.Check(evt.Fee.Amount.Uint64(), Equals, uint64(50))
+
+	// no affiliates
+	none := *msg
+	none.AffiliateAddress = common.NoAddress
+	none.AffiliateBasisPoints = cosmos.ZeroUint()
+	c.Check(takeAffiliateFees(&none), HasLen, 0)
+	c.Check(none.Tx.Coins[0].Amount.Uint64(), Equals, uint64(10_000))
+}
+
 func (HandlerSuite) TestIsSignedByActiveNodeAccounts(c *C) {
 	ctx, mgr := setupManagerForTest(c)
 
@@ -1032,3 +1079,102 @@ func (HandlerSuite) TestIsSignedByActiveNodeAccounts(c *C) {
 	c.Check(r, Equals, true,
 		Commentf("asgard module address should return true"))
 }
+
+func (s *HelperSuite) TestAccrueAffiliateFee(c *C) {
+	ctx, mgr := setupManagerForTest(c)
+
+	name := NewTHORName("alice", ctx.BlockHeight()+100, []THORNameAlias{
+		{Chain: common.THORChain, Address: GetRandomTHORAddress()},
+	})
+	name.Owner = GetRandomBech32Addr()
+	mgr.Keeper().SetTHORName(ctx, name)
+
+	// no preferred asset, fees are paid directly
+	_, ok := getAffiliateCollectorTHORName(ctx, mgr, "alice")
+	c.Check(ok, Equals, false)
+	name.PreferredAsset = common.BTCAsset
+	mgr.Keeper().SetTHORName(ctx, name)
+	_, ok = getAffiliateCollectorTHORName(ctx, mgr, "alice")
+	c.Check(ok, Equals, false)
+	name.SetAlias(common.BTCChain, GetRandomBTCAddress())
+	mgr.Keeper().SetTHORName(ctx, name)
+	tn, ok := getAffiliateCollectorTHORName(ctx, mgr, "alice")
+	c.Assert(ok, Equals, true)
+
+	coin := common.NewCoin(common.RuneNative, cosmos.NewUint(300*common.One))
+	c.Assert(mgr.Keeper().MintToModule(ctx, ModuleName, coin), IsNil)
+	c.Assert(mgr.Keeper().SendFromModuleToModule(ctx, ModuleName, AsgardName, common.NewCoins(coin)), IsNil)
+
+	// fees below the threshold are held in the affiliate collector
+	mgr.Keeper().SetMimir(ctx, constants.PreferredAssetOutboundFeeMultiplier.String(), 1_000_000)
+	c.Assert(accrueAffiliateFee(ctx, mgr, tn, cosmos.NewUint(100*common.One)), IsNil)
+	c.Assert(accrueAffiliateFee(ctx, mgr, tn, cosmos.NewUint(100*common.One)), IsNil)
+	accrued, err := mgr.Keeper().GetAffiliateCollectorRune(ctx, "alice")
+	c.Assert(err, IsNil)
+	c.Check(accrued.Uint64(), Equals, uint64(200*common.One))
+	bal := mgr.Keeper().GetBalanceOfModule(ctx, AffiliateCollectorName, common.RuneNative.Native())
+	c.Check(bal.Uint64(), Equals, uint64(200*common.One))
+
+	// once the threshold is crossed, everything accrued is paid out
+	mgr.Keeper().SetMimir(ctx, constants.PreferredAssetOutboundFeeMultiplier.String(), 0)
+	c.Assert(accrueAffiliateFee(ctx, mgr, tn, cosmos.NewUint(100*common.One)), IsNil)
+	accrued, err = mgr.Keeper().GetAffiliateCollectorRune(ctx, "alice")
+	c.Assert(err, IsNil)
+	c.Check(accrued.IsZero(), Equals, true)
+	bal = mgr.Keeper().GetBalanceOfModule(ctx, AffiliateCollectorName, common.RuneNative.Native())
+	c.Check(bal.IsZero(), Equals, true)
+
+	items := make([]MsgSwap, 0)
+	iter := mgr.Keeper().GetSwapQueueIterator(ctx)
+	for ; iter.Valid(); iter.Next() {
+		var msg MsgSwap
+		c.Assert(mgr.Keeper().Cdc().Unmarshal(iter.Value(), &msg), IsNil)
+		items = append(items, msg)
+	}
+	iter.Close()
+	c.Assert(items, HasLen, 1)
+	c.Check(items[0].TargetAsset.Equals(common.BTCAsset), Equals, true)
+	c.Check(items[0].Destination.Equals(name.GetAlias(common.BTCChain)), Equals, true)
+	c.Check(items[0].Tx.Coins[0].Amount.Uint64(), Equals, uint64(300*common.One))
+}
+
+func (s *HelperSuite) TestGetMaxSwapQuantity(c *C) {
+	ctx, mgr := setupManagerForTest(c)
+
+	btcPool := NewPool()
+	btcPool.Asset = common.BTCAsset
+	btcPool.BalanceRune = cosmos.NewUint(2000 * common.One)
+	btcPool.BalanceAsset = cosmos.NewUint(20 * common.One)
+	c.Assert(mgr.Keeper().SetPool(ctx, btcPool), IsNil)
+
+	// no interval, not a streaming swap
+	swp := NewStreamingSwap(GetRandomTxHash(), 0, 0, cosmos.ZeroUint(), cosmos.NewUint(common.One))
+	quantity, err := getMaxSwapQuantity(ctx, mgr, common.RuneAsset(), common.BTCAsset, swp)
+	c.Assert(err, IsNil)
+	c.Check(quantity, Equals, uint64(0))
+
+	// min bp fee caps the quantity, 5bp of 2000 RUNE is 1 RUNE per sub-swap
+	swp = NewStreamingSwap(GetRandomTxHash(), 0, 1, cosmos.ZeroUint(), cosmos.NewUint(10*common.One))
+	quantity, err = getMaxSwapQuantity(ctx, mgr, common.RuneAsset(), common.BTCAsset, swp)
+	c.Assert(err, IsNil)
+	c.Check(quantity, Equals, uint64(10))
+
+	// each sub-swap must be worth more than the native tx fee (0.02 RUNE)
+	mgr.Keeper().SetMimir(ctx, constants.StreamingSwapMinBPFee.String(), 0)
+	swp = NewStreamingSwap(GetRandomTxHash(), 0, 1, cosmos.ZeroUint(), cosmos.NewUint(common.One/10))
+	quantity, err = getMaxSwapQuantity(ctx, mgr, common.RuneAsset(), common.BTCAsset, swp)
+	c.Assert(err, IsNil)
+	c.Check(quantity, Equals, uint64(4))
+
+	// the source asset is valued in RUNE, 0.001 BTC is 0.1 RUNE
+	swp = NewStreamingSwap(GetRandomTxHash(), 0, 1, cosmos.ZeroUint(), cosmos.NewUint(common.One/1000))
+	quantity, err = getMaxSwapQuantity(ctx, mgr, common.BTCAsset, common.RuneAsset(), swp)
+	c.Assert(err, IsNil)
+	c.Check(quantity, Equals, uint64(4))
+
+	// a deposit worth less than the native tx fee still gets a single swap
+	swp = NewStreamingSwap(GetRandomTxHash(), 0, 1, cosmos.ZeroUint(), cosmos.NewUint(common.One/100))
+	quantity, err = getMaxSwapQuantity(ctx, mgr, common.RuneAsset(), common.BTCAsset, swp)
+	c.Assert(err, IsNil)
+	c.Check(quantity, Equals, uint64(1))
+}
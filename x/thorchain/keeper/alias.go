@@ -39,6 +39,7 @@ type (
 	Pools                    = types.Pools
 	LiquidityProvider        = types.LiquidityProvider
 	Loan                     = types.Loan
+	StreamingSwap            = types.StreamingSwap
 	ObservedTxVoter          = types.ObservedTxVoter
 	BanVoter                 = types.BanVoter
 	ErrataTxVoter            = types.ErrataTxVoter
@@ -61,6 +62,7 @@ type (
 	NodeStatus               = types.NodeStatus
 	Network                  = types.Network
 	ProtocolOwnedLiquidity   = types.ProtocolOwnedLiquidity
+	SaversGrowth             = types.SaversGrowth
 	VaultStatus              = types.VaultStatus
 	NetworkFee               = types.NetworkFee
 	ObservedNetworkFeeVoter  = types.ObservedNetworkFeeVoter
@@ -76,6 +76,7 @@ type Keeper interface {
 	KeeperErrataTx
 	KeeperBanVoter
 	KeeperSwapQueue
+	KeeperStreamingSwap
 	KeeperOrderBooks
 	KeeperMimir
 	KeeperNetworkFee
@@ -101,6 +102,8 @@ type KeeperPool interface {
 	RemovePool(ctx cosmos.Context, asset common.Asset)
 	SetPoolLUVI(ctx cosmos.Context, asset common.Asset, luvi cosmos.Uint)
 	GetPoolLUVI(ctx cosmos.Context, asset common.Asset) (cosmos.Uint, error)
+	SetSaversGrowth(ctx cosmos.Context, asset common.Asset, growth SaversGrowth)
+	GetSaversGrowth(ctx cosmos.Context, asset common.Asset) (SaversGrowth, error)
 }
 
 type KeeperLastHeight interface {
@@ -117,6 +120,7 @@ type KeeperLastHeight interface {
 
 type KeeperLoan interface {
 	GetLoanIterator(ctx cosmos.Context, _ common.Asset) cosmos.Iterator
+	GetLoanIteratorAfter(ctx cosmos.Context, _ common.Asset, owner string) cosmos.Iterator
 	GetLoan(ctx cosmos.Context, asset common.Asset, addr common.Address) (Loan, error)
 	SetLoan(ctx cosmos.Context, _ Loan)
 	RemoveLoan(ctx cosmos.Context, _ Loan)
@@ -126,6 +130,7 @@ type KeeperLoan interface {
 
 type KeeperLiquidityProvider interface {
 	GetLiquidityProviderIterator(ctx cosmos.Context, _ common.Asset) cosmos.Iterator
+	GetLiquidityProviderIteratorAfter(ctx cosmos.Context, _ common.Asset, addr string) cosmos.Iterator
 	GetLiquidityProvider(ctx cosmos.Context, asset common.Asset, addr common.Address) (LiquidityProvider, error)
 	SetLiquidityProvider(ctx cosmos.Context, lp LiquidityProvider)
 	RemoveLiquidityProvider(ctx cosmos.Context, lp LiquidityProvider)
@@ -144,6 +149,7 @@ type KeeperNodeAccount interface {
 	SetNodeAccount(ctx cosmos.Context, na NodeAccount) error
 	EnsureNodeKeysUnique(ctx cosmos.Context, consensusPubKey string, pubKeys common.PubKeySet) error
 	GetNodeAccountIterator(ctx cosmos.Context) cosmos.Iterator
+	GetNodeAccountIteratorAfter(ctx cosmos.Context, addr string) cosmos.Iterator
 	GetNodeAccountSlashPoints(_ cosmos.Context, _ cosmos.AccAddress) (int64, error)
 	SetNodeAccountSlashPoints(_ cosmos.Context, _ cosmos.AccAddress, _ int64)
 	IncNodeAccountSlashPoints(_ cosmos.Context, _ cosmos.AccAddress, _ int64) error
@@ -223,6 +229,8 @@ type KeeperNetwork interface {
 	SetNetwork(ctx cosmos.Context, data Network) error
 	GetPOL(ctx cosmos.Context) (ProtocolOwnedLiquidity, error)
 	SetPOL(ctx cosmos.Context, data ProtocolOwnedLiquidity) error
+	GetPOLPool(ctx cosmos.Context, asset common.Asset) (ProtocolOwnedLiquidity, error)
+	SetPOLPool(ctx cosmos.Context, asset common.Asset, data ProtocolOwnedLiquidity) error
 }
 
 type KeeperTss interface {
@@ -277,11 +285,20 @@ type KeeperErrataTx interface {
 type KeeperSwapQueue interface {
 	SetSwapQueueItem(ctx cosmos.Context, msg MsgSwap, i int) error
 	GetSwapQueueIterator(ctx cosmos.Context) cosmos.Iterator
+	GetSwapQueueIteratorAfter(ctx cosmos.Context, txID string) cosmos.Iterator
 	GetSwapQueueItem(ctx cosmos.Context, txID common.TxID, i int) (MsgSwap, error)
 	HasSwapQueueItem(ctx cosmos.Context, txID common.TxID, i int) bool
 	RemoveSwapQueueItem(ctx cosmos.Context, txID common.TxID, i int)
 }
 
+type KeeperStreamingSwap interface {
+	GetStreamingSwapIterator(ctx cosmos.Context) cosmos.Iterator
+	GetStreamingSwap(ctx cosmos.Context, hash common.TxID) (StreamingSwap, error)
+	HasStreamingSwap(ctx cosmos.Context, hash common.TxID) bool
+	SetStreamingSwap(ctx cosmos.Context, _ StreamingSwap)
+	RemoveStreamingSwap(ctx cosmos.Context, hash common.TxID)
+}
+
 type KeeperOrderBooks interface {
 	OrderBooksEnabled(ctx cosmos.Context) bool
 	SetOrderBookItem(ctx cosmos.Context, msg MsgSwap) error
@@ -294,6 +311,7 @@ type KeeperOrderBooks interface {
 	GetOrderBookIndex(_ cosmos.Context, _ MsgSwap) (common.TxIDs, error)
 	HasOrderBookIndex(_ cosmos.Context, _ MsgSwap) (bool, error)
 	RemoveOrderBookIndex(_ cosmos.Context, _ MsgSwap) error
+	GetOrderBookExpiryIterator(_ cosmos.Context) cosmos.Iterator
 	SetOrderBookProcessor(_ cosmos.Context, _ []bool) error
 	GetOrderBookProcessor(_ cosmos.Context) ([]bool, error)
 }
@@ -338,9 +356,13 @@ type KeeperSolvencyVoter interface {
 type KeeperTHORName interface {
 	THORNameExists(ctx cosmos.Context, _ string) bool
 	GetTHORName(ctx cosmos.Context, _ string) (THORName, error)
+	GetTHORNameRecord(ctx cosmos.Context, _ string) (THORName, error)
 	SetTHORName(ctx cosmos.Context, name THORName)
 	GetTHORNameIterator(ctx cosmos.Context) cosmos.Iterator
 	DeleteTHORName(ctx cosmos.Context, _ string) error
+	GetTHORNamesByAddress(ctx cosmos.Context, addr common.Address) ([]string, error)
+	GetAffiliateCollectorRune(ctx cosmos.Context, name string) (cosmos.Uint, error)
+	SetAffiliateCollectorRune(ctx cosmos.Context, name string, amt cosmos.Uint)
 }
 
 type KeeperHalt interface {
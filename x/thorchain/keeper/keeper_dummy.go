@@ -178,10 +178,19 @@ func (k KVStoreDummy) GetPoolLUVI(ctx cosmos.Context, asset common.Asset) (cosmo
 	return cosmos.ZeroUint(), kaboom
 }
 
+func (k KVStoreDummy) SetSaversGrowth(ctx cosmos.Context, asset common.Asset, growth SaversGrowth) {}
+func (k KVStoreDummy) GetSaversGrowth(ctx cosmos.Context, asset common.Asset) (SaversGrowth, error) {
+	return SaversGrowth{}, kaboom
+}
+
 func (k KVStoreDummy) GetLoanIterator(ctx cosmos.Context, _ common.Asset) cosmos.Iterator {
 	return nil
 }
 
+func (k KVStoreDummy) GetLoanIteratorAfter(ctx cosmos.Context, _ common.Asset, _ string) cosmos.Iterator {
+	return nil
+}
+
 func (k KVStoreDummy) GetLoan(ctx cosmos.Context, asset common.Asset, addr common.Address) (Loan, error) {
 	return Loan{}, kaboom
 }
@@ -196,6 +205,10 @@ func (k KVStoreDummy) GetLiquidityProviderIterator(_ cosmos.Context, _ common.As
 	return nil
 }
 
+func (k KVStoreDummy) GetLiquidityProviderIteratorAfter(_ cosmos.Context, _ common.Asset, _ string) cosmos.Iterator {
+	return nil
+}
+
 func (k KVStoreDummy) GetLiquidityProvider(_ cosmos.Context, _ common.Asset, _ common.Address) (LiquidityProvider, error) {
 	return LiquidityProvider{}, kaboom
 }
@@ -240,6 +253,9 @@ func (k KVStoreDummy) EnsureNodeKeysUnique(_ cosmos.Context, _ string, _ common.
 	return kaboom
 }
 func (k KVStoreDummy) GetNodeAccountIterator(_ cosmos.Context) cosmos.Iterator { return nil }
+func (k KVStoreDummy) GetNodeAccountIteratorAfter(_ cosmos.Context, _ string) cosmos.Iterator {
+	return nil
+}
 
 func (k KVStoreDummy) GetNodeAccountSlashPoints(_ cosmos.Context, _ cosmos.AccAddress) (int64, error) {
 	return 0, kaboom
@@ -381,6 +397,14 @@ func (k KVStoreDummy) SetPOL(_ cosmos.Context, _ ProtocolOwnedLiquidity) error {
 	return kaboom
 }
 
+func (k KVStoreDummy) GetPOLPool(_ cosmos.Context, _ common.Asset) (ProtocolOwnedLiquidity, error) {
+	return ProtocolOwnedLiquidity{}, kaboom
+}
+
+func (k KVStoreDummy) SetPOLPool(_ cosmos.Context, _ common.Asset, _ ProtocolOwnedLiquidity) error {
+	return kaboom
+}
+
 func (k KVStoreDummy) SetTssKeysignFailVoter(_ cosmos.Context, tss TssKeysignFailVoter) {
 }
 
@@ -418,10 +442,22 @@ func (k KVStoreDummy) GetSwapQueueItem(ctx cosmos.Context, txID common.TxID, _ i
 	return MsgSwap{}, kaboom
 }
 
+func (k KVStoreDummy) GetSwapQueueIteratorAfter(ctx cosmos.Context, _ string) cosmos.Iterator {
+	return nil
+}
+
 func (k KVStoreDummy) HasSwapQueueItem(ctx cosmos.Context, txID common.TxID, _ int) bool {
 	return false
 }
 
+func (k KVStoreDummy) GetStreamingSwapIterator(ctx cosmos.Context) cosmos.Iterator { return nil }
+func (k KVStoreDummy) GetStreamingSwap(ctx cosmos.Context, _ common.TxID) (StreamingSwap, error) {
+	return StreamingSwap{}, kaboom
+}
+func (k KVStoreDummy) HasStreamingSwap(ctx cosmos.Context, _ common.TxID) bool { return false }
+func (k KVStoreDummy) SetStreamingSwap(ctx cosmos.Context, _ StreamingSwap)    {}
+func (k KVStoreDummy) RemoveStreamingSwap(ctx cosmos.Context, _ common.TxID)   {}
+
 func (k KVStoreDummy) OrderBooksEnabled(ctx cosmos.Context) bool {
 	return false
 }
@@ -457,6 +493,10 @@ func (k KVStoreDummy) RemoveOrderBookIndex(_ cosmos.Context, _ MsgSwap) error {
 	return kaboom
 }
 
+func (k KVStoreDummy) GetOrderBookExpiryIterator(_ cosmos.Context) cosmos.Iterator {
+	return nil
+}
+
 func (k KVStoreDummy) SetOrderBookProcessor(ctx cosmos.Context, record []bool) error {
 	return kaboom
 }
@@ -555,9 +595,19 @@ func (k KVStoreDummy) THORNameExists(ctx cosmos.Context, _ string) bool { return
 func (k KVStoreDummy) GetTHORName(ctx cosmos.Context, _ string) (THORName, error) {
 	return THORName{}, kaboom
 }
+func (k KVStoreDummy) GetTHORNameRecord(ctx cosmos.Context, _ string) (THORName, error) {
+	return THORName{}, kaboom
+}
 func (k KVStoreDummy) SetTHORName(ctx cosmos.Context, name THORName)          {}
 func (k KVStoreDummy) GetTHORNameIterator(ctx cosmos.Context) cosmos.Iterator { return nil }
 func (k KVStoreDummy) DeleteTHORName(ctx cosmos.Context, _ string) error      { return kaboom }
+func (k KVStoreDummy) GetTHORNamesByAddress(ctx cosmos.Context, addr common.Address) ([]string, error) {
+	return nil, kaboom
+}
+func (k KVStoreDummy) GetAffiliateCollectorRune(ctx cosmos.Context, name string) (cosmos.Uint, error) {
+	return cosmos.ZeroUint(), kaboom
+}
+func (k KVStoreDummy) SetAffiliateCollectorRune(ctx cosmos.Context, name string, amt cosmos.Uint) {}
 
 func (k KVStoreDummy) InvariantRoutes() []crisis.InvarRoute {
 	return nil
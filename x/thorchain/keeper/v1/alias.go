@@ -42,8 +42,10 @@ var (
 	NewPool                    = types.NewPool
 	NewJail                    = types.NewJail
 	NewLoan                    = types.NewLoan
+	NewStreamingSwap           = types.NewStreamingSwap
 	NewNetwork                 = types.NewNetwork
 	NewProtocolOwnedLiquidity  = types.NewProtocolOwnedLiquidity
+	NewSaversGrowth            = types.NewSaversGrowth
 	NewObservedTx              = types.NewObservedTx
 	NewTssVoter                = types.NewTssVoter
 	NewBanVoter                = types.NewBanVoter
@@ -88,6 +90,7 @@ type (
 	Pools                    = types.Pools
 	LiquidityProvider        = types.LiquidityProvider
 	Loan                     = types.Loan
+	StreamingSwap            = types.StreamingSwap
 	ObservedTxs              = types.ObservedTxs
 	ObservedTxVoter          = types.ObservedTxVoter
 	BanVoter                 = types.BanVoter
@@ -122,6 +125,7 @@ type (
 	NodeMimir                = types.NodeMimir
 	NodeMimirs               = types.NodeMimirs
 	ProtocolOwnedLiquidity   = types.ProtocolOwnedLiquidity
+	SaversGrowth             = types.SaversGrowth
 
 	ProtoInt64        = types.ProtoInt64
 	ProtoUint64       = types.ProtoUint64
@@ -2,6 +2,8 @@ package keeperv1
 
 import (
 	"fmt"
+	"strconv"
+	"strings"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	crisis "github.com/cosmos/cosmos-sdk/x/crisis/types"
@@ -15,6 +17,11 @@ func (k KVStore) InvariantRoutes() []crisis.InvarRoute {
 		crisis.NewInvarRoute(ModuleName, "asgard", AsgardInvariant(k)),
 		crisis.NewInvarRoute(ModuleName, "bond", BondInvariant(k)),
 		crisis.NewInvarRoute(ModuleName, "thorchain", THORChainInvariant(k)),
+		crisis.NewInvarRoute(ModuleName, "lp_units", LPUnitsInvariant(k)),
+		crisis.NewInvarRoute(ModuleName, "synth_supply", SynthSupplyInvariant(k)),
+		crisis.NewInvarRoute(ModuleName, "loans", LoansInvariant(k)),
+		crisis.NewInvarRoute(ModuleName, "swap_queue", SwapQueueInvariant(k)),
+		crisis.NewInvarRoute(ModuleName, "order_book", OrderBookInvariant(k)),
 	}
 }
 
@@ -149,3 +156,239 @@ func THORChainInvariant(k KVStore) sdk.Invariant {
 		return msg, broken
 	}
 }
+
+// LPUnitsInvariant the units of each pool are the sum of the units of its
+// liquidity providers
+func LPUnitsInvariant(k KVStore) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		pools, err := k.GetPools(ctx)
+		if err != nil {
+			return err.Error(), true
+		}
+
+		var msg string
+		broken := false
+
+		for _, pool := range pools {
+			lpUnits := cosmos.ZeroUint()
+			iter := k.GetLiquidityProviderIterator(ctx, pool.Asset)
+			for ; iter.Valid(); iter.Next() {
+				var lp LiquidityProvider
+				if err := k.Cdc().Unmarshal(iter.Value(), &lp); err != nil {
+					broken = true
+					msg += fmt.Sprintf("%s: fail to unmarshal liquidity provider: %s\n", pool.Asset, err)
+					continue
+				}
+				if !lp.Asset.Equals(pool.Asset) {
+					continue
+				}
+				lpUnits = lpUnits.Add(lp.Units)
+			}
+			iter.Close()
+
+			if !lpUnits.Equal(pool.LPUnits) {
+				broken = true
+				msg += fmt.Sprintf("%s: pool units %s, liquidity provider units %s\n", pool.Asset, pool.LPUnits, lpUnits)
+			}
+		}
+
+		return msg, broken
+	}
+}
+
+// SynthSupplyInvariant the supply of each synth in the bank module covers the
+// synths held by its savers vault, and converts to synth units of its pool that
+// imply the same supply back. The synth units stored on the pool are only
+// recomputed on swaps, so they are recomputed here on a copy of the pool.
+func SynthSupplyInvariant(k KVStore) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		pools, err := k.GetPools(ctx)
+		if err != nil {
+			return err.Error(), true
+		}
+
+		var msg string
+		broken := false
+
+		for _, pool := range pools {
+			if !pool.Asset.IsSyntheticAsset() {
+				continue
+			}
+			synthSupply := k.GetTotalSupply(ctx, pool.Asset)
+			if synthSupply.LT(pool.BalanceAsset) {
+				broken = true
+				msg += fmt.Sprintf("%s: synth supply %s, savers vault %s\n", pool.Asset, synthSupply, pool.BalanceAsset)
+			}
+		}
+
+		for _, pool := range pools {
+			if pool.Asset.IsNative() || pool.Asset.IsVaultAsset() || pool.Asset.IsDerivedAsset() {
+				continue
+			}
+			synthSupply := k.GetTotalSupply(ctx, pool.Asset.GetSyntheticAsset())
+			p := pool
+			p.CalcUnits(k.GetVersion(), synthSupply)
+			// synth units are rounded down, so the supply falls between the
+			// supply implied by the units and by one more unit
+			low := impliedSynthSupply(p, p.SynthUnits)
+			high := impliedSynthSupply(p, p.SynthUnits.Add(cosmos.OneUint()))
+			if synthSupply.LT(low) || synthSupply.GT(high) {
+				broken = true
+				msg += fmt.Sprintf("%s: synth supply %s, synth units %s imply %s\n", pool.Asset, synthSupply, p.SynthUnits, low)
+			}
+		}
+
+		return msg, broken
+	}
+}
+
+// impliedSynthSupply returns the synth supply the given synth units of the pool
+// stand for, the inverse of synth units (L*S)/(2*A-S), that is (2*A*U)/(L+U)
+func impliedSynthSupply(pool Pool, units cosmos.Uint) cosmos.Uint {
+	denominator := pool.LPUnits.Add(units)
+	if denominator.IsZero() {
+		return cosmos.ZeroUint()
+	}
+	return pool.BalanceAsset.MulUint64(2).Mul(units).Quo(denominator)
+}
+
+// LoansInvariant the total collateral of each pool is the sum of the collateral
+// of its loans
+func LoansInvariant(k KVStore) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		pools, err := k.GetPools(ctx)
+		if err != nil {
+			return err.Error(), true
+		}
+
+		var msg string
+		broken := false
+
+		for _, pool := range pools {
+			if pool.Asset.IsNative() || pool.Asset.IsVaultAsset() || pool.Asset.IsDerivedAsset() {
+				continue
+			}
+			collateral := cosmos.ZeroUint()
+			iter := k.GetLoanIterator(ctx, pool.Asset)
+			for ; iter.Valid(); iter.Next() {
+				var loan Loan
+				if err := k.Cdc().Unmarshal(iter.Value(), &loan); err != nil {
+					broken = true
+					msg += fmt.Sprintf("%s: fail to unmarshal loan: %s\n", pool.Asset, err)
+					continue
+				}
+				if !loan.Asset.Equals(pool.Asset) {
+					continue
+				}
+				collateral = collateral.Add(loan.Collateral())
+			}
+			iter.Close()
+
+			totalCollateral, err := k.GetTotalCollateral(ctx, pool.Asset)
+			if err != nil {
+				broken = true
+				msg += fmt.Sprintf("%s: fail to get total collateral: %s\n", pool.Asset, err)
+				continue
+			}
+			if !collateral.Equal(totalCollateral) {
+				broken = true
+				msg += fmt.Sprintf("%s: total collateral %s, loan collateral %s\n", pool.Asset, totalCollateral, collateral)
+			}
+		}
+
+		return msg, broken
+	}
+}
+
+// SwapQueueInvariant each swap queue item is stored under a key made of its tx
+// id and an index, and each streaming swap record belongs to a streaming swap
+// in the queue
+func SwapQueueInvariant(k KVStore) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var msg string
+		broken := false
+
+		streaming := make(map[string]bool)
+		prefix := k.GetKey(ctx, prefixSwapQueueItem, "")
+		iter := k.GetSwapQueueIterator(ctx)
+		defer iter.Close()
+		for ; iter.Valid(); iter.Next() {
+			key := strings.TrimPrefix(string(iter.Key()), prefix)
+			var swap MsgSwap
+			if err := k.Cdc().Unmarshal(iter.Value(), &swap); err != nil {
+				broken = true
+				msg += fmt.Sprintf("%s: fail to unmarshal swap: %s\n", key, err)
+				continue
+			}
+			i := strings.LastIndex(key, "-")
+			if i < 0 || key[:i] != swap.Tx.ID.String() {
+				broken = true
+				msg += fmt.Sprintf("%s: stored under the wrong tx id (%s)\n", key, swap.Tx.ID)
+				continue
+			}
+			if _, err := strconv.Atoi(key[i+1:]); err != nil {
+				broken = true
+				msg += fmt.Sprintf("%s: invalid swap queue index\n", key)
+				continue
+			}
+			if swap.IsStreaming() {
+				streaming[swap.Tx.ID.String()] = true
+			}
+		}
+
+		// streaming swap records are only created when the first sub-swap is
+		// fetched, so a queued streaming swap may not have one yet
+		swpIter := k.GetStreamingSwapIterator(ctx)
+		defer swpIter.Close()
+		for ; swpIter.Valid(); swpIter.Next() {
+			var swp StreamingSwap
+			if err := k.Cdc().Unmarshal(swpIter.Value(), &swp); err != nil {
+				broken = true
+				msg += fmt.Sprintf("%s: fail to unmarshal streaming swap: %s\n", swpIter.Key(), err)
+				continue
+			}
+			if !streaming[swp.TxID.String()] {
+				broken = true
+				msg += fmt.Sprintf("%s: streaming swap not in swap queue\n", swp.TxID)
+			}
+		}
+
+		return msg, broken
+	}
+}
+
+// OrderBookInvariant each order book item has a matching entry in the order
+// book index, and in the expiry index when it expires
+func OrderBookInvariant(k KVStore) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var msg string
+		broken := false
+
+		iter := k.GetOrderBookItemIterator(ctx)
+		defer iter.Close()
+		for ; iter.Valid(); iter.Next() {
+			var order MsgSwap
+			if err := k.Cdc().Unmarshal(iter.Value(), &order); err != nil {
+				broken = true
+				msg += fmt.Sprintf("%s: fail to unmarshal order: %s\n", iter.Key(), err)
+				continue
+			}
+			ok, err := k.HasOrderBookIndex(ctx, order)
+			if err != nil {
+				broken = true
+				msg += fmt.Sprintf("%s: fail to get order book index: %s\n", order.Tx.ID, err)
+				continue
+			}
+			if !ok {
+				broken = true
+				msg += fmt.Sprintf("%s: missing order book index\n", order.Tx.ID)
+			}
+			if order.ExpiryHeight > 0 && !k.hasOrderBookExpiryIndex(ctx, order) {
+				broken = true
+				msg += fmt.Sprintf("%s: missing order book expiry index\n", order.Tx.ID)
+			}
+		}
+
+		return msg, broken
+	}
+}
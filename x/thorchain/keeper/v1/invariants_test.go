@@ -1,10 +1,13 @@
 package keeperv1
 
 import (
+	"fmt"
+
 	. "gopkg.in/check.v1"
 
 	"gitlab.com/thorchain/thornode/common"
 	"gitlab.com/thorchain/thornode/common/cosmos"
+	"gitlab.com/thorchain/thornode/x/thorchain/types"
 )
 
 type InvariantsSuite struct{}
@@ -138,3 +141,200 @@ func (s *InvariantsSuite) TestTHORChainInvariant(c *C) {
 	c.Assert(broken, Equals, true)
 	c.Assert(msg, Equals, "oversolvent: 1rune\n")
 }
+
+func (s *InvariantsSuite) TestLPUnitsInvariant(c *C) {
+	ctx, k := setupKeeperForTest(c)
+
+	pool := NewPool()
+	pool.Asset = common.BTCAsset
+	pool.LPUnits = cosmos.NewUint(300)
+	c.Assert(k.SetPool(ctx, pool), IsNil)
+
+	lp := LiquidityProvider{
+		Asset:        common.BTCAsset,
+		RuneAddress:  GetRandomTHORAddress(),
+		AssetAddress: GetRandomBTCAddress(),
+		Units:        cosmos.NewUint(100),
+	}
+	k.SetLiquidityProvider(ctx, lp)
+
+	invariant := LPUnitsInvariant(k)
+
+	msg, broken := invariant(ctx)
+	c.Assert(broken, Equals, true)
+	c.Assert(msg, Equals, "BTC.BTC: pool units 300, liquidity provider units 100\n")
+
+	lp.RuneAddress = GetRandomTHORAddress()
+	lp.Units = cosmos.NewUint(200)
+	k.SetLiquidityProvider(ctx, lp)
+
+	msg, broken = invariant(ctx)
+	c.Assert(broken, Equals, false)
+	c.Assert(msg, Equals, "")
+}
+
+func (s *InvariantsSuite) TestSynthSupplyInvariant(c *C) {
+	ctx, k := setupKeeperForTest(c)
+
+	pool := NewPool()
+	pool.Asset = common.BTCAsset
+	pool.BalanceAsset = cosmos.NewUint(1000)
+	pool.LPUnits = cosmos.NewUint(1000)
+	c.Assert(k.SetPool(ctx, pool), IsNil)
+
+	invariant := SynthSupplyInvariant(k)
+
+	msg, broken := invariant(ctx)
+	c.Assert(broken, Equals, false)
+	c.Assert(msg, Equals, "")
+
+	// the synth units stored on the pool are stale, they are only updated on swaps
+	coin := common.NewCoin(common.BTCAsset.GetSyntheticAsset(), cosmos.NewUint(500))
+	c.Assert(k.MintToModule(ctx, ModuleName, coin), IsNil)
+	c.Assert(k.SendFromModuleToModule(ctx, ModuleName, AsgardName, common.NewCoins(coin)), IsNil)
+
+	msg, broken = invariant(ctx)
+	c.Assert(broken, Equals, false)
+	c.Assert(msg, Equals, "")
+
+	// the savers vault can't hold more synths than were minted
+	vault := NewPool()
+	vault.Asset = common.BTCAsset.GetSyntheticAsset()
+	vault.BalanceAsset = cosmos.NewUint(600)
+	c.Assert(k.SetPool(ctx, vault), IsNil)
+
+	msg, broken = invariant(ctx)
+	c.Assert(broken, Equals, true)
+	c.Assert(msg, Equals, "BTC/BTC: synth supply 500, savers vault 600\n")
+
+	vault.BalanceAsset = cosmos.NewUint(500)
+	c.Assert(k.SetPool(ctx, vault), IsNil)
+
+	msg, broken = invariant(ctx)
+	c.Assert(broken, Equals, false)
+	c.Assert(msg, Equals, "")
+
+	// a supply close to twice the pool depth still converts back
+	pool.BalanceAsset = cosmos.NewUint(251)
+	c.Assert(k.SetPool(ctx, pool), IsNil)
+
+	msg, broken = invariant(ctx)
+	c.Assert(broken, Equals, false)
+	c.Assert(msg, Equals, "")
+
+	// the synth supply must be backed by the pool
+	pool.BalanceAsset = cosmos.NewUint(250)
+	c.Assert(k.SetPool(ctx, pool), IsNil)
+
+	msg, broken = invariant(ctx)
+	c.Assert(broken, Equals, true)
+	c.Assert(msg, Equals, "BTC.BTC: synth supply 500, synth units 500000 imply 499\n")
+}
+
+func (s *InvariantsSuite) TestLoansInvariant(c *C) {
+	ctx, k := setupKeeperForTest(c)
+
+	pool := NewPool()
+	pool.Asset = common.BTCAsset
+	c.Assert(k.SetPool(ctx, pool), IsNil)
+
+	loan := NewLoan(GetRandomBTCAddress(), common.BTCAsset, 10)
+	loan.CollateralUp = cosmos.NewUint(1000)
+	loan.CollateralDown = cosmos.NewUint(400)
+	k.SetLoan(ctx, loan)
+
+	// closed loans don't count towards the total
+	loan = NewLoan(GetRandomBTCAddress(), common.BTCAsset, 10)
+	loan.CollateralUp = cosmos.NewUint(500)
+	loan.CollateralDown = cosmos.NewUint(500)
+	k.SetLoan(ctx, loan)
+
+	k.SetTotalCollateral(ctx, common.BTCAsset, cosmos.NewUint(1000))
+
+	invariant := LoansInvariant(k)
+
+	msg, broken := invariant(ctx)
+	c.Assert(broken, Equals, true)
+	c.Assert(msg, Equals, "BTC.BTC: total collateral 1000, loan collateral 600\n")
+
+	k.SetTotalCollateral(ctx, common.BTCAsset, cosmos.NewUint(600))
+
+	msg, broken = invariant(ctx)
+	c.Assert(broken, Equals, false)
+	c.Assert(msg, Equals, "")
+}
+
+func (s *InvariantsSuite) TestSwapQueueInvariant(c *C) {
+	ctx, k := setupKeeperForTest(c)
+
+	swapMsg := MsgSwap{
+		Tx:             GetRandomTx(),
+		StreamInterval: 1,
+		StreamQuantity: 2,
+	}
+	c.Assert(k.SetSwapQueueItem(ctx, swapMsg, 0), IsNil)
+
+	invariant := SwapQueueInvariant(k)
+
+	// the streaming swap record is created when the first sub-swap is fetched
+	msg, broken := invariant(ctx)
+	c.Assert(broken, Equals, false)
+	c.Assert(msg, Equals, "")
+
+	k.SetStreamingSwap(ctx, NewStreamingSwap(swapMsg.Tx.ID, 2, 1, cosmos.ZeroUint(), cosmos.NewUint(100)))
+
+	msg, broken = invariant(ctx)
+	c.Assert(broken, Equals, false)
+	c.Assert(msg, Equals, "")
+
+	// streaming swap records without a queued swap
+	orphan := GetRandomTxHash()
+	k.SetStreamingSwap(ctx, NewStreamingSwap(orphan, 2, 1, cosmos.ZeroUint(), cosmos.NewUint(100)))
+
+	msg, broken = invariant(ctx)
+	c.Assert(broken, Equals, true)
+	c.Assert(msg, Equals, fmt.Sprintf("%s: streaming swap not in swap queue\n", orphan))
+	k.RemoveStreamingSwap(ctx, orphan)
+
+	// items stored under a key they can't be looked up by
+	other := MsgSwap{Tx: GetRandomTx()}
+	k.setMsgSwap(ctx, k.GetKey(ctx, prefixSwapQueueItem, other.Tx.ID.String()+"-X"), other)
+
+	msg, broken = invariant(ctx)
+	c.Assert(broken, Equals, true)
+	c.Assert(msg, Equals, fmt.Sprintf("%s-X: invalid swap queue index\n", other.Tx.ID))
+	k.del(ctx, k.GetKey(ctx, prefixSwapQueueItem, other.Tx.ID.String()+"-X"))
+
+	k.setMsgSwap(ctx, k.GetKey(ctx, prefixSwapQueueItem, swapMsg.Tx.ID.String()+"-1"), other)
+
+	msg, broken = invariant(ctx)
+	c.Assert(broken, Equals, true)
+	c.Assert(msg, Equals, fmt.Sprintf("%s-1: stored under the wrong tx id (%s)\n", swapMsg.Tx.ID, other.Tx.ID))
+}
+
+func (s *InvariantsSuite) TestOrderBookInvariant(c *C) {
+	ctx, k := setupKeeperForTest(c)
+
+	order := MsgSwap{
+		Tx:           GetRandomTx(),
+		TargetAsset:  common.BTCAsset,
+		TradeTarget:  cosmos.NewUint(100),
+		OrderType:    types.OrderType_limit,
+		ExpiryHeight: 100,
+	}
+	order.Tx.Coins = common.NewCoins(common.NewCoin(common.RuneAsset(), cosmos.NewUint(1000)))
+	c.Assert(k.SetOrderBookItem(ctx, order), IsNil)
+
+	invariant := OrderBookInvariant(k)
+
+	msg, broken := invariant(ctx)
+	c.Assert(broken, Equals, false)
+	c.Assert(msg, Equals, "")
+
+	c.Assert(k.RemoveOrderBookIndex(ctx, order), IsNil)
+	c.Assert(k.removeOrderBookExpiryIndex(ctx, order), IsNil)
+
+	msg, broken = invariant(ctx)
+	c.Assert(broken, Equals, true)
+	c.Assert(msg, Equals, fmt.Sprintf("%s: missing order book index\n%s: missing order book expiry index\n", order.Tx.ID, order.Tx.ID))
+}
@@ -29,6 +29,7 @@ const (
 	prefixObservedLink            types.DbPrefix = "ob_link/"
 	prefixPool                    types.DbPrefix = "pool/"
 	prefixPoolLUVI                types.DbPrefix = "luvi/"
+	prefixSaversGrowth            types.DbPrefix = "savers_growth/"
 	prefixTxOut                   types.DbPrefix = "txout/"
 	prefixTotalLiquidityFee       types.DbPrefix = "total_liquidity_fee/"
 	prefixPoolLiquidityFee        types.DbPrefix = "pool_liquidity_fee/"
@@ -43,6 +44,7 @@ const (
 	prefixVaultAsgardIndex        types.DbPrefix = "vault_asgard_index/"
 	prefixNetwork                 types.DbPrefix = "network/"
 	prefixPOL                     types.DbPrefix = "pol/"
+	prefixPOLPool                 types.DbPrefix = "pol_pool/"
 	prefixLoan                    types.DbPrefix = "loan/"
 	prefixLoanTotalCollateral     types.DbPrefix = "loan_col_total/"
 	prefixObservingAddresses      types.DbPrefix = "observing_addresses/"
@@ -59,10 +61,12 @@ const (
 	prefixNodeSlashPoints         types.DbPrefix = "slash/"
 	prefixNodeJail                types.DbPrefix = "jail/"
 	prefixSwapQueueItem           types.DbPrefix = "swapitem/"
+	prefixStreamingSwap           types.DbPrefix = "stream/"
 	prefixOrderBookItem           types.DbPrefix = "o/"
 	prefixOrderBookLimitIndex     types.DbPrefix = "olim/"
 	prefixOrderBookMarketIndex    types.DbPrefix = "omark/"
 	prefixOrderBookProcessor      types.DbPrefix = "oproc/"
+	prefixOrderBookExpiryIndex    types.DbPrefix = "oexp/"
 	prefixMimir                   types.DbPrefix = "mimir/"
 	prefixMinJoinLast             types.DbPrefix = "minjoinlast/"
 	prefixNodeMimir               types.DbPrefix = "nodemimir/"
@@ -75,6 +79,8 @@ const (
 	prefixChainContract           types.DbPrefix = "chain_contract/"
 	prefixSolvencyVoter           types.DbPrefix = "solvency_voter/"
 	prefixTHORName                types.DbPrefix = "thorname/"
+	prefixTHORNameReverse         types.DbPrefix = "thorname_rev/"
+	prefixAffiliateCollector      types.DbPrefix = "affiliate_collector/"
 	prefixRollingPoolLiquidityFee types.DbPrefix = "rolling_pool_liquidity_fee/"
 	prefixVersion                 types.DbPrefix = "version/"
 )
@@ -154,6 +160,13 @@ func (k KVStore) getIterator(ctx cosmos.Context, prefix types.DbPrefix) cosmos.I
 	return cosmos.KVStorePrefixIterator(store, []byte(prefix))
 }
 
+// getIteratorFrom iterates the keys with the given prefix, starting at the
+// given key, so pages of a list don't have to walk the keys before them
+func (k KVStore) getIteratorFrom(ctx cosmos.Context, prefix types.DbPrefix, start []byte) cosmos.Iterator {
+	store := ctx.KVStore(k.storeKey)
+	return store.Iterator(start, cosmos.PrefixEndBytes([]byte(prefix)))
+}
+
 // del - delete data from the kvstore
 func (k KVStore) del(ctx cosmos.Context, key string) {
 	store := ctx.KVStore(k.storeKey)
@@ -39,6 +39,17 @@ func (k KVStore) GetLiquidityProviderIterator(ctx cosmos.Context, asset common.A
 	return k.getIterator(ctx, types.DbPrefix(key))
 }
 
+// GetLiquidityProviderIteratorAfter iterate liquidity providers, starting
+// after the liquidity provider of the given address
+func (k KVStore) GetLiquidityProviderIteratorAfter(ctx cosmos.Context, asset common.Asset, addr string) cosmos.Iterator {
+	if addr == "" {
+		return k.GetLiquidityProviderIterator(ctx, asset)
+	}
+	key := k.GetKey(ctx, prefixLiquidityProvider, (&LiquidityProvider{Asset: asset}).Key())
+	start := k.GetKey(ctx, prefixLiquidityProvider, fmt.Sprintf("%s/%s", asset, addr))
+	return k.getIteratorFrom(ctx, types.DbPrefix(key), []byte(start+"\x00"))
+}
+
 func (k KVStore) GetTotalSupply(ctx cosmos.Context, asset common.Asset) cosmos.Uint {
 	if k.GetVersion().GTE(semver.MustParse("1.91.0")) {
 		// when pool ragnarok started , synth unit become zero
@@ -37,6 +37,17 @@ func (k KVStore) GetLoanIterator(ctx cosmos.Context, asset common.Asset) cosmos.
 	return k.getIterator(ctx, types.DbPrefix(key))
 }
 
+// GetLoanIteratorAfter iterate loans, starting after the loan of the given
+// owner
+func (k KVStore) GetLoanIteratorAfter(ctx cosmos.Context, asset common.Asset, owner string) cosmos.Iterator {
+	if owner == "" {
+		return k.GetLoanIterator(ctx, asset)
+	}
+	key := k.GetKey(ctx, prefixLoan, asset.String())
+	start := k.GetKey(ctx, prefixLoan, fmt.Sprintf("%s/%s", asset, owner))
+	return k.getIteratorFrom(ctx, types.DbPrefix(key), []byte(start+"\x00"))
+}
+
 // GetLoan retrieve loan from the data store
 func (k KVStore) GetLoan(ctx cosmos.Context, asset common.Asset, addr common.Address) (Loan, error) {
 	record := NewLoan(addr, asset, 0)
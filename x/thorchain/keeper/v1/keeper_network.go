@@ -3,6 +3,7 @@ package keeperv1
 import (
 	"fmt"
 
+	"gitlab.com/thorchain/thornode/common"
 	"gitlab.com/thorchain/thornode/common/cosmos"
 )
 
@@ -77,3 +78,16 @@ func (k KVStore) SetPOL(ctx cosmos.Context, data ProtocolOwnedLiquidity) error {
 	k.setPOL(ctx, k.GetKey(ctx, prefixPOL, ""), data)
 	return nil
 }
+
+// GetPOLPool retrieve the rune deposited and withdrawn by the protocol owned liquidity in the given pool
+func (k KVStore) GetPOLPool(ctx cosmos.Context, asset common.Asset) (ProtocolOwnedLiquidity, error) {
+	record := NewProtocolOwnedLiquidity()
+	_, err := k.getPOL(ctx, k.GetKey(ctx, prefixPOLPool, asset.String()), &record)
+	return record, err
+}
+
+// SetPOLPool save the rune deposited and withdrawn by the protocol owned liquidity in the given pool
+func (k KVStore) SetPOLPool(ctx cosmos.Context, asset common.Asset, data ProtocolOwnedLiquidity) error {
+	k.setPOL(ctx, k.GetKey(ctx, prefixPOLPool, asset.String()), data)
+	return nil
+}
@@ -41,3 +41,27 @@ func (KeeperNetworkSuite) TestPOL(c *C) {
 	c.Check(err2, IsNil)
 	c.Check(pol2.RuneDeposited.Uint64(), Equals, uint64(100*common.One))
 }
+
+func (KeeperNetworkSuite) TestPOLPool(c *C) {
+	ctx, k := setupKeeperForTest(c)
+	pol, err := k.GetPOLPool(ctx, common.BTCAsset)
+	c.Check(err, IsNil)
+	c.Check(pol.RuneDeposited.IsZero(), Equals, true)
+
+	pol.RuneDeposited = cosmos.NewUint(common.One * 100)
+	pol.RuneWithdrawn = cosmos.NewUint(common.One * 20)
+	c.Assert(k.SetPOLPool(ctx, common.BTCAsset, pol), IsNil)
+
+	pol2, err := k.GetPOLPool(ctx, common.BTCAsset)
+	c.Check(err, IsNil)
+	c.Check(pol2.RuneDeposited.Uint64(), Equals, uint64(100*common.One))
+	c.Check(pol2.RuneWithdrawn.Uint64(), Equals, uint64(20*common.One))
+
+	// pools are tracked separately, and apart from the network total
+	pol3, err := k.GetPOLPool(ctx, common.ETHAsset)
+	c.Check(err, IsNil)
+	c.Check(pol3.RuneDeposited.IsZero(), Equals, true)
+	total, err := k.GetPOL(ctx)
+	c.Check(err, IsNil)
+	c.Check(total.RuneDeposited.IsZero(), Equals, true)
+}
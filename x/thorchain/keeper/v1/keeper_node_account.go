@@ -236,6 +236,16 @@ func (k KVStore) GetNodeAccountIterator(ctx cosmos.Context) cosmos.Iterator {
 	return k.getIterator(ctx, prefixNodeAccount)
 }
 
+// GetNodeAccountIteratorAfter iterate node account, starting after the node
+// account of the given address
+func (k KVStore) GetNodeAccountIteratorAfter(ctx cosmos.Context, addr string) cosmos.Iterator {
+	if addr == "" {
+		return k.GetNodeAccountIterator(ctx)
+	}
+	start := k.GetKey(ctx, prefixNodeAccount, addr)
+	return k.getIteratorFrom(ctx, prefixNodeAccount, []byte(start+"\x00"))
+}
+
 // GetNodeAccountSlashPoints - get the slash points associated with the given
 // node address
 func (k KVStore) GetNodeAccountSlashPoints(ctx cosmos.Context, addr cosmos.AccAddress) (int64, error) {
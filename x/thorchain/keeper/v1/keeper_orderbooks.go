@@ -43,6 +43,9 @@ func (k KVStore) SetOrderBookItem(ctx cosmos.Context, msg MsgSwap) error {
 	if err := k.SetOrderBookIndex(ctx, msg); err != nil {
 		return err
 	}
+	if err := k.setOrderBookExpiryIndex(ctx, msg); err != nil {
+		return err
+	}
 	k.setMsgSwap(ctx, k.GetKey(ctx, prefixOrderBookItem, msg.Tx.ID.String()), msg)
 	return nil
 }
@@ -76,6 +79,9 @@ func (k KVStore) RemoveOrderBookItem(ctx cosmos.Context, txID common.TxID) error
 		_ = dbError(ctx, "failed to fetch order book item", err)
 	} else {
 		err = k.RemoveOrderBookIndex(ctx, msg)
+		if err == nil {
+			err = k.removeOrderBookExpiryIndex(ctx, msg)
+		}
 	}
 	k.del(ctx, k.GetKey(ctx, prefixOrderBookItem, txID.String()))
 	return err
@@ -103,6 +109,80 @@ func (k KVStore) GetOrderBookProcessor(ctx cosmos.Context) ([]bool, error) {
 
 ///----------------------------------------------------------------------///
 
+///---------------------- Order Book Expiry Index -----------------------///
+// The Order Book Expiry Index tracks the order book items that carry an
+// expiry height, keyed by that height (zero padded, so the kvstore iterates
+// over them in ascending height order).
+
+// GetOrderBookExpiryIterator iterate order book expiry index, in ascending
+// order of expiry height
+func (k KVStore) GetOrderBookExpiryIterator(ctx cosmos.Context) cosmos.Iterator {
+	return k.getIterator(ctx, prefixOrderBookExpiryIndex)
+}
+
+func (k KVStore) getOrderBookExpiryIndexKey(ctx cosmos.Context, height int64) string {
+	return k.GetKey(ctx, prefixOrderBookExpiryIndex, fmt.Sprintf("%020d", height))
+}
+
+func (k KVStore) setOrderBookExpiryIndex(ctx cosmos.Context, msg MsgSwap) error {
+	if msg.ExpiryHeight <= 0 {
+		return nil
+	}
+	key := k.getOrderBookExpiryIndexKey(ctx, msg.ExpiryHeight)
+	record := make([]string, 0)
+	_, err := k.getStrings(ctx, key, &record)
+	if err != nil {
+		return err
+	}
+	for _, rec := range record {
+		if strings.EqualFold(rec, msg.Tx.ID.String()) {
+			return nil
+		}
+	}
+	record = append(record, msg.Tx.ID.String())
+	k.setStrings(ctx, key, record)
+	return nil
+}
+
+func (k KVStore) hasOrderBookExpiryIndex(ctx cosmos.Context, msg MsgSwap) bool {
+	record := make([]string, 0)
+	if _, err := k.getStrings(ctx, k.getOrderBookExpiryIndexKey(ctx, msg.ExpiryHeight), &record); err != nil {
+		return false
+	}
+	for _, rec := range record {
+		if strings.EqualFold(rec, msg.Tx.ID.String()) {
+			return true
+		}
+	}
+	return false
+}
+
+func (k KVStore) removeOrderBookExpiryIndex(ctx cosmos.Context, msg MsgSwap) error {
+	if msg.ExpiryHeight <= 0 {
+		return nil
+	}
+	key := k.getOrderBookExpiryIndexKey(ctx, msg.ExpiryHeight)
+	record := make([]string, 0)
+	_, err := k.getStrings(ctx, key, &record)
+	if err != nil {
+		return err
+	}
+	for i, rec := range record {
+		if strings.EqualFold(rec, msg.Tx.ID.String()) {
+			record = append(record[:i], record[i+1:]...)
+			break
+		}
+	}
+	if len(record) == 0 {
+		k.del(ctx, key)
+		return nil
+	}
+	k.setStrings(ctx, key, record)
+	return nil
+}
+
+///----------------------------------------------------------------------///
+
 ///-------------------------- Order Book Index --------------------------///
 
 // SetOrderBookIndex - writes a order book index to the kv store
@@ -70,6 +70,59 @@ func (s *KeeperOrderBookSuite) TestKeeperOrderBook(c *C) {
 	c.Check(ok, Equals, false)
 }
 
+func (s *KeeperOrderBookSuite) TestOrderBookExpiryIndex(c *C) {
+	ctx, k := setupKeeperForTest(c)
+
+	msg1 := MsgSwap{
+		Tx:           GetRandomTx(),
+		TradeTarget:  cosmos.NewUint(10 * common.One),
+		OrderType:    types.OrderType_limit,
+		ExpiryHeight: 200,
+	}
+	msg2 := MsgSwap{
+		Tx:           GetRandomTx(),
+		TradeTarget:  cosmos.NewUint(10 * common.One),
+		OrderType:    types.OrderType_limit,
+		ExpiryHeight: 100,
+	}
+	msg3 := MsgSwap{
+		Tx:          GetRandomTx(),
+		TradeTarget: cosmos.NewUint(10 * common.One),
+		OrderType:   types.OrderType_limit,
+	}
+	c.Assert(k.SetOrderBookItem(ctx, msg1), IsNil)
+	c.Assert(k.SetOrderBookItem(ctx, msg1), IsNil) // no duplicates
+	c.Assert(k.SetOrderBookItem(ctx, msg2), IsNil)
+	c.Assert(k.SetOrderBookItem(ctx, msg3), IsNil)
+
+	// iterates in ascending order of expiry height, orders without an
+	// expiry are not indexed
+	expected := []common.TxID{msg2.Tx.ID, msg1.Tx.ID}
+	i := 0
+	iter := k.GetOrderBookExpiryIterator(ctx)
+	for ; iter.Valid(); iter.Next() {
+		hashes := make([]string, 0)
+		ok, err := k.getStrings(ctx, string(iter.Key()), &hashes)
+		c.Assert(err, IsNil)
+		c.Check(ok, Equals, true)
+		c.Assert(hashes, HasLen, 1)
+		c.Assert(i < len(expected), Equals, true)
+		c.Check(hashes[0], Equals, expected[i].String())
+		i++
+	}
+	iter.Close()
+	c.Check(i, Equals, 2)
+
+	c.Assert(k.RemoveOrderBookItem(ctx, msg2.Tx.ID), IsNil)
+	iter = k.GetOrderBookExpiryIterator(ctx)
+	i = 0
+	for ; iter.Valid(); iter.Next() {
+		i++
+	}
+	iter.Close()
+	c.Check(i, Equals, 1)
+}
+
 func (s *KeeperOrderBookSuite) TestGetOrderBookIndexKey(c *C) {
 	ctx, k := setupKeeperForTest(c)
 	msg := MsgSwap{
@@ -89,3 +89,39 @@ func (k KVStore) GetPoolLUVI(ctx cosmos.Context, asset common.Asset) (cosmos.Uin
 	_, err := k.getUint(ctx, key, &record)
 	return record, err
 }
+
+func (k KVStore) setSaversGrowth(ctx cosmos.Context, key string, record SaversGrowth) {
+	store := ctx.KVStore(k.storeKey)
+	buf := k.cdc.MustMarshal(&record)
+	if buf == nil {
+		store.Delete([]byte(key))
+	} else {
+		store.Set([]byte(key), buf)
+	}
+}
+
+func (k KVStore) getSaversGrowth(ctx cosmos.Context, key string, record *SaversGrowth) (bool, error) {
+	store := ctx.KVStore(k.storeKey)
+	if !store.Has([]byte(key)) {
+		return false, nil
+	}
+
+	bz := store.Get([]byte(key))
+	if err := k.cdc.Unmarshal(bz, record); err != nil {
+		return true, dbError(ctx, fmt.Sprintf("Unmarshal kvstore: (%T) %s", record, key), err)
+	}
+	return true, nil
+}
+
+// SetSaversGrowth save the yield tracking of the savers pool of the given asset
+func (k KVStore) SetSaversGrowth(ctx cosmos.Context, asset common.Asset, growth SaversGrowth) {
+	k.setSaversGrowth(ctx, k.GetKey(ctx, prefixSaversGrowth, asset.String()), growth)
+}
+
+// GetSaversGrowth retrieve the yield tracking of the savers pool of the given
+// asset, an empty record is returned when the pool hasn't been sampled yet
+func (k KVStore) GetSaversGrowth(ctx cosmos.Context, asset common.Asset) (SaversGrowth, error) {
+	record := NewSaversGrowth()
+	_, err := k.getSaversGrowth(ctx, k.GetKey(ctx, prefixSaversGrowth, asset.String()), &record)
+	return record, err
+}
@@ -45,3 +45,24 @@ func (s *KeeperPoolSuite) TestPoolLUVI(c *C) {
 	c.Assert(err, IsNil)
 	c.Assert(luvi.Uint64(), Equals, luvi2.Uint64())
 }
+
+func (s *KeeperPoolSuite) TestSaversGrowth(c *C) {
+	ctx, k := setupKeeperForTest(c)
+	asset := common.BTCAsset.GetSyntheticAsset()
+
+	growth, err := k.GetSaversGrowth(ctx, asset)
+	c.Assert(err, IsNil)
+	c.Check(growth.LastHeight, Equals, int64(0))
+	c.Check(growth.Apr.IsZero(), Equals, true)
+
+	growth.Update(10, cosmos.NewUint(1_000_000_000_000), 100, 10)
+	growth.Update(20, cosmos.NewUint(1_001_000_000_000), 100, 10)
+	k.SetSaversGrowth(ctx, asset, growth)
+
+	growth2, err := k.GetSaversGrowth(ctx, asset)
+	c.Assert(err, IsNil)
+	c.Check(growth2.LastHeight, Equals, int64(20))
+	c.Check(growth2.LastValuePerUnit.Equal(growth.LastValuePerUnit), Equals, true)
+	c.Check(growth2.Samples, Equals, int64(1))
+	c.Check(growth2.AprBps(), Equals, int64(100))
+}
@@ -0,0 +1,60 @@
+package keeperv1
+
+import (
+	"fmt"
+
+	"gitlab.com/thorchain/thornode/common"
+	"gitlab.com/thorchain/thornode/common/cosmos"
+)
+
+func (k KVStore) setStreamingSwap(ctx cosmos.Context, key string, record StreamingSwap) {
+	store := ctx.KVStore(k.storeKey)
+	buf := k.cdc.MustMarshal(&record)
+	if buf == nil {
+		store.Delete([]byte(key))
+	} else {
+		store.Set([]byte(key), buf)
+	}
+}
+
+func (k KVStore) getStreamingSwap(ctx cosmos.Context, key string, record *StreamingSwap) (bool, error) {
+	store := ctx.KVStore(k.storeKey)
+	if !store.Has([]byte(key)) {
+		return false, nil
+	}
+
+	bz := store.Get([]byte(key))
+	if err := k.cdc.Unmarshal(bz, record); err != nil {
+		return true, dbError(ctx, fmt.Sprintf("Unmarshal kvstore: (%T) %s", record, key), err)
+	}
+	return true, nil
+}
+
+// GetStreamingSwapIterator iterate streaming swaps
+func (k KVStore) GetStreamingSwapIterator(ctx cosmos.Context) cosmos.Iterator {
+	return k.getIterator(ctx, prefixStreamingSwap)
+}
+
+// GetStreamingSwap retrieve streaming swap from the data store
+func (k KVStore) GetStreamingSwap(ctx cosmos.Context, hash common.TxID) (StreamingSwap, error) {
+	record := NewStreamingSwap(hash, 0, 0, cosmos.ZeroUint(), cosmos.ZeroUint())
+	_, err := k.getStreamingSwap(ctx, k.GetKey(ctx, prefixStreamingSwap, hash.String()), &record)
+	return record, err
+}
+
+// HasStreamingSwap - check whether the given streaming swap exists
+func (k KVStore) HasStreamingSwap(ctx cosmos.Context, hash common.TxID) bool {
+	record := StreamingSwap{}
+	ok, _ := k.getStreamingSwap(ctx, k.GetKey(ctx, prefixStreamingSwap, hash.String()), &record)
+	return ok
+}
+
+// SetStreamingSwap save the streaming swap to kv store
+func (k KVStore) SetStreamingSwap(ctx cosmos.Context, swp StreamingSwap) {
+	k.setStreamingSwap(ctx, k.GetKey(ctx, prefixStreamingSwap, swp.TxID.String()), swp)
+}
+
+// RemoveStreamingSwap remove the streaming swap from kv store
+func (k KVStore) RemoveStreamingSwap(ctx cosmos.Context, hash common.TxID) {
+	k.del(ctx, k.GetKey(ctx, prefixStreamingSwap, hash.String()))
+}
@@ -0,0 +1,42 @@
+package keeperv1
+
+import (
+	"gitlab.com/thorchain/thornode/common/cosmos"
+	. "gopkg.in/check.v1"
+)
+
+type KeeperStreamingSwapSuite struct{}
+
+var _ = Suite(&KeeperStreamingSwapSuite{})
+
+func (s *KeeperStreamingSwapSuite) TestStreamingSwap(c *C) {
+	ctx, k := setupKeeperForTest(c)
+
+	hash := GetRandomTxHash()
+	c.Check(k.HasStreamingSwap(ctx, hash), Equals, false)
+	swp, err := k.GetStreamingSwap(ctx, hash)
+	c.Assert(err, IsNil)
+	c.Check(swp.TxID.Equals(hash), Equals, true)
+	c.Check(swp.Deposit.IsZero(), Equals, true)
+
+	swp = NewStreamingSwap(hash, 10, 3, cosmos.NewUint(500), cosmos.NewUint(1000))
+	swp.Count = 2
+	swp.LastHeight = 18
+	k.SetStreamingSwap(ctx, swp)
+	c.Check(k.HasStreamingSwap(ctx, hash), Equals, true)
+
+	swp, err = k.GetStreamingSwap(ctx, hash)
+	c.Assert(err, IsNil)
+	c.Check(swp.Quantity, Equals, uint64(10))
+	c.Check(swp.Interval, Equals, uint64(3))
+	c.Check(swp.Count, Equals, uint64(2))
+	c.Check(swp.LastHeight, Equals, int64(18))
+	c.Check(swp.Deposit.Uint64(), Equals, uint64(1000))
+
+	iter := k.GetStreamingSwapIterator(ctx)
+	c.Check(iter.Valid(), Equals, true)
+	iter.Close()
+
+	k.RemoveStreamingSwap(ctx, hash)
+	c.Check(k.HasStreamingSwap(ctx, hash), Equals, false)
+}
@@ -42,6 +42,16 @@ func (k KVStore) GetSwapQueueIterator(ctx cosmos.Context) cosmos.Iterator {
 	return k.getIterator(ctx, prefixSwapQueueItem)
 }
 
+// GetSwapQueueIteratorAfter iterate swap queue, starting after every swap queue
+// item of the given tx id
+func (k KVStore) GetSwapQueueIteratorAfter(ctx cosmos.Context, txID string) cosmos.Iterator {
+	if txID == "" {
+		return k.GetSwapQueueIterator(ctx)
+	}
+	start := cosmos.PrefixEndBytes([]byte(k.GetKey(ctx, prefixSwapQueueItem, txID+"-")))
+	return k.getIteratorFrom(ctx, prefixSwapQueueItem, start)
+}
+
 // GetSwapQueueItem - write the given swap queue item information to key values tore
 func (k KVStore) GetSwapQueueItem(ctx cosmos.Context, txID common.TxID, i int) (MsgSwap, error) {
 	record := MsgSwap{}
@@ -1,7 +1,11 @@
 package keeperv1
 
 import (
+	"strings"
+
 	. "gopkg.in/check.v1"
+
+	"gitlab.com/thorchain/thornode/common"
 )
 
 type KeeperSwapQueueSuite struct{}
@@ -32,3 +36,37 @@ func (s *KeeperSwapQueueSuite) TestKeeperSwapQueue(c *C) {
 	_, err = k.GetSwapQueueItem(ctx, msg.Tx.ID, 0)
 	c.Check(err, NotNil)
 }
+
+func (s *KeeperSwapQueueSuite) TestGetSwapQueueIteratorAfter(c *C) {
+	ctx, k := setupKeeperForTest(c)
+
+	txIDs := []string{
+		"A000000000000000000000000000000000000000000000000000000000000000",
+		"B000000000000000000000000000000000000000000000000000000000000000",
+		"C000000000000000000000000000000000000000000000000000000000000000",
+	}
+	for _, id := range txIDs {
+		msg := MsgSwap{Tx: GetRandomTx()}
+		msg.Tx.ID = common.TxID(id)
+		c.Assert(k.SetSwapQueueItem(ctx, msg, 0), IsNil)
+		c.Assert(k.SetSwapQueueItem(ctx, msg, 1), IsNil)
+	}
+
+	list := func(cursor string) []string {
+		ids := make([]string, 0)
+		iter := k.GetSwapQueueIteratorAfter(ctx, cursor)
+		defer iter.Close()
+		for ; iter.Valid(); iter.Next() {
+			var msg MsgSwap
+			c.Assert(k.Cdc().Unmarshal(iter.Value(), &msg), IsNil)
+			ids = append(ids, msg.Tx.ID.String())
+		}
+		return ids
+	}
+
+	c.Check(list(""), HasLen, 6)
+	// every item of the cursor tx is skipped, the cursor is case insensitive
+	c.Check(list(txIDs[0]), DeepEquals, []string{txIDs[1], txIDs[1], txIDs[2], txIDs[2]})
+	c.Check(list(strings.ToLower(txIDs[1])), DeepEquals, []string{txIDs[2], txIDs[2]})
+	c.Check(list(txIDs[2]), HasLen, 0)
+}
@@ -2,7 +2,11 @@ package keeperv1
 
 import (
 	"fmt"
+	"strings"
 
+	"github.com/blang/semver"
+
+	"gitlab.com/thorchain/thornode/common"
 	"gitlab.com/thorchain/thornode/common/cosmos"
 )
 
@@ -37,7 +41,22 @@ func (k KVStore) GetTHORNameIterator(ctx cosmos.Context) cosmos.Iterator {
 
 // SetTHORName save the THORName object to store
 func (k KVStore) SetTHORName(ctx cosmos.Context, name THORName) {
-	k.setTHORName(ctx, k.GetKey(ctx, prefixTHORName, name.Key()), name)
+	key := k.GetKey(ctx, prefixTHORName, name.Key())
+	if k.GetVersion().GTE(semver.MustParse("1.114.0")) {
+		// keep the reverse index in line with the aliases
+		var current THORName
+		if ok, _ := k.getTHORName(ctx, key, &current); ok {
+			for _, alias := range current.Aliases {
+				if !name.GetAlias(alias.Chain).Equals(alias.Address) {
+					k.removeTHORNameReverse(ctx, alias.Address, name.Name)
+				}
+			}
+		}
+		for _, alias := range name.Aliases {
+			k.addTHORNameReverse(ctx, alias.Address, name.Name)
+		}
+	}
+	k.setTHORName(ctx, key, name)
 }
 
 // THORNameExists check whether the given name exists
@@ -67,9 +86,94 @@ func (k KVStore) GetTHORName(ctx cosmos.Context, name string) (THORName, error)
 	return record, err
 }
 
+// GetTHORNameRecord get THORName with the given name from data store, as it
+// was stored, whether it has expired or not
+func (k KVStore) GetTHORNameRecord(ctx cosmos.Context, name string) (THORName, error) {
+	record := THORName{
+		Name: name,
+	}
+	ok, err := k.getTHORName(ctx, k.GetKey(ctx, prefixTHORName, record.Key()), &record)
+	if !ok {
+		return record, fmt.Errorf("THORName doesn't exist: %s", name)
+	}
+	return record, err
+}
+
 // DeleteTHORName remove the given THORName from data store
 func (k KVStore) DeleteTHORName(ctx cosmos.Context, name string) error {
 	n := THORName{Name: name}
-	k.del(ctx, k.GetKey(ctx, prefixTHORName, n.Key()))
+	key := k.GetKey(ctx, prefixTHORName, n.Key())
+	if k.GetVersion().GTE(semver.MustParse("1.114.0")) {
+		if ok, _ := k.getTHORName(ctx, key, &n); ok {
+			for _, alias := range n.Aliases {
+				k.removeTHORNameReverse(ctx, alias.Address, name)
+			}
+		}
+	}
+	k.del(ctx, key)
 	return nil
 }
+
+// GetTHORNamesByAddress returns the names of the THORNames that have an alias
+// to the given address. The index isn't cleaned up when a THORName expires, so
+// the caller should check the THORName is still valid.
+func (k KVStore) GetTHORNamesByAddress(ctx cosmos.Context, addr common.Address) ([]string, error) {
+	names := make([]string, 0)
+	_, err := k.getStrings(ctx, k.GetKey(ctx, prefixTHORNameReverse, addr.String()), &names)
+	return names, err
+}
+
+// GetAffiliateCollectorRune returns the RUNE accrued from affiliate fees by
+// the given THORName, held in the affiliate collector module until paid out
+func (k KVStore) GetAffiliateCollectorRune(ctx cosmos.Context, name string) (cosmos.Uint, error) {
+	record := cosmos.ZeroUint()
+	_, err := k.getUint(ctx, k.GetKey(ctx, prefixAffiliateCollector, name), &record)
+	return record, err
+}
+
+// SetAffiliateCollectorRune save the RUNE accrued from affiliate fees by the
+// given THORName
+func (k KVStore) SetAffiliateCollectorRune(ctx cosmos.Context, name string, amt cosmos.Uint) {
+	key := k.GetKey(ctx, prefixAffiliateCollector, name)
+	if amt.IsZero() {
+		k.del(ctx, key)
+		return
+	}
+	k.setUint(ctx, key, amt)
+}
+
+func (k KVStore) addTHORNameReverse(ctx cosmos.Context, addr common.Address, name string) {
+	name = strings.ToLower(name)
+	names, err := k.GetTHORNamesByAddress(ctx, addr)
+	if err != nil {
+		ctx.Logger().Error("fail to get THORNames by address", "address", addr, "error", err)
+	}
+	for _, n := range names {
+		if n == name {
+			return
+		}
+	}
+	names = append(names, name)
+	k.setStrings(ctx, k.GetKey(ctx, prefixTHORNameReverse, addr.String()), names)
+}
+
+func (k KVStore) removeTHORNameReverse(ctx cosmos.Context, addr common.Address, name string) {
+	name = strings.ToLower(name)
+	names, err := k.GetTHORNamesByAddress(ctx, addr)
+	if err != nil {
+		ctx.Logger().Error("fail to get THORNames by address", "address", addr, "error", err)
+		return
+	}
+	key := k.GetKey(ctx, prefixTHORNameReverse, addr.String())
+	remaining := make([]string, 0, len(names))
+	for _, n := range names {
+		if n != name {
+			remaining = append(remaining, n)
+		}
+	}
+	if len(remaining) == 0 {
+		k.del(ctx, key)
+		return
+	}
+	k.setStrings(ctx, key, remaining)
+}
@@ -2,6 +2,7 @@ package keeperv1
 
 import (
 	"gitlab.com/thorchain/thornode/common"
+	"gitlab.com/thorchain/thornode/common/cosmos"
 	. "gopkg.in/check.v1"
 )
 
@@ -32,3 +33,63 @@ func (s *KeeperTHORNameSuite) TestTHORName(c *C) {
 	c.Assert(name.GetAlias(common.THORChain).Equals(thorAddr), Equals, true)
 	c.Assert(name.GetAlias(common.BNBChain).Equals(bnbAddr), Equals, true)
 }
+
+func (s *KeeperTHORNameSuite) TestTHORNameReverse(c *C) {
+	ctx, k := setupKeeperForTest(c)
+
+	thorAddr := GetRandomTHORAddress()
+	bnbAddr := GetRandomBNBAddress()
+	name := NewTHORName("Alice", 50, []THORNameAlias{{Chain: common.THORChain, Address: thorAddr}, {Chain: common.BNBChain, Address: bnbAddr}})
+	k.SetTHORName(ctx, name)
+	k.SetTHORName(ctx, NewTHORName("bob", 50, []THORNameAlias{{Chain: common.THORChain, Address: thorAddr}}))
+
+	names, err := k.GetTHORNamesByAddress(ctx, thorAddr)
+	c.Assert(err, IsNil)
+	c.Check(names, DeepEquals, []string{"alice", "bob"})
+	names, err = k.GetTHORNamesByAddress(ctx, bnbAddr)
+	c.Assert(err, IsNil)
+	c.Check(names, DeepEquals, []string{"alice"})
+
+	// setting the same aliases again doesn't duplicate the index
+	k.SetTHORName(ctx, name)
+	names, err = k.GetTHORNamesByAddress(ctx, thorAddr)
+	c.Assert(err, IsNil)
+	c.Check(names, DeepEquals, []string{"alice", "bob"})
+
+	// updating an alias drops the old address
+	newBNBAddr := GetRandomBNBAddress()
+	name.SetAlias(common.BNBChain, newBNBAddr)
+	k.SetTHORName(ctx, name)
+	names, err = k.GetTHORNamesByAddress(ctx, bnbAddr)
+	c.Assert(err, IsNil)
+	c.Check(names, HasLen, 0)
+	names, err = k.GetTHORNamesByAddress(ctx, newBNBAddr)
+	c.Assert(err, IsNil)
+	c.Check(names, DeepEquals, []string{"alice"})
+
+	c.Assert(k.DeleteTHORName(ctx, "alice"), IsNil)
+	names, err = k.GetTHORNamesByAddress(ctx, thorAddr)
+	c.Assert(err, IsNil)
+	c.Check(names, DeepEquals, []string{"bob"})
+	names, err = k.GetTHORNamesByAddress(ctx, newBNBAddr)
+	c.Assert(err, IsNil)
+	c.Check(names, HasLen, 0)
+}
+
+func (s *KeeperTHORNameSuite) TestAffiliateCollectorRune(c *C) {
+	ctx, k := setupKeeperForTest(c)
+
+	amt, err := k.GetAffiliateCollectorRune(ctx, "alice")
+	c.Assert(err, IsNil)
+	c.Check(amt.IsZero(), Equals, true)
+
+	k.SetAffiliateCollectorRune(ctx, "alice", cosmos.NewUint(100))
+	amt, err = k.GetAffiliateCollectorRune(ctx, "alice")
+	c.Assert(err, IsNil)
+	c.Check(amt.Uint64(), Equals, uint64(100))
+
+	k.SetAffiliateCollectorRune(ctx, "alice", cosmos.ZeroUint())
+	amt, err = k.GetAffiliateCollectorRune(ctx, "alice")
+	c.Assert(err, IsNil)
+	c.Check(amt.IsZero(), Equals, true)
+}
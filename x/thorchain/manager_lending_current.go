@@ -0,0 +1,325 @@
+package thorchain
+
+import (
+	"crypto/sha256"
+	"fmt"
+
+	"gitlab.com/thorchain/thornode/common"
+	"gitlab.com/thorchain/thornode/common/cosmos"
+	"gitlab.com/thorchain/thornode/constants"
+	"gitlab.com/thorchain/thornode/x/thorchain/keeper"
+)
+
+// LendingMgrV114 is going to manage the health of loans
+type LendingMgrV114 struct {
+	k        keeper.Keeper
+	eventMgr EventManager
+}
+
+// newLendingMgrV114 create a new instance of LendingMgrV114
+func newLendingMgrV114(k keeper.Keeper, eventMgr EventManager) *LendingMgrV114 {
+	return &LendingMgrV114{
+		k:        k,
+		eventMgr: eventMgr,
+	}
+}
+
+// EndBlock liquidate the loans whose collateralization ratio has dropped below
+// the liquidation threshold
+func (lm *LendingMgrV114) EndBlock(ctx cosmos.Context, mgr Manager) error {
+	liquidationCR := lm.k.GetConfigInt64(ctx, constants.LoanLiquidationCR)
+	if liquidationCR <= 0 {
+		return nil
+	}
+	closeFactor := lm.k.GetConfigInt64(ctx, constants.LoanLiquidationCloseFactor)
+	penalty := lm.k.GetConfigInt64(ctx, constants.LoanLiquidationPenalty)
+	if closeFactor <= 0 || closeFactor > 10_000 {
+		closeFactor = 10_000
+	}
+	if penalty < 0 {
+		penalty = 0
+	}
+
+	price := lm.k.DollarsPerRune(ctx)
+	if price.IsZero() {
+		return fmt.Errorf("TOR price cannot be zero")
+	}
+
+	pools, err := lm.k.GetPools(ctx)
+	if err != nil {
+		return fmt.Errorf("fail to get pools: %w", err)
+	}
+	for _, pool := range pools {
+		if pool.Status != PoolAvailable || pool.Asset.IsDerivedAsset() || pool.Asset.IsVaultAsset() {
+			continue
+		}
+		if pool.BalanceRune.IsZero() || pool.BalanceAsset.IsZero() {
+			continue
+		}
+
+		// collect the loans first, so the store isn't written to while iterating
+		loans := make(Loans, 0)
+		iter := lm.k.GetLoanIterator(ctx, pool.Asset)
+		for ; iter.Valid(); iter.Next() {
+			var loan Loan
+			if err := lm.k.Cdc().Unmarshal(iter.Value(), &loan); err != nil {
+				ctx.Logger().Error("fail to unmarshal loan", "error", err)
+				continue
+			}
+			loans = append(loans, loan)
+		}
+		iter.Close()
+
+		for _, loan := range loans {
+			// a failed liquidation (ie the seized collateral can't be swapped
+			// to RUNE) leaves the loan untouched, it is retried next block
+			cacheCtx, commit := ctx.CacheContext()
+			if err := lm.liquidate(cacheCtx, mgr, pool, pools, loan, price, liquidationCR, closeFactor, penalty); err != nil {
+				ctx.Logger().Error("fail to liquidate loan", "owner", loan.Owner, "asset", loan.Asset, "error", err)
+				continue
+			}
+			commit()
+			ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+		}
+	}
+	return nil
+}
+
+// liquidate clears a portion (the close factor) of the debt of the given loan
+// if it is undercollateralized. The reserve takes collateral worth the cleared
+// debt, plus the liquidation penalty, in exchange. When the collateral left
+// can't cover that, the reserve takes all of it and the debt is written off.
+// The seized collateral is a derived asset, which is swapped to RUNE for the
+// reserve.
+func (lm *LendingMgrV114) liquidate(ctx cosmos.Context, mgr Manager, pool Pool, pools Pools, loan Loan, price cosmos.Uint, liquidationCR, closeFactor, penalty int64) error {
+	if loan.Debt().IsZero() || loan.Collateral().IsZero() {
+		return nil
+	}
+
+	// the health of the loan includes the interest owed, which is only
+	// written to the loan when it gets liquidated
+	interest, err := lm.accruedInterest(ctx, loan, pools)
+	if err != nil {
+		return err
+	}
+	debt := loan.Debt().Add(interest)
+	collateral := loan.Collateral()
+
+	collateralValueInTOR := pool.AssetValueInRune(collateral).Mul(price).QuoUint64(common.One)
+	cr := collateralValueInTOR.MulUint64(10_000).Quo(debt)
+	if cr.GTE(cosmos.NewUint(uint64(liquidationCR))) {
+		return nil
+	}
+
+	loan, err = lm.accrueInterest(ctx, loan, pools)
+	if err != nil {
+		return err
+	}
+
+	debtDown := common.GetSafeShare(cosmos.NewUint(uint64(closeFactor)), cosmos.NewUint(10_000), debt)
+	seizeValueInTOR := common.GetUncappedShare(cosmos.NewUint(uint64(10_000+penalty)), cosmos.NewUint(10_000), debtDown)
+	collateralDown := common.GetSafeShare(seizeValueInTOR, collateralValueInTOR, collateral)
+	if debtDown.IsZero() || collateralDown.IsZero() || seizeValueInTOR.GTE(collateralValueInTOR) {
+		debtDown = debt
+		collateralDown = collateral
+	}
+
+	totalCollateral, err := lm.k.GetTotalCollateral(ctx, pool.Asset)
+	if err != nil {
+		return err
+	}
+
+	if err := lm.swapCollateralToReserve(ctx, mgr, loan, collateralDown); err != nil {
+		return err
+	}
+
+	ctx.Logger().Info("liquidate loan", "owner", loan.Owner, "asset", loan.Asset, "cr", cr.Uint64(), "collateral", collateralDown.Uint64(), "debt", debtDown.Uint64())
+
+	loan.CollateralDown = loan.CollateralDown.Add(collateralDown)
+	loan.DebtDown = loan.DebtDown.Add(debtDown)
+	loan.LastLiquidationHeight = ctx.BlockHeight()
+	lm.k.SetLoan(ctx, loan)
+	lm.k.SetTotalCollateral(ctx, pool.Asset, common.SafeSub(totalCollateral, collateralDown))
+
+	evt := NewEventLoanLiquidation(collateralDown, debtDown, cr, pool.Asset, loan.Owner)
+	if err := lm.eventMgr.EmitEvent(ctx, evt); err != nil {
+		ctx.Logger().Error("fail to emit loan liquidation event", "error", err)
+	}
+	return nil
+}
+
+// swapCollateralToReserve swaps the seized derived asset collateral of the
+// given loan to RUNE, paid out to the reserve
+func (lm *LendingMgrV114) swapCollateralToReserve(ctx cosmos.Context, mgr Manager, loan Loan, amount cosmos.Uint) error {
+	lendAddr, err := lm.k.GetModuleAddress(LendingName)
+	if err != nil {
+		return fmt.Errorf("fail to get lending address: %w", err)
+	}
+	asgardAddr, err := lm.k.GetModuleAddress(AsgardName)
+	if err != nil {
+		return fmt.Errorf("fail to get asgard address: %w", err)
+	}
+	reserveAddr, err := lm.k.GetModuleAddress(ReserveName)
+	if err != nil {
+		return fmt.Errorf("fail to get reserve address: %w", err)
+	}
+
+	// liquidations have no inbound tx, derive a unique id from the loan
+	hash := sha256.Sum256([]byte(fmt.Sprintf("liquidation:%s:%s:%d", loan.Asset, loan.Owner, ctx.BlockHeight())))
+	txID, err := common.NewTxID(fmt.Sprintf("%X", hash))
+	if err != nil {
+		return fmt.Errorf("fail to get liquidation tx id: %w", err)
+	}
+
+	// transfer derived asset from the lending to asgard before swap to RUNE
+	coins := common.NewCoins(common.NewCoin(loan.Asset.GetDerivedAsset(), amount))
+	if err := lm.k.SendFromModuleToModule(ctx, LendingName, AsgardName, coins); err != nil {
+		return fmt.Errorf("fail to send collateral to asgard: %w", err)
+	}
+
+	signer := lm.k.GetModuleAccAddress(ReserveName)
+	tx := common.NewTx(txID, lendAddr, asgardAddr, coins, nil, "noop")
+	swapMsg := NewMsgSwap(tx, common.RuneAsset(), reserveAddr, cosmos.ZeroUint(), common.NoAddress, cosmos.ZeroUint(), "", "", nil, 0, signer)
+	if _, err := NewSwapHandler(mgr).Run(ctx, swapMsg); err != nil {
+		return fmt.Errorf("fail to swap collateral to RUNE: %w", err)
+	}
+	return nil
+}
+
+// InterestRate returns the yearly interest rate (in basis points) charged on
+// the debt of loans backed by the given collateral asset. The rate grows
+// linearly with the utilization of the lending capacity of the pool, from the
+// base rate when no collateral is deposited, to the base rate plus the slope
+// when the pool is at capacity.
+func (lm *LendingMgrV114) InterestRate(ctx cosmos.Context, asset common.Asset) (cosmos.Uint, error) {
+	pools, err := lm.k.GetPools(ctx)
+	if err != nil {
+		return cosmos.ZeroUint(), fmt.Errorf("fail to get pools: %w", err)
+	}
+	return lm.interestRate(ctx, asset, pools)
+}
+
+func (lm *LendingMgrV114) interestRate(ctx cosmos.Context, asset common.Asset, pools Pools) (cosmos.Uint, error) {
+	baseRate := lm.k.GetConfigInt64(ctx, constants.LoanInterestBaseRate)
+	slope := lm.k.GetConfigInt64(ctx, constants.LoanInterestSlope)
+	if baseRate < 0 {
+		baseRate = 0
+	}
+	if slope <= 0 {
+		return cosmos.NewUint(uint64(baseRate)), nil
+	}
+
+	utilization, err := lm.getUtilization(ctx, asset, pools)
+	if err != nil {
+		return cosmos.ZeroUint(), err
+	}
+	rate := common.GetSafeShare(utilization, cosmos.NewUint(10_000), cosmos.NewUint(uint64(slope)))
+	return rate.AddUint64(uint64(baseRate)), nil
+}
+
+// AccruedInterest returns the interest owed on the debt of the given loan
+// since it was last touched. Loans that haven't been touched since interest
+// was introduced start accruing on their next touch.
+func (lm *LendingMgrV114) AccruedInterest(ctx cosmos.Context, loan Loan) (cosmos.Uint, error) {
+	pools, err := lm.k.GetPools(ctx)
+	if err != nil {
+		return cosmos.ZeroUint(), fmt.Errorf("fail to get pools: %w", err)
+	}
+	return lm.accruedInterest(ctx, loan, pools)
+}
+
+func (lm *LendingMgrV114) accruedInterest(ctx cosmos.Context, loan Loan, pools Pools) (cosmos.Uint, error) {
+	if loan.LastInterestHeight <= 0 || loan.LastInterestHeight >= ctx.BlockHeight() || loan.Debt().IsZero() {
+		return cosmos.ZeroUint(), nil
+	}
+	rate, err := lm.interestRate(ctx, loan.Asset, pools)
+	if err != nil {
+		return cosmos.ZeroUint(), err
+	}
+	blocksPerYear := lm.k.GetConfigInt64(ctx, constants.BlocksPerYear)
+	if rate.IsZero() || blocksPerYear <= 0 {
+		return cosmos.ZeroUint(), nil
+	}
+	blocks := ctx.BlockHeight() - loan.LastInterestHeight
+	return loan.Debt().Mul(rate).MulUint64(uint64(blocks)).QuoUint64(10_000).QuoUint64(uint64(blocksPerYear)), nil
+}
+
+// AccrueInterest adds the interest owed on the given loan to its debt, and
+// returns the updated loan for the caller to save. Interest is paid in TOR
+// along with the rest of the debt, and burned on repayment.
+func (lm *LendingMgrV114) AccrueInterest(ctx cosmos.Context, loan Loan) (Loan, error) {
+	pools, err := lm.k.GetPools(ctx)
+	if err != nil {
+		return loan, fmt.Errorf("fail to get pools: %w", err)
+	}
+	return lm.accrueInterest(ctx, loan, pools)
+}
+
+func (lm *LendingMgrV114) accrueInterest(ctx cosmos.Context, loan Loan, pools Pools) (Loan, error) {
+	interest, err := lm.accruedInterest(ctx, loan, pools)
+	if err != nil {
+		return loan, err
+	}
+	blocks := ctx.BlockHeight() - loan.LastInterestHeight
+	loan.LastInterestHeight = ctx.BlockHeight()
+	if interest.IsZero() {
+		return loan, nil
+	}
+
+	rate, err := lm.interestRate(ctx, loan.Asset, pools)
+	if err != nil {
+		return loan, err
+	}
+	loan.DebtUp = loan.DebtUp.Add(interest)
+
+	evt := NewEventLoanInterest(interest, rate, blocks, loan.Asset, loan.Owner)
+	if err := lm.eventMgr.EmitEvent(ctx, evt); err != nil {
+		ctx.Logger().Error("fail to emit loan interest event", "error", err)
+	}
+	return loan, nil
+}
+
+// getUtilization returns the share (in basis points) of the lending capacity
+// of the given pool that is backing loans. The capacity follows the lending
+// lever, as on loan open. The pools are passed in so they are only loaded
+// once when going through every loan.
+func (lm *LendingMgrV114) getUtilization(ctx cosmos.Context, asset common.Asset, pools Pools) (cosmos.Uint, error) {
+	totalCollateral, err := lm.k.GetTotalCollateral(ctx, asset)
+	if err != nil {
+		return cosmos.ZeroUint(), err
+	}
+	if totalCollateral.IsZero() {
+		return cosmos.ZeroUint(), nil
+	}
+	pool, ok := pools.Get(asset)
+	if !ok {
+		return cosmos.NewUint(10_000), nil
+	}
+
+	totalRune := cosmos.ZeroUint()
+	for _, p := range pools {
+		if p.Status == PoolSuspended || p.Asset.IsVaultAsset() || p.Asset.IsDerivedAsset() {
+			continue
+		}
+		val, err := lm.k.GetMimir(ctx, "LENDING-"+p.Asset.GetDerivedAsset().MimirString())
+		if err != nil || val <= 0 {
+			continue
+		}
+		totalRune = totalRune.Add(p.BalanceRune)
+	}
+
+	lever := lm.k.GetConfigInt64(ctx, constants.LendingLever)
+	maxRuneSupply := lm.k.GetConfigInt64(ctx, constants.MaxRuneSupply)
+	if maxRuneSupply <= 0 || lever <= 0 {
+		return cosmos.NewUint(10_000), nil
+	}
+	supply := lm.k.GetTotalSupply(ctx, common.RuneAsset())
+	runeBurnt := common.SafeSub(cosmos.NewUint(uint64(maxRuneSupply)), supply)
+	totalAvailableRuneForProtocol := common.GetSafeShare(cosmos.NewUint(uint64(lever)), cosmos.NewUint(10_000), runeBurnt)
+	totalAvailableRuneForPool := common.GetSafeShare(pool.BalanceRune, totalRune, totalAvailableRuneForProtocol)
+	totalAvailableAssetForPool := pool.RuneValueInAsset(totalAvailableRuneForPool)
+	if totalAvailableAssetForPool.IsZero() {
+		return cosmos.NewUint(10_000), nil
+	}
+	return common.GetSafeShare(totalCollateral, totalAvailableAssetForPool, cosmos.NewUint(10_000)), nil
+}
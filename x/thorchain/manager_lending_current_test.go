@@ -0,0 +1,183 @@
+package thorchain
+
+import (
+	. "gopkg.in/check.v1"
+
+	"gitlab.com/thorchain/thornode/common"
+	"gitlab.com/thorchain/thornode/common/cosmos"
+	"gitlab.com/thorchain/thornode/constants"
+)
+
+type LendingMgrV114Suite struct{}
+
+var _ = Suite(&LendingMgrV114Suite{})
+
+func (s *LendingMgrV114Suite) TestLiquidation(c *C) {
+	ctx, mgr := setupManagerForTest(c)
+	ctx = ctx.WithBlockHeight(100)
+	lm := newLendingMgrV114(mgr.Keeper(), mgr.EventMgr())
+
+	// 1 BTC == 20,000 RUNE == $20,000
+	pool := NewPool()
+	pool.Asset = common.BTCAsset
+	pool.Status = PoolAvailable
+	pool.BalanceAsset = cosmos.NewUint(common.One)
+	pool.BalanceRune = cosmos.NewUint(20_000 * common.One)
+	c.Assert(mgr.Keeper().SetPool(ctx, pool), IsNil)
+	mgr.Keeper().SetMimir(ctx, "DollarsPerRune", common.One)
+	// seized collateral is swapped to RUNE through the derived pool
+	mgr.Keeper().SetMimir(ctx, "DerivedDepthBasisPts", 10_000)
+	pool.Asset = common.BTCAsset.GetDerivedAsset()
+	c.Assert(mgr.Keeper().SetPool(ctx, pool), IsNil)
+
+	newLoan := func(collateral, debt uint64) Loan {
+		loan := NewLoan(GetRandomBTCAddress(), common.BTCAsset, 10)
+		loan.CollateralUp = cosmos.NewUint(collateral)
+		loan.DebtUp = cosmos.NewUint(debt)
+		mgr.Keeper().SetLoan(ctx, loan)
+		return loan
+	}
+	underwater := newLoan(10_000_000, 2500*common.One) // $2000 collateral, CR 80%
+	healthy := newLoan(10_000_000, 1000*common.One)    // $2000 collateral, CR 200%
+	insolvent := newLoan(1_000_000, 500*common.One)    // $200 collateral, CR 40%
+
+	total := cosmos.NewUint(21_000_000)
+	mgr.Keeper().SetTotalCollateral(ctx, common.BTCAsset, total)
+	coin := common.NewCoin(common.BTCAsset.GetDerivedAsset(), total)
+	c.Assert(mgr.Keeper().MintToModule(ctx, ModuleName, coin), IsNil)
+	c.Assert(mgr.Keeper().SendFromModuleToModule(ctx, ModuleName, LendingName, common.NewCoins(coin)), IsNil)
+
+	reserveRune := mgr.Keeper().GetRuneBalanceOfModule(ctx, ReserveName)
+
+	// liquidations are disabled by default
+	c.Assert(lm.EndBlock(ctx, mgr), IsNil)
+	loan, err := mgr.Keeper().GetLoan(ctx, common.BTCAsset, underwater.Owner)
+	c.Assert(err, IsNil)
+	c.Check(loan.CollateralDown.IsZero(), Equals, true)
+
+	mgr.Keeper().SetMimir(ctx, constants.LoanLiquidationCR.String(), 11_000)
+	mgr.Keeper().SetMimir(ctx, constants.LoanLiquidationCloseFactor.String(), 5000)
+	mgr.Keeper().SetMimir(ctx, constants.LoanLiquidationPenalty.String(), 500)
+	c.Assert(lm.EndBlock(ctx, mgr), IsNil)
+
+	// half the debt is cleared, the reserve takes collateral worth the
+	// cleared debt plus the 5% penalty
+	loan, err = mgr.Keeper().GetLoan(ctx, common.BTCAsset, underwater.Owner)
+	c.Assert(err, IsNil)
+	c.Check(loan.DebtDown.Uint64(), Equals, uint64(1250*common.One))
+	c.Check(loan.CollateralDown.Uint64(), Equals, uint64(6_562_500))
+	c.Check(loan.LastLiquidationHeight, Equals, int64(100))
+
+	loan, err = mgr.Keeper().GetLoan(ctx, common.BTCAsset, healthy.Owner)
+	c.Assert(err, IsNil)
+	c.Check(loan.DebtDown.IsZero(), Equals, true)
+	c.Check(loan.CollateralDown.IsZero(), Equals, true)
+	c.Check(loan.LastLiquidationHeight, Equals, int64(0))
+
+	// not enough collateral to cover a partial liquidation, all of it is
+	// seized and the debt is written off
+	loan, err = mgr.Keeper().GetLoan(ctx, common.BTCAsset, insolvent.Owner)
+	c.Assert(err, IsNil)
+	c.Check(loan.Debt().IsZero(), Equals, true)
+	c.Check(loan.Collateral().IsZero(), Equals, true)
+
+	// the seized collateral is swapped to RUNE for the reserve, none of the
+	// derived asset is left in the reserve
+	seized := uint64(6_562_500 + 1_000_000)
+	lending := mgr.Keeper().GetBalanceOfModule(ctx, LendingName, common.BTCAsset.GetDerivedAsset().Native())
+	c.Check(lending.Uint64(), Equals, total.Uint64()-seized)
+	reserve := mgr.Keeper().GetBalanceOfModule(ctx, ReserveName, common.BTCAsset.GetDerivedAsset().Native())
+	c.Check(reserve.IsZero(), Equals, true)
+	c.Check(mgr.Keeper().GetRuneBalanceOfModule(ctx, ReserveName).GT(reserveRune), Equals, true)
+	totalCollateral, err := mgr.Keeper().GetTotalCollateral(ctx, common.BTCAsset)
+	c.Assert(err, IsNil)
+	c.Check(totalCollateral.Uint64(), Equals, total.Uint64()-seized)
+}
+
+func (s *LendingMgrV114Suite) TestLiquidationSwapFail(c *C) {
+	ctx, mgr := setupManagerForTest(c)
+	ctx = ctx.WithBlockHeight(100)
+	lm := newLendingMgrV114(mgr.Keeper(), mgr.EventMgr())
+
+	// no derived pool, the seized collateral can't be swapped to RUNE
+	pool := NewPool()
+	pool.Asset = common.BTCAsset
+	pool.Status = PoolAvailable
+	pool.BalanceAsset = cosmos.NewUint(common.One)
+	pool.BalanceRune = cosmos.NewUint(20_000 * common.One)
+	c.Assert(mgr.Keeper().SetPool(ctx, pool), IsNil)
+	mgr.Keeper().SetMimir(ctx, "DollarsPerRune", common.One)
+	mgr.Keeper().SetMimir(ctx, constants.LoanLiquidationCR.String(), 11_000)
+
+	loan := NewLoan(GetRandomBTCAddress(), common.BTCAsset, 10)
+	loan.CollateralUp = cosmos.NewUint(10_000_000)
+	loan.DebtUp = cosmos.NewUint(2500 * common.One)
+	mgr.Keeper().SetLoan(ctx, loan)
+	mgr.Keeper().SetTotalCollateral(ctx, common.BTCAsset, loan.CollateralUp)
+	coin := common.NewCoin(common.BTCAsset.GetDerivedAsset(), loan.CollateralUp)
+	c.Assert(mgr.Keeper().MintToModule(ctx, ModuleName, coin), IsNil)
+	c.Assert(mgr.Keeper().SendFromModuleToModule(ctx, ModuleName, LendingName, common.NewCoins(coin)), IsNil)
+
+	// the liquidation is rolled back, and retried on a later block
+	c.Assert(lm.EndBlock(ctx, mgr), IsNil)
+	loan, err := mgr.Keeper().GetLoan(ctx, common.BTCAsset, loan.Owner)
+	c.Assert(err, IsNil)
+	c.Check(loan.CollateralDown.IsZero(), Equals, true)
+	c.Check(loan.DebtDown.IsZero(), Equals, true)
+	lending := mgr.Keeper().GetBalanceOfModule(ctx, LendingName, common.BTCAsset.GetDerivedAsset().Native())
+	c.Check(lending.Uint64(), Equals, loan.CollateralUp.Uint64())
+}
+
+func (s *LendingMgrV114Suite) TestInterest(c *C) {
+	ctx, mgr := setupManagerForTest(c)
+	ctx = ctx.WithBlockHeight(1100)
+	lm := newLendingMgrV114(mgr.Keeper(), mgr.EventMgr())
+
+	loan := NewLoan(GetRandomBTCAddress(), common.BTCAsset, 10)
+	loan.CollateralUp = cosmos.NewUint(10_000_000)
+	loan.DebtUp = cosmos.NewUint(1000 * common.One)
+
+	// no interest is charged by default
+	rate, err := lm.InterestRate(ctx, common.BTCAsset)
+	c.Assert(err, IsNil)
+	c.Check(rate.IsZero(), Equals, true)
+
+	mgr.Keeper().SetMimir(ctx, constants.LoanInterestBaseRate.String(), 500)
+	mgr.Keeper().SetMimir(ctx, constants.BlocksPerYear.String(), 10_000)
+	rate, err = lm.InterestRate(ctx, common.BTCAsset)
+	c.Assert(err, IsNil)
+	c.Check(rate.Uint64(), Equals, uint64(500))
+
+	// loans that were never touched start accruing on their next touch
+	interest, err := lm.AccruedInterest(ctx, loan)
+	c.Assert(err, IsNil)
+	c.Check(interest.IsZero(), Equals, true)
+	loan, err = lm.AccrueInterest(ctx, loan)
+	c.Assert(err, IsNil)
+	c.Check(loan.LastInterestHeight, Equals, int64(1100))
+	c.Check(loan.DebtUp.Uint64(), Equals, uint64(1000*common.One))
+
+	// 5% a year over a tenth of a year
+	ctx = ctx.WithBlockHeight(2100)
+	interest, err = lm.AccruedInterest(ctx, loan)
+	c.Assert(err, IsNil)
+	c.Check(interest.Uint64(), Equals, uint64(5*common.One))
+	loan, err = lm.AccrueInterest(ctx, loan)
+	c.Assert(err, IsNil)
+	c.Check(loan.LastInterestHeight, Equals, int64(2100))
+	c.Check(loan.DebtUp.Uint64(), Equals, uint64(1005*common.One))
+	c.Check(loan.Debt().Uint64(), Equals, uint64(1005*common.One))
+
+	// nothing more is owed in the same block
+	interest, err = lm.AccruedInterest(ctx, loan)
+	c.Assert(err, IsNil)
+	c.Check(interest.IsZero(), Equals, true)
+
+	// the slope applies on top of the base rate as the pool fills up
+	mgr.Keeper().SetMimir(ctx, constants.LoanInterestSlope.String(), 1000)
+	mgr.Keeper().SetTotalCollateral(ctx, common.BTCAsset, cosmos.NewUint(common.One))
+	rate, err = lm.InterestRate(ctx, common.BTCAsset)
+	c.Assert(err, IsNil)
+	c.Check(rate.GTE(cosmos.NewUint(500)), Equals, true)
+	c.Check(rate.LTE(cosmos.NewUint(1500)), Equals, true)
+}
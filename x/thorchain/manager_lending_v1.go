@@ -0,0 +1,35 @@
+package thorchain
+
+import (
+	"gitlab.com/thorchain/thornode/common"
+	"gitlab.com/thorchain/thornode/common/cosmos"
+)
+
+// LendingMgrV1 is the lending manager before loans were liquidated or
+// charged interest
+type LendingMgrV1 struct{}
+
+// newLendingMgrV1 create a new instance of LendingMgrV1
+func newLendingMgrV1() *LendingMgrV1 {
+	return &LendingMgrV1{}
+}
+
+// EndBlock does nothing, loans are never liquidated
+func (lm *LendingMgrV1) EndBlock(ctx cosmos.Context, mgr Manager) error {
+	return nil
+}
+
+// InterestRate always returns zero, loans don't pay interest
+func (lm *LendingMgrV1) InterestRate(ctx cosmos.Context, asset common.Asset) (cosmos.Uint, error) {
+	return cosmos.ZeroUint(), nil
+}
+
+// AccruedInterest always returns zero, loans don't pay interest
+func (lm *LendingMgrV1) AccruedInterest(ctx cosmos.Context, loan Loan) (cosmos.Uint, error) {
+	return cosmos.ZeroUint(), nil
+}
+
+// AccrueInterest returns the loan unchanged, loans don't pay interest
+func (lm *LendingMgrV1) AccrueInterest(ctx cosmos.Context, loan Loan) (Loan, error) {
+	return loan, nil
+}
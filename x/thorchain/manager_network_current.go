@@ -12,23 +12,23 @@ import (
 	"gitlab.com/thorchain/thornode/x/thorchain/keeper"
 )
 
-// NetworkMgrV112 is going to manage the vaults
-type NetworkMgrV112 struct {
+// NetworkMgrV114 is going to manage the vaults
+type NetworkMgrV114 struct {
 	k          keeper.Keeper
 	txOutStore TxOutStore
 	eventMgr   EventManager
 }
 
-// newNetworkMgrV112 create a new vault manager
-func newNetworkMgrV112(k keeper.Keeper, txOutStore TxOutStore, eventMgr EventManager) *NetworkMgrV112 {
-	return &NetworkMgrV112{
+// newNetworkMgrV114 create a new vault manager
+func newNetworkMgrV114(k keeper.Keeper, txOutStore TxOutStore, eventMgr EventManager) *NetworkMgrV114 {
+	return &NetworkMgrV114{
 		k:          k,
 		txOutStore: txOutStore,
 		eventMgr:   eventMgr,
 	}
 }
 
-func (vm *NetworkMgrV112) processGenesisSetup(ctx cosmos.Context) error {
+func (vm *NetworkMgrV114) processGenesisSetup(ctx cosmos.Context) error {
 	if ctx.BlockHeight() != genesisBlockHeight {
 		return nil
 	}
@@ -76,11 +76,11 @@ func (vm *NetworkMgrV112) processGenesisSetup(ctx cosmos.Context) error {
 	return nil
 }
 
-func (vm *NetworkMgrV112) BeginBlock(ctx cosmos.Context, mgr Manager) error {
+func (vm *NetworkMgrV114) BeginBlock(ctx cosmos.Context, mgr Manager) error {
 	return vm.spawnDerivedAssets(ctx, mgr)
 }
 
-func (vm *NetworkMgrV112) suspendVirtualPool(ctx cosmos.Context, mgr Manager, derivedAsset common.Asset, suspendReasonErr error) {
+func (vm *NetworkMgrV114) suspendVirtualPool(ctx cosmos.Context, mgr Manager, derivedAsset common.Asset, suspendReasonErr error) {
 	// Ensure that derivedAsset is indeed a derived asset.
 	derivedAsset = derivedAsset.GetDerivedAsset()
 
@@ -114,7 +114,7 @@ func (vm *NetworkMgrV112) suspendVirtualPool(ctx cosmos.Context, mgr Manager, de
 	}
 }
 
-func (vm *NetworkMgrV112) calcAnchor(ctx cosmos.Context, mgr Manager, asset common.Asset) (cosmos.Uint, cosmos.Uint, cosmos.Uint) {
+func (vm *NetworkMgrV114) calcAnchor(ctx cosmos.Context, mgr Manager, asset common.Asset) (cosmos.Uint, cosmos.Uint, cosmos.Uint) {
 	anchors := mgr.Keeper().GetAnchors(ctx, asset)
 
 	maxAnchorBlocks := mgr.Keeper().GetConfigInt64(ctx, constants.MaxAnchorBlocks)
@@ -161,7 +161,7 @@ func (vm *NetworkMgrV112) calcAnchor(ctx cosmos.Context, mgr Manager, asset comm
 	return totalRuneDepth, price, slippage
 }
 
-func (vm *NetworkMgrV112) spawnDerivedAssets(ctx cosmos.Context, mgr Manager) error {
+func (vm *NetworkMgrV114) spawnDerivedAssets(ctx cosmos.Context, mgr Manager) error {
 	active, err := mgr.Keeper().GetAsgardVaultsByStatus(ctx, ActiveVault)
 	if err != nil {
 		return err
@@ -190,7 +190,7 @@ func (vm *NetworkMgrV112) spawnDerivedAssets(ctx cosmos.Context, mgr Manager) er
 	return nil
 }
 
-func (vm *NetworkMgrV112) SpawnDerivedAsset(ctx cosmos.Context, asset common.Asset, mgr Manager) {
+func (vm *NetworkMgrV114) SpawnDerivedAsset(ctx cosmos.Context, asset common.Asset, mgr Manager) {
 	var err error
 	layer1Asset := asset
 	if layer1Asset.IsDerivedAsset() && !asset.Equals(common.TOR) {
@@ -305,7 +305,7 @@ func (vm *NetworkMgrV112) SpawnDerivedAsset(ctx cosmos.Context, asset common.Ass
 }
 
 // EndBlock move funds from retiring asgard vaults
-func (vm *NetworkMgrV112) EndBlock(ctx cosmos.Context, mgr Manager) error {
+func (vm *NetworkMgrV114) EndBlock(ctx cosmos.Context, mgr Manager) error {
 	if ctx.BlockHeight() == genesisBlockHeight {
 		return vm.processGenesisSetup(ctx)
 	}
@@ -316,6 +316,10 @@ func (vm *NetworkMgrV112) EndBlock(ctx cosmos.Context, mgr Manager) error {
 		ctx.Logger().Error("fail to process POL liquidity", "error", err)
 	}
 
+	if err := vm.recordSaversGrowth(ctx, mgr); err != nil {
+		ctx.Logger().Error("fail to record savers growth", "error", err)
+	}
+
 	migrateInterval, err := vm.k.GetMimir(ctx, constants.FundMigrationInterval.String())
 	if migrateInterval < 0 || err != nil {
 		migrateInterval = mgr.GetConstants().GetInt64Value(constants.FundMigrationInterval)
@@ -513,8 +517,38 @@ func (vm *NetworkMgrV112) EndBlock(ctx cosmos.Context, mgr Manager) error {
 	return nil
 }
 
+// recordSaversGrowth samples the redeem value of a unit of every savers pool
+// once per SaversGrowthInterval, to track the annualised yield of the savers
+func (vm *NetworkMgrV114) recordSaversGrowth(ctx cosmos.Context, mgr Manager) error {
+	interval := fetchConfigInt64(ctx, mgr, constants.SaversGrowthInterval)
+	if interval <= 0 || ctx.BlockHeight()%interval != 0 {
+		return nil
+	}
+	blocksPerYear := fetchConfigInt64(ctx, mgr, constants.BlocksPerYear)
+	periods := fetchConfigInt64(ctx, mgr, constants.SaversGrowthPeriods)
+
+	iterator := vm.k.GetPoolIterator(ctx)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var pool Pool
+		if err := vm.k.Cdc().Unmarshal(iterator.Value(), &pool); err != nil {
+			return fmt.Errorf("fail to unmarshal pool: %w", err)
+		}
+		if !pool.Asset.IsVaultAsset() || pool.LPUnits.IsZero() {
+			continue
+		}
+		growth, err := vm.k.GetSaversGrowth(ctx, pool.Asset)
+		if err != nil {
+			return fmt.Errorf("fail to get savers growth: %w", err)
+		}
+		growth.Update(ctx.BlockHeight(), SaversValuePerUnit(pool), blocksPerYear, periods)
+		vm.k.SetSaversGrowth(ctx, pool.Asset, growth)
+	}
+	return nil
+}
+
 // paySaverYield - takes a pool asset and total rune collected in yield to the pool, then pays out savers their proportion of yield based on its size (relative to dual side LPs) and the SynthYieldBasisPoints
-func (vm *NetworkMgrV112) paySaverYield(ctx cosmos.Context, asset common.Asset, runeAmt cosmos.Uint) error {
+func (vm *NetworkMgrV114) paySaverYield(ctx cosmos.Context, asset common.Asset, runeAmt cosmos.Uint) error {
 	pool, err := vm.k.GetPool(ctx, asset.GetLayer1Asset())
 	if err != nil {
 		return err
@@ -606,7 +640,7 @@ func (vm *NetworkMgrV112) paySaverYield(ctx cosmos.Context, asset common.Asset,
 	return nil
 }
 
-func (vm *NetworkMgrV112) POLCycle(ctx cosmos.Context, mgr Manager) error {
+func (vm *NetworkMgrV114) POLCycle(ctx cosmos.Context, mgr Manager) error {
 	maxDeposit := fetchConfigInt64(ctx, mgr, constants.POLMaxNetworkDeposit)
 	movement := fetchConfigInt64(ctx, mgr, constants.POLMaxPoolMovement)
 	target := fetchConfigInt64(ctx, mgr, constants.POLTargetSynthPerPoolDepth)
@@ -651,19 +685,13 @@ func (vm *NetworkMgrV112) POLCycle(ctx cosmos.Context, mgr Manager) error {
 
 	pool := pools[int(ctx.BlockHeight()%int64(len(pools)))]
 
-	// The POL key for the ETH.ETH pool would be POL-ETH-ETH .
-	key := "POL-" + pool.Asset.MimirString()
-	val, err := mgr.Keeper().GetMimir(ctx, key)
+	// if pool isn't available or mimir has it configured, force withdraw from the pool
+	targetSynthPerPoolDepth, err = polTargetSynthPerPoolDepth(ctx, mgr, pool)
 	if err != nil {
 		ctx.Logger().Error("fail to manage POL in pool", "pool", pool.Asset.String(), "error", err)
 		return nil
 	}
 
-	// if pool isn't available or mimir has it configured, force withdraw from the pool
-	if val == 2 || pool.Status != PoolAvailable {
-		targetSynthPerPoolDepth = cosmos.NewUint(10_000)
-	}
-
 	synthSupply := mgr.Keeper().GetTotalSupply(ctx, pool.Asset.GetSyntheticAsset())
 	pool.CalcUnits(mgr.GetVersion(), synthSupply)
 	synthPerPoolDepth := common.GetUncappedShare(pool.SynthUnits, pool.GetPoolUnits(), cosmos.NewUint(10_000))
@@ -691,7 +719,7 @@ func (vm *NetworkMgrV112) POLCycle(ctx cosmos.Context, mgr Manager) error {
 }
 
 // generated a filtered list of pools that the POL is active with
-func (mv *NetworkMgrV112) fetchPOLPools(ctx cosmos.Context, mgr Manager) Pools {
+func (mv *NetworkMgrV114) fetchPOLPools(ctx cosmos.Context, mgr Manager) Pools {
 	var pools Pools
 	iterator := mgr.Keeper().GetPoolIterator(ctx)
 	defer iterator.Close()
@@ -743,7 +771,7 @@ func (mv *NetworkMgrV112) fetchPOLPools(ctx cosmos.Context, mgr Manager) Pools {
 	return pools
 }
 
-func (vm *NetworkMgrV112) addPOLLiquidity(
+func (vm *NetworkMgrV114) addPOLLiquidity(
 	ctx cosmos.Context,
 	pool Pool,
 	polAddress, asgardAddress common.Address,
@@ -786,7 +814,7 @@ func (vm *NetworkMgrV112) addPOLLiquidity(
 	return err
 }
 
-func (vm *NetworkMgrV112) removePOLLiquidity(
+func (vm *NetworkMgrV114) removePOLLiquidity(
 	ctx cosmos.Context,
 	pool Pool,
 	polAddress, asgardAddress common.Address,
@@ -834,7 +862,7 @@ func (vm *NetworkMgrV112) removePOLLiquidity(
 }
 
 // TriggerKeygen generate a record to instruct signer kick off keygen process
-func (vm *NetworkMgrV112) TriggerKeygen(ctx cosmos.Context, nas NodeAccounts) error {
+func (vm *NetworkMgrV114) TriggerKeygen(ctx cosmos.Context, nas NodeAccounts) error {
 	halt, err := vm.k.GetMimir(ctx, "HaltChurning")
 	if halt > 0 && halt <= ctx.BlockHeight() && err == nil {
 		ctx.Logger().Info("churn event skipped due to mimir has halted churning")
@@ -890,7 +918,7 @@ func (vm *NetworkMgrV112) TriggerKeygen(ctx cosmos.Context, nas NodeAccounts) er
 }
 
 // RotateVault update vault to Retiring and new vault to active
-func (vm *NetworkMgrV112) RotateVault(ctx cosmos.Context, vault Vault) error {
+func (vm *NetworkMgrV114) RotateVault(ctx cosmos.Context, vault Vault) error {
 	active, err := vm.k.GetAsgardVaultsByStatus(ctx, ActiveVault)
 	if err != nil {
 		return err
@@ -939,7 +967,7 @@ func (vm *NetworkMgrV112) RotateVault(ctx cosmos.Context, vault Vault) error {
 	return nil
 }
 
-func (vm *NetworkMgrV112) cleanupAsgardIndex(ctx cosmos.Context) error {
+func (vm *NetworkMgrV114) cleanupAsgardIndex(ctx cosmos.Context) error {
 	asgards, err := vm.k.GetAsgardVaults(ctx)
 	if err != nil {
 		return fmt.Errorf("fail to get all asgards,err: %w", err)
@@ -962,7 +990,7 @@ func (vm *NetworkMgrV112) cleanupAsgardIndex(ctx cosmos.Context) error {
 
 // manageChains - checks to see if we have any chains that we are ragnaroking,
 // and ragnaroks them
-func (vm *NetworkMgrV112) manageChains(ctx cosmos.Context, mgr Manager) error {
+func (vm *NetworkMgrV114) manageChains(ctx cosmos.Context, mgr Manager) error {
 	chains, err := vm.findChainsToRetire(ctx)
 	if err != nil {
 		return err
@@ -1008,7 +1036,7 @@ func (vm *NetworkMgrV112) manageChains(ctx cosmos.Context, mgr Manager) error {
 // findChainsToRetire - evaluates the chains associated with active asgard
 // vaults vs retiring asgard vaults to detemine if any chains need to be
 // ragnarok'ed
-func (vm *NetworkMgrV112) findChainsToRetire(ctx cosmos.Context) (common.Chains, error) {
+func (vm *NetworkMgrV114) findChainsToRetire(ctx cosmos.Context) (common.Chains, error) {
 	chains := make(common.Chains, 0)
 
 	active, err := vm.k.GetAsgardVaultsByStatus(ctx, ActiveVault)
@@ -1046,7 +1074,7 @@ func (vm *NetworkMgrV112) findChainsToRetire(ctx cosmos.Context) (common.Chains,
 
 // RecallChainFunds - sends a message to bifrost nodes to send back all funds
 // associated with given chain
-func (vm *NetworkMgrV112) RecallChainFunds(ctx cosmos.Context, chain common.Chain, mgr Manager, excludeNodes common.PubKeys) error {
+func (vm *NetworkMgrV114) RecallChainFunds(ctx cosmos.Context, chain common.Chain, mgr Manager, excludeNodes common.PubKeys) error {
 	allNodes, err := vm.k.ListValidatorsWithBond(ctx)
 	if err != nil {
 		return fmt.Errorf("fail to list all node accounts: %w", err)
@@ -1113,7 +1141,7 @@ func (vm *NetworkMgrV112) RecallChainFunds(ctx cosmos.Context, chain common.Chai
 
 // ragnarokChain - ends a chain by withdrawing all liquidity providers of any pool that's
 // asset is on the given chain
-func (vm *NetworkMgrV112) ragnarokChain(ctx cosmos.Context, chain common.Chain, nth int64, mgr Manager) error {
+func (vm *NetworkMgrV114) ragnarokChain(ctx cosmos.Context, chain common.Chain, nth int64, mgr Manager) error {
 	nas, err := vm.k.ListActiveValidators(ctx)
 	if err != nil {
 		ctx.Logger().Error("can't get active nodes", "error", err)
@@ -1147,7 +1175,7 @@ func (vm *NetworkMgrV112) ragnarokChain(ctx cosmos.Context, chain common.Chain,
 
 // withdrawLiquidity will process a batch of LP per iteration, the batch size is defined by constants.RagnarokProcessNumOfLPPerIteration
 // once the all LP get processed, none-gas pool will be removed , gas pool will be set to Suspended
-func (vm *NetworkMgrV112) withdrawLiquidity(ctx cosmos.Context, pool Pool, na NodeAccount, mgr Manager) error {
+func (vm *NetworkMgrV114) withdrawLiquidity(ctx cosmos.Context, pool Pool, na NodeAccount, mgr Manager) error {
 	if pool.Status == PoolSuspended {
 		ctx.Logger().Info("cannot further withdraw liquidity from a suspended pool", "pool", pool.Asset)
 		return nil
@@ -1225,7 +1253,7 @@ func (vm *NetworkMgrV112) withdrawLiquidity(ctx cosmos.Context, pool Pool, na No
 }
 
 // UpdateNetwork Update the network data to reflect changing in this block
-func (vm *NetworkMgrV112) UpdateNetwork(ctx cosmos.Context, constAccessor constants.ConstantValues, gasManager GasManager, eventMgr EventManager) error {
+func (vm *NetworkMgrV114) UpdateNetwork(ctx cosmos.Context, constAccessor constants.ConstantValues, gasManager GasManager, eventMgr EventManager) error {
 	network, err := vm.k.GetNetwork(ctx)
 	if err != nil {
 		return fmt.Errorf("fail to get existing network data: %w", err)
@@ -1348,7 +1376,7 @@ func (vm *NetworkMgrV112) UpdateNetwork(ctx cosmos.Context, constAccessor consta
 	return vm.k.SetNetwork(ctx, network)
 }
 
-func (vm *NetworkMgrV112) getTotalProvidedLiquidityRune(ctx cosmos.Context) (Pools, cosmos.Uint, error) {
+func (vm *NetworkMgrV114) getTotalProvidedLiquidityRune(ctx cosmos.Context) (Pools, cosmos.Uint, error) {
 	// First get active pools and total provided liquidity Rune
 	totalProvidedLiquidity := cosmos.ZeroUint()
 	var pools Pools
@@ -1370,7 +1398,7 @@ func (vm *NetworkMgrV112) getTotalProvidedLiquidityRune(ctx cosmos.Context) (Poo
 	return pools, totalProvidedLiquidity, nil
 }
 
-func (vm *NetworkMgrV112) getTotalActiveBond(ctx cosmos.Context) (cosmos.Uint, error) {
+func (vm *NetworkMgrV114) getTotalActiveBond(ctx cosmos.Context) (cosmos.Uint, error) {
 	totalBonded := cosmos.ZeroUint()
 	nodes, err := vm.k.ListActiveValidators(ctx)
 	if err != nil {
@@ -1383,7 +1411,7 @@ func (vm *NetworkMgrV112) getTotalActiveBond(ctx cosmos.Context) (cosmos.Uint, e
 }
 
 // Pays out Rewards
-func (vm *NetworkMgrV112) payPoolRewards(ctx cosmos.Context, poolRewards []cosmos.Uint, pools Pools) error {
+func (vm *NetworkMgrV114) payPoolRewards(ctx cosmos.Context, poolRewards []cosmos.Uint, pools Pools) error {
 	for i, reward := range poolRewards {
 		if reward.IsZero() {
 			continue
@@ -1401,12 +1429,12 @@ func (vm *NetworkMgrV112) payPoolRewards(ctx cosmos.Context, poolRewards []cosmo
 }
 
 // Calculate pool deficit based on the pool's accrued fees compared with total fees.
-func (vm *NetworkMgrV112) calcPoolDeficit(lpDeficit, totalFees, poolFees cosmos.Uint) cosmos.Uint {
+func (vm *NetworkMgrV114) calcPoolDeficit(lpDeficit, totalFees, poolFees cosmos.Uint) cosmos.Uint {
 	return common.GetSafeShare(poolFees, totalFees, lpDeficit)
 }
 
 // Calculate the block rewards that bonders and liquidity providers should receive
-func (vm *NetworkMgrV112) calcBlockRewards(totalProvidedLiquidity, totalBonded, totalReserve, totalLiquidityFees cosmos.Uint, emissionCurve, incentiveCurve, blocksPerYear int64) (cosmos.Uint, cosmos.Uint, cosmos.Uint, cosmos.Uint) {
+func (vm *NetworkMgrV114) calcBlockRewards(totalProvidedLiquidity, totalBonded, totalReserve, totalLiquidityFees cosmos.Uint, emissionCurve, incentiveCurve, blocksPerYear int64) (cosmos.Uint, cosmos.Uint, cosmos.Uint, cosmos.Uint) {
 	// Block Rewards will take the latest reserve, divide it by the emission
 	// curve factor, then divide by blocks per year
 	trD := cosmos.NewDec(int64(totalReserve.Uint64()))
@@ -1435,7 +1463,7 @@ func (vm *NetworkMgrV112) calcBlockRewards(totalProvidedLiquidity, totalBonded,
 	return bonderSplit, poolReward, lpDeficit, lpShare
 }
 
-func (vm *NetworkMgrV112) getPoolShare(incentiveCurve int64, totalProvidedLiquidity, totalBonded, totalRewards cosmos.Uint) cosmos.Uint {
+func (vm *NetworkMgrV114) getPoolShare(incentiveCurve int64, totalProvidedLiquidity, totalBonded, totalRewards cosmos.Uint) cosmos.Uint {
 	/*
 		Pooled : Share
 		0 : 100%
@@ -1474,7 +1502,7 @@ func (vm *NetworkMgrV112) getPoolShare(incentiveCurve int64, totalProvidedLiquid
 // pool is in proportion to the amount of fees it accrued:
 //
 // deduction = (poolFees / totalLiquidityFees) * lpDeficit
-func (vm *NetworkMgrV112) deductPoolRewardDeficit(ctx cosmos.Context, pools Pools, totalLiquidityFees, lpDeficit cosmos.Uint) ([]PoolAmt, error) {
+func (vm *NetworkMgrV114) deductPoolRewardDeficit(ctx cosmos.Context, pools Pools, totalLiquidityFees, lpDeficit cosmos.Uint) ([]PoolAmt, error) {
 	poolAmts := make([]PoolAmt, 0)
 	for _, pool := range pools {
 		if !pool.IsAvailable() {
@@ -1518,7 +1546,7 @@ func (vm *NetworkMgrV112) deductPoolRewardDeficit(ctx cosmos.Context, pools Pool
 
 // checkPoolRagnarok iterate through all the pools to see whether there are pools need to be ragnarok
 // this function will only run in an interval , defined by constants.FundMigrationInterval
-func (vm *NetworkMgrV112) checkPoolRagnarok(ctx cosmos.Context, mgr Manager) error {
+func (vm *NetworkMgrV114) checkPoolRagnarok(ctx cosmos.Context, mgr Manager) error {
 	// check whether pool need to be ragnarok per constants.FundMigrationInterval
 	if ctx.BlockHeight()%mgr.GetConstants().GetInt64Value(constants.FundMigrationInterval) > 0 {
 		return nil
@@ -1552,7 +1580,7 @@ func (vm *NetworkMgrV112) checkPoolRagnarok(ctx cosmos.Context, mgr Manager) err
 
 // canRagnarokGasPool check whether a gas pool can be ragnarok
 // On blockchain that support multiple assets, make sure gas pool doesn't get ragnarok before none-gas asset pool
-func (vm *NetworkMgrV112) canRagnarokGasPool(ctx cosmos.Context, c common.Chain, allPools Pools) bool {
+func (vm *NetworkMgrV114) canRagnarokGasPool(ctx cosmos.Context, c common.Chain, allPools Pools) bool {
 	for _, pool := range allPools {
 		if pool.Status == PoolSuspended {
 			continue
@@ -1567,7 +1595,7 @@ func (vm *NetworkMgrV112) canRagnarokGasPool(ctx cosmos.Context, c common.Chain,
 	return true
 }
 
-func (vm *NetworkMgrV112) redeemSynthAssetToReserve(ctx cosmos.Context, p Pool) error {
+func (vm *NetworkMgrV114) redeemSynthAssetToReserve(ctx cosmos.Context, p Pool) error {
 	totalSupply := vm.k.GetTotalSupply(ctx, p.Asset.GetSyntheticAsset())
 	if totalSupply.IsZero() {
 		return nil
@@ -1590,7 +1618,7 @@ func (vm *NetworkMgrV112) redeemSynthAssetToReserve(ctx cosmos.Context, p Pool)
 	return nil
 }
 
-func (vm *NetworkMgrV112) ragnarokPool(ctx cosmos.Context, mgr Manager, p Pool) error {
+func (vm *NetworkMgrV114) ragnarokPool(ctx cosmos.Context, mgr Manager, p Pool) error {
 	if p.Status == PoolSuspended {
 		ctx.Logger().Info("cannot further ragnarok a suspended pool", "pool", p.Asset)
 		return nil
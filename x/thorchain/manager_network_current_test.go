@@ -10,15 +10,15 @@ import (
 	"gitlab.com/thorchain/thornode/x/thorchain/types"
 )
 
-type NetworkManagerV112TestSuite struct{}
+type NetworkManagerV114TestSuite struct{}
 
-var _ = Suite(&NetworkManagerV112TestSuite{})
+var _ = Suite(&NetworkManagerV114TestSuite{})
 
-func (s *NetworkManagerV112TestSuite) SetUpSuite(c *C) {
+func (s *NetworkManagerV114TestSuite) SetUpSuite(c *C) {
 	SetupConfigForTest()
 }
 
-func (s *NetworkManagerV112TestSuite) TestRagnarokChain(c *C) {
+func (s *NetworkManagerV114TestSuite) TestRagnarokChain(c *C) {
 	ctx, _ := setupKeeperForTest(c)
 	ctx = ctx.WithBlockHeight(100000)
 
@@ -83,7 +83,7 @@ func (s *NetworkManagerV112TestSuite) TestRagnarokChain(c *C) {
 
 	mgr := NewDummyMgrWithKeeper(keeper)
 
-	networkMgr := newNetworkMgrV112(keeper, mgr.TxOutStore(), mgr.EventMgr())
+	networkMgr := newNetworkMgrV114(keeper, mgr.TxOutStore(), mgr.EventMgr())
 
 	// the first round should just recall yggdrasil fund
 	err := networkMgr.manageChains(ctx, mgr)
@@ -119,7 +119,7 @@ func (s *NetworkManagerV112TestSuite) TestRagnarokChain(c *C) {
 	ctx, mgr1 := setupManagerForTest(c)
 	helper := NewVaultGenesisSetupTestHelper(mgr1.Keeper())
 	mgr.K = helper
-	networkMgr1 := newNetworkMgrV112(helper, mgr1.TxOutStore(), mgr1.EventMgr())
+	networkMgr1 := newNetworkMgrV114(helper, mgr1.TxOutStore(), mgr1.EventMgr())
 	// fail to get active nodes should error out
 	helper.failToListActiveAccounts = true
 	c.Assert(networkMgr1.ragnarokChain(ctx, common.BNBChain, 1, mgr), NotNil)
@@ -136,13 +136,13 @@ func (s *NetworkManagerV112TestSuite) TestRagnarokChain(c *C) {
 	helper.failGetPools = false
 }
 
-func (s *NetworkManagerV112TestSuite) TestUpdateNetwork(c *C) {
+func (s *NetworkManagerV114TestSuite) TestUpdateNetwork(c *C) {
 	ctx, mgr := setupManagerForTest(c)
 	ver := GetCurrentVersion()
 	constAccessor := constants.GetConstantValues(ver)
 	helper := NewVaultGenesisSetupTestHelper(mgr.Keeper())
 	mgr.K = helper
-	networkMgr := newNetworkMgrV112(helper, mgr.TxOutStore(), mgr.EventMgr())
+	networkMgr := newNetworkMgrV114(helper, mgr.TxOutStore(), mgr.EventMgr())
 
 	// fail to get Network should return error
 	helper.failGetNetwork = true
@@ -187,9 +187,9 @@ func (s *NetworkManagerV112TestSuite) TestUpdateNetwork(c *C) {
 	c.Assert(networkMgr.UpdateNetwork(ctx, constAccessor, mgr.GasMgr(), mgr.EventMgr()), NotNil)
 }
 
-func (s *NetworkManagerV112TestSuite) TestCalcBlockRewards(c *C) {
+func (s *NetworkManagerV114TestSuite) TestCalcBlockRewards(c *C) {
 	mgr := NewDummyMgr()
-	networkMgr := newNetworkMgrV112(keeper.KVStoreDummy{}, mgr.TxOutStore(), mgr.EventMgr())
+	networkMgr := newNetworkMgrV114(keeper.KVStoreDummy{}, mgr.TxOutStore(), mgr.EventMgr())
 
 	ver := GetCurrentVersion()
 	constAccessor := constants.GetConstantValues(ver)
@@ -234,13 +234,13 @@ func (s *NetworkManagerV112TestSuite) TestCalcBlockRewards(c *C) {
 	c.Check(lpShare.Uint64(), Equals, uint64(0), Commentf("%d", lpShare.Uint64()))
 }
 
-func (s *NetworkManagerV112TestSuite) TestCalcPoolDeficit(c *C) {
+func (s *NetworkManagerV114TestSuite) TestCalcPoolDeficit(c *C) {
 	pool1Fees := cosmos.NewUint(1000)
 	pool2Fees := cosmos.NewUint(3000)
 	totalFees := cosmos.NewUint(4000)
 
 	mgr := NewDummyMgr()
-	networkMgr := newNetworkMgrV112(keeper.KVStoreDummy{}, mgr.TxOutStore(), mgr.EventMgr())
+	networkMgr := newNetworkMgrV114(keeper.KVStoreDummy{}, mgr.TxOutStore(), mgr.EventMgr())
 
 	lpDeficit := cosmos.NewUint(1120)
 	amt1 := networkMgr.calcPoolDeficit(lpDeficit, totalFees, pool1Fees)
@@ -250,12 +250,12 @@ func (s *NetworkManagerV112TestSuite) TestCalcPoolDeficit(c *C) {
 	c.Check(amt2.Equal(cosmos.NewUint(840)), Equals, true, Commentf("%d", amt2.Uint64()))
 }
 
-func (*NetworkManagerV112TestSuite) TestProcessGenesisSetup(c *C) {
+func (*NetworkManagerV114TestSuite) TestProcessGenesisSetup(c *C) {
 	ctx, mgr := setupManagerForTest(c)
 	helper := NewVaultGenesisSetupTestHelper(mgr.Keeper())
 	ctx = ctx.WithBlockHeight(1)
 	mgr.K = helper
-	networkMgr := newNetworkMgrV112(helper, mgr.TxOutStore(), mgr.EventMgr())
+	networkMgr := newNetworkMgrV114(helper, mgr.TxOutStore(), mgr.EventMgr())
 	// no active account
 	c.Assert(networkMgr.EndBlock(ctx, mgr), NotNil)
 
@@ -279,7 +279,7 @@ func (*NetworkManagerV112TestSuite) TestProcessGenesisSetup(c *C) {
 	helper = NewVaultGenesisSetupTestHelper(mgr.Keeper())
 	ctx = ctx.WithBlockHeight(1)
 	mgr.K = helper
-	networkMgr = newNetworkMgrV112(helper, mgr.TxOutStore(), mgr.EventMgr())
+	networkMgr = newNetworkMgrV114(helper, mgr.TxOutStore(), mgr.EventMgr())
 	helper.failToListActiveAccounts = true
 	c.Assert(networkMgr.EndBlock(ctx, mgr), NotNil)
 	helper.failToListActiveAccounts = false
@@ -298,11 +298,11 @@ func (*NetworkManagerV112TestSuite) TestProcessGenesisSetup(c *C) {
 	helper.failGetActiveAsgardVault = false
 }
 
-func (*NetworkManagerV112TestSuite) TestGetTotalActiveBond(c *C) {
+func (*NetworkManagerV114TestSuite) TestGetTotalActiveBond(c *C) {
 	ctx, mgr := setupManagerForTest(c)
 	helper := NewVaultGenesisSetupTestHelper(mgr.Keeper())
 	mgr.K = helper
-	networkMgr := newNetworkMgrV112(helper, mgr.TxOutStore(), mgr.EventMgr())
+	networkMgr := newNetworkMgrV114(helper, mgr.TxOutStore(), mgr.EventMgr())
 	helper.failToListActiveAccounts = true
 	bond, err := networkMgr.getTotalActiveBond(ctx)
 	c.Assert(err, NotNil)
@@ -314,11 +314,11 @@ func (*NetworkManagerV112TestSuite) TestGetTotalActiveBond(c *C) {
 	c.Assert(bond.Uint64() > 0, Equals, true)
 }
 
-func (*NetworkManagerV112TestSuite) TestGetTotalLiquidityRune(c *C) {
+func (*NetworkManagerV114TestSuite) TestGetTotalLiquidityRune(c *C) {
 	ctx, mgr := setupManagerForTest(c)
 	helper := NewVaultGenesisSetupTestHelper(mgr.Keeper())
 	mgr.K = helper
-	networkMgr := newNetworkMgrV112(helper, mgr.TxOutStore(), mgr.EventMgr())
+	networkMgr := newNetworkMgrV114(helper, mgr.TxOutStore(), mgr.EventMgr())
 	p := NewPool()
 	p.Asset = common.BNBAsset
 	p.BalanceRune = cosmos.NewUint(common.One * 100)
@@ -331,11 +331,11 @@ func (*NetworkManagerV112TestSuite) TestGetTotalLiquidityRune(c *C) {
 	c.Assert(totalLiquidity.Equal(p.BalanceRune), Equals, true)
 }
 
-func (*NetworkManagerV112TestSuite) TestPayPoolRewards(c *C) {
+func (*NetworkManagerV114TestSuite) TestPayPoolRewards(c *C) {
 	ctx, mgr := setupManagerForTest(c)
 	helper := NewVaultGenesisSetupTestHelper(mgr.Keeper())
 	mgr.K = helper
-	networkMgr := newNetworkMgrV112(helper, mgr.TxOutStore(), mgr.EventMgr())
+	networkMgr := newNetworkMgrV114(helper, mgr.TxOutStore(), mgr.EventMgr())
 	p := NewPool()
 	p.Asset = common.BNBAsset
 	p.BalanceRune = cosmos.NewUint(common.One * 100)
@@ -347,11 +347,11 @@ func (*NetworkManagerV112TestSuite) TestPayPoolRewards(c *C) {
 	c.Assert(networkMgr.payPoolRewards(ctx, []cosmos.Uint{cosmos.NewUint(100 * common.One)}, Pools{p}), NotNil)
 }
 
-func (*NetworkManagerV112TestSuite) TestFindChainsToRetire(c *C) {
+func (*NetworkManagerV114TestSuite) TestFindChainsToRetire(c *C) {
 	ctx, mgr := setupManagerForTest(c)
 	helper := NewVaultGenesisSetupTestHelper(mgr.Keeper())
 	mgr.K = helper
-	networkMgr := newNetworkMgrV112(helper, mgr.TxOutStore(), mgr.EventMgr())
+	networkMgr := newNetworkMgrV114(helper, mgr.TxOutStore(), mgr.EventMgr())
 	// fail to get active asgard vault
 	helper.failGetActiveAsgardVault = true
 	chains, err := networkMgr.findChainsToRetire(ctx)
@@ -367,11 +367,11 @@ func (*NetworkManagerV112TestSuite) TestFindChainsToRetire(c *C) {
 	helper.failGetRetiringAsgardVault = false
 }
 
-func (*NetworkManagerV112TestSuite) TestRecallChainFunds(c *C) {
+func (*NetworkManagerV114TestSuite) TestRecallChainFunds(c *C) {
 	ctx, mgr := setupManagerForTest(c)
 	helper := NewVaultGenesisSetupTestHelper(mgr.Keeper())
 	mgr.K = helper
-	networkMgr := newNetworkMgrV112(helper, mgr.TxOutStore(), mgr.EventMgr())
+	networkMgr := newNetworkMgrV114(helper, mgr.TxOutStore(), mgr.EventMgr())
 	helper.failToListActiveAccounts = true
 	c.Assert(networkMgr.RecallChainFunds(ctx, common.BNBChain, mgr, common.PubKeys{}), NotNil)
 	helper.failToListActiveAccounts = false
@@ -381,11 +381,11 @@ func (*NetworkManagerV112TestSuite) TestRecallChainFunds(c *C) {
 	helper.failGetActiveAsgardVault = false
 }
 
-func (s *NetworkManagerV112TestSuite) TestRecoverPoolDeficit(c *C) {
+func (s *NetworkManagerV114TestSuite) TestRecoverPoolDeficit(c *C) {
 	ctx, mgr := setupManagerForTest(c)
 	helper := NewVaultGenesisSetupTestHelper(mgr.Keeper())
 	mgr.K = helper
-	networkMgr := newNetworkMgrV112(helper, mgr.TxOutStore(), mgr.EventMgr())
+	networkMgr := newNetworkMgrV114(helper, mgr.TxOutStore(), mgr.EventMgr())
 
 	pools := Pools{
 		Pool{
@@ -427,10 +427,10 @@ func (s *NetworkManagerV112TestSuite) TestRecoverPoolDeficit(c *C) {
 	c.Assert(pool.BalanceRune.String(), Equals, pools[0].BalanceRune.Sub(lpDeficit).String())
 }
 
-func (s *NetworkManagerV112TestSuite) TestSaverYieldFunc(c *C) {
+func (s *NetworkManagerV114TestSuite) TestSaverYieldFunc(c *C) {
 	var err error
 	ctx, mgr := setupManagerForTest(c)
-	net := newNetworkMgrV112(mgr.Keeper(), mgr.TxOutStore(), mgr.EventMgr())
+	net := newNetworkMgrV114(mgr.Keeper(), mgr.TxOutStore(), mgr.EventMgr())
 	mgr.Keeper().SetMimir(ctx, constants.SynthYieldCycle.String(), 5_000)
 
 	// mint synths
@@ -459,7 +459,7 @@ func (s *NetworkManagerV112TestSuite) TestSaverYieldFunc(c *C) {
 	c.Assert(spool.BalanceAsset.String(), Equals, "1113100000", Commentf("%d", spool.BalanceAsset.Uint64()))
 }
 
-func (s *NetworkManagerV112TestSuite) TestSaverYieldCall(c *C) {
+func (s *NetworkManagerV114TestSuite) TestSaverYieldCall(c *C) {
 	var err error
 	ctx, mgr := setupManagerForTest(c)
 	ver := GetCurrentVersion()
@@ -485,7 +485,7 @@ func (s *NetworkManagerV112TestSuite) TestSaverYieldCall(c *C) {
 	pool.CalcUnits(mgr.GetVersion(), coin.Amount)
 	c.Assert(mgr.Keeper().SetPool(ctx, pool), IsNil)
 
-	networkMgr := newNetworkMgrV112(mgr.Keeper(), mgr.TxOutStore(), mgr.EventMgr())
+	networkMgr := newNetworkMgrV114(mgr.Keeper(), mgr.TxOutStore(), mgr.EventMgr())
 
 	// test no fees collected
 	c.Assert(networkMgr.UpdateNetwork(ctx, constAccessor, mgr.gasMgr, mgr.eventMgr), IsNil)
@@ -511,7 +511,58 @@ func (s *NetworkManagerV112TestSuite) TestSaverYieldCall(c *C) {
 	c.Check(spool.BalanceAsset.String(), Equals, "7834021738", Commentf("%d", spool.BalanceAsset.Uint64()))
 }
 
-func (s *NetworkManagerV112TestSuite) TestRagnarokPool(c *C) {
+func (s *NetworkManagerV114TestSuite) TestRecordSaversGrowth(c *C) {
+	ctx, mgr := setupManagerForTest(c)
+	net := newNetworkMgrV114(mgr.Keeper(), mgr.TxOutStore(), mgr.EventMgr())
+	mgr.Keeper().SetMimir(ctx, constants.SaversGrowthInterval.String(), 10)
+	mgr.Keeper().SetMimir(ctx, constants.BlocksPerYear.String(), 100)
+
+	spool := NewPool()
+	spool.Asset = common.BTCAsset.GetSyntheticAsset()
+	spool.BalanceAsset = cosmos.NewUint(100 * common.One)
+	spool.LPUnits = cosmos.NewUint(100 * common.One)
+	c.Assert(mgr.Keeper().SetPool(ctx, spool), IsNil)
+
+	pool := NewPool()
+	pool.Asset = common.BTCAsset
+	pool.BalanceRune = cosmos.NewUint(100 * common.One)
+	pool.BalanceAsset = cosmos.NewUint(100 * common.One)
+	pool.LPUnits = cosmos.NewUint(100)
+	c.Assert(mgr.Keeper().SetPool(ctx, pool), IsNil)
+
+	ctx = ctx.WithBlockHeight(10)
+	c.Assert(net.recordSaversGrowth(ctx, mgr), IsNil)
+	growth, err := mgr.Keeper().GetSaversGrowth(ctx, spool.Asset)
+	c.Assert(err, IsNil)
+	c.Check(growth.LastHeight, Equals, int64(10))
+	c.Check(growth.LastValuePerUnit.Uint64(), Equals, uint64(1_000_000_000_000))
+
+	// layer 1 pools aren't tracked
+	growth, err = mgr.Keeper().GetSaversGrowth(ctx, pool.Asset)
+	c.Assert(err, IsNil)
+	c.Check(growth.LastHeight, Equals, int64(0))
+
+	// savers earn 1% over a tenth of a year
+	spool.BalanceAsset = cosmos.NewUint(101 * common.One)
+	c.Assert(mgr.Keeper().SetPool(ctx, spool), IsNil)
+
+	// not sampled outside of the interval
+	ctx = ctx.WithBlockHeight(15)
+	c.Assert(net.recordSaversGrowth(ctx, mgr), IsNil)
+	growth, err = mgr.Keeper().GetSaversGrowth(ctx, spool.Asset)
+	c.Assert(err, IsNil)
+	c.Check(growth.LastHeight, Equals, int64(10))
+
+	ctx = ctx.WithBlockHeight(20)
+	c.Assert(net.recordSaversGrowth(ctx, mgr), IsNil)
+	growth, err = mgr.Keeper().GetSaversGrowth(ctx, spool.Asset)
+	c.Assert(err, IsNil)
+	c.Check(growth.LastHeight, Equals, int64(20))
+	c.Check(growth.Samples, Equals, int64(1))
+	c.Check(growth.AprBps(), Equals, int64(1000))
+}
+
+func (s *NetworkManagerV114TestSuite) TestRagnarokPool(c *C) {
 	ctx, k := setupKeeperForTest(c)
 	ctx = ctx.WithBlockHeight(100000)
 	na := GetRandomValidatorNode(NodeActive)
@@ -573,7 +624,7 @@ func (s *NetworkManagerV112TestSuite) TestRagnarokPool(c *C) {
 	k.SetLiquidityProvider(ctx, lps[0])
 	k.SetLiquidityProvider(ctx, lps[1])
 	mgr := NewDummyMgrWithKeeper(k)
-	networkMgr := newNetworkMgrV112(k, mgr.TxOutStore(), mgr.EventMgr())
+	networkMgr := newNetworkMgrV114(k, mgr.TxOutStore(), mgr.EventMgr())
 
 	ctx = ctx.WithBlockHeight(1)
 	// block height not correct , doesn't take any actions
@@ -633,7 +684,7 @@ func (s *NetworkManagerV112TestSuite) TestRagnarokPool(c *C) {
 	c.Assert(tempPool.Status, Equals, PoolAvailable)
 }
 
-func (s *NetworkManagerV112TestSuite) TestCleanupAsgardIndex(c *C) {
+func (s *NetworkManagerV114TestSuite) TestCleanupAsgardIndex(c *C) {
 	ctx, k := setupKeeperForTest(c)
 	vault1 := NewVault(1024, ActiveVault, AsgardVault, GetRandomPubKey(), common.Chains{common.BNBChain}.Strings(), []ChainContract{})
 	c.Assert(k.SetVault(ctx, vault1), IsNil)
@@ -644,7 +695,7 @@ func (s *NetworkManagerV112TestSuite) TestCleanupAsgardIndex(c *C) {
 	vault4 := NewVault(1024, InactiveVault, AsgardVault, GetRandomPubKey(), common.Chains{common.BNBChain}.Strings(), []ChainContract{})
 	c.Assert(k.SetVault(ctx, vault4), IsNil)
 	mgr := NewDummyMgrWithKeeper(k)
-	networkMgr := newNetworkMgrV112(k, mgr.TxOutStore(), mgr.EventMgr())
+	networkMgr := newNetworkMgrV114(k, mgr.TxOutStore(), mgr.EventMgr())
 	c.Assert(networkMgr.cleanupAsgardIndex(ctx), IsNil)
 	containsVault := func(vaults Vaults, pubKey common.PubKey) bool {
 		for _, item := range vaults {
@@ -662,10 +713,10 @@ func (s *NetworkManagerV112TestSuite) TestCleanupAsgardIndex(c *C) {
 	c.Assert(containsVault(asgards, vault4.PubKey), Equals, false)
 }
 
-func (*NetworkManagerV112TestSuite) TestPOLLiquidityAdd(c *C) {
+func (*NetworkManagerV114TestSuite) TestPOLLiquidityAdd(c *C) {
 	ctx, mgr := setupManagerForTest(c)
 
-	net := newNetworkMgrV112(mgr.Keeper(), NewTxStoreDummy(), NewDummyEventMgr())
+	net := newNetworkMgrV114(mgr.Keeper(), NewTxStoreDummy(), NewDummyEventMgr())
 	max := cosmos.NewUint(10000)
 
 	polAddress, err := mgr.Keeper().GetModuleAddress(ReserveName)
@@ -715,10 +766,10 @@ func (*NetworkManagerV112TestSuite) TestPOLLiquidityAdd(c *C) {
 	c.Check(lp.Units.Uint64(), Equals, uint64(10), Commentf("%d", lp.Units.Uint64()))
 }
 
-func (*NetworkManagerV112TestSuite) TestPOLLiquidityWithdraw(c *C) {
+func (*NetworkManagerV114TestSuite) TestPOLLiquidityWithdraw(c *C) {
 	ctx, mgr := setupManagerForTest(c)
 
-	net := newNetworkMgrV112(mgr.Keeper(), NewTxStoreDummy(), NewDummyEventMgr())
+	net := newNetworkMgrV114(mgr.Keeper(), NewTxStoreDummy(), NewDummyEventMgr())
 	max := cosmos.NewUint(10000)
 
 	polAddress, err := mgr.Keeper().GetModuleAddress(ReserveName)
@@ -794,9 +845,9 @@ func (*NetworkManagerV112TestSuite) TestPOLLiquidityWithdraw(c *C) {
 	c.Check(lp.Units.Uint64(), Equals, uint64(788), Commentf("%d", lp.Units.Uint64()))
 }
 
-func (*NetworkManagerV112TestSuite) TestFairMergePOLCycle(c *C) {
+func (*NetworkManagerV114TestSuite) TestFairMergePOLCycle(c *C) {
 	ctx, mgr := setupManagerForTest(c)
-	net := newNetworkMgrV112(mgr.Keeper(), NewTxStoreDummy(), NewDummyEventMgr())
+	net := newNetworkMgrV114(mgr.Keeper(), NewTxStoreDummy(), NewDummyEventMgr())
 
 	// cycle should do nothing when target is 0
 	err := net.POLCycle(ctx, mgr)
@@ -978,10 +1029,10 @@ func (*NetworkManagerV112TestSuite) TestFairMergePOLCycle(c *C) {
 	c.Assert(pol.RuneWithdrawn.String(), Equals, "198909097")
 }
 
-func (s *NetworkManagerV112TestSuite) TestSpawnDerivedAssets(c *C) {
+func (s *NetworkManagerV114TestSuite) TestSpawnDerivedAssets(c *C) {
 	ctx, mgr := setupManagerForTest(c)
 
-	nmgr := newNetworkMgrV112(mgr.Keeper(), NewTxStoreDummy(), NewDummyEventMgr())
+	nmgr := newNetworkMgrV114(mgr.Keeper(), NewTxStoreDummy(), NewDummyEventMgr())
 
 	vault := GetRandomVault()
 	c.Assert(mgr.Keeper().SetVault(ctx, vault), IsNil)
@@ -1054,10 +1105,10 @@ func (s *NetworkManagerV112TestSuite) TestSpawnDerivedAssets(c *C) {
 	c.Assert(bnbPool.BalanceRune.Uint64(), Equals, uint64(187493559385369), Commentf("%d", bnbPool.BalanceRune.Uint64()))
 }
 
-func (s *NetworkManagerV112TestSuite) TestSpawnDerivedAssetsBasisPoints(c *C) {
+func (s *NetworkManagerV114TestSuite) TestSpawnDerivedAssetsBasisPoints(c *C) {
 	ctx, mgr := setupManagerForTest(c)
 
-	nmgr := newNetworkMgrV112(mgr.Keeper(), NewTxStoreDummy(), NewDummyEventMgr())
+	nmgr := newNetworkMgrV114(mgr.Keeper(), NewTxStoreDummy(), NewDummyEventMgr())
 
 	vault := GetRandomVault()
 	c.Assert(mgr.Keeper().SetVault(ctx, vault), IsNil)
@@ -162,19 +162,19 @@ func (items orderItems) Sort(ctx cosmos.Context) orderItems {
 	return sorted
 }
 
-// OrderBookV104 is going to manage the swaps queue
-type OrderBookV104 struct {
+// OrderBookV114 is going to manage the swaps queue
+type OrderBookV114 struct {
 	k           keeper.Keeper
 	limitOrders orderItems
 }
 
-// newOrderBookV104 create a new vault manager
-func newOrderBookV104(k keeper.Keeper) *OrderBookV104 {
-	return &OrderBookV104{k: k, limitOrders: make(orderItems, 0)}
+// newOrderBookV114 create a new vault manager
+func newOrderBookV114(k keeper.Keeper) *OrderBookV114 {
+	return &OrderBookV114{k: k, limitOrders: make(orderItems, 0)}
 }
 
 // FetchQueue - grabs all swap queue items from the kvstore and returns them
-func (ob *OrderBookV104) FetchQueue(ctx cosmos.Context, mgr Manager, pairs tradePairs, pools Pools) (orderItems, error) { // nolint
+func (ob *OrderBookV114) FetchQueue(ctx cosmos.Context, mgr Manager, pairs tradePairs, pools Pools) (orderItems, error) { // nolint
 	items := make(orderItems, 0)
 
 	// if the network is doing a pool cycle, no swaps/orders are executed this
@@ -230,7 +230,7 @@ func (ob *OrderBookV104) FetchQueue(ctx cosmos.Context, mgr Manager, pairs trade
 	return items, nil
 }
 
-func (ob *OrderBookV104) discoverLimitOrders(ctx cosmos.Context, pair tradePair, pools Pools) (orderItems, bool) {
+func (ob *OrderBookV114) discoverLimitOrders(ctx cosmos.Context, pair tradePair, pools Pools) (orderItems, bool) {
 	items := make(orderItems, 0)
 	done := false
 
@@ -286,7 +286,7 @@ func (ob *OrderBookV104) discoverLimitOrders(ctx cosmos.Context, pair tradePair,
 	return items, done
 }
 
-func (ob *OrderBookV104) checkFeelessSwap(pools Pools, pair tradePair, indexRatio uint64) bool {
+func (ob *OrderBookV114) checkFeelessSwap(pools Pools, pair tradePair, indexRatio uint64) bool {
 	var ratio cosmos.Uint
 	switch {
 	case !pair.HasRune():
@@ -318,7 +318,7 @@ func (ob *OrderBookV104) checkFeelessSwap(pools Pools, pair tradePair, indexRati
 	return cosmos.NewUint(indexRatio).GT(ratio)
 }
 
-func (ob *OrderBookV104) checkWithFeeSwap(ctx cosmos.Context, pools Pools, msg MsgSwap) bool {
+func (ob *OrderBookV114) checkWithFeeSwap(ctx cosmos.Context, pools Pools, msg MsgSwap) bool {
 	swapper, err := GetSwapper(ob.k.GetVersion())
 	if err != nil {
 		ctx.Logger().Error("fail to load swapper", "error", err)
@@ -367,7 +367,7 @@ func (ob *OrderBookV104) checkWithFeeSwap(ctx cosmos.Context, pools Pools, msg M
 	return emit.GT(target.Amount)
 }
 
-func (ob *OrderBookV104) getRatio(input, output cosmos.Uint) cosmos.Uint {
+func (ob *OrderBookV114) getRatio(input, output cosmos.Uint) cosmos.Uint {
 	if output.IsZero() {
 		return cosmos.ZeroUint()
 	}
@@ -376,7 +376,7 @@ func (ob *OrderBookV104) getRatio(input, output cosmos.Uint) cosmos.Uint {
 
 // converts a proc, cosmos.Uint, into a series of selected pairs from the pairs
 // input (ie asset pairs that need to be check for executable order)
-func (ob *OrderBookV104) convertProcToAssetArrays(proc []bool, pairs tradePairs) (tradePairs, bool) {
+func (ob *OrderBookV114) convertProcToAssetArrays(proc []bool, pairs tradePairs) (tradePairs, bool) {
 	result := make(tradePairs, 0)
 	if len(proc) != len(pairs) {
 		return result, false
@@ -393,7 +393,7 @@ func (ob *OrderBookV104) convertProcToAssetArrays(proc []bool, pairs tradePairs)
 }
 
 // converts a list of selected pairs from a list of total pairs, to be represented as a uint64
-func (ob *OrderBookV104) convertAssetArraysToProc(toProc, pairs tradePairs) []bool {
+func (ob *OrderBookV114) convertAssetArraysToProc(toProc, pairs tradePairs) []bool {
 	builder := make([]bool, len(pairs))
 	for i, pair := range pairs {
 		builder[i] = false
@@ -408,7 +408,7 @@ func (ob *OrderBookV104) convertAssetArraysToProc(toProc, pairs tradePairs) []bo
 }
 
 // getAssetPairs - fetches a list of strings that represents directional trading pairs
-func (ob *OrderBookV104) getAssetPairs(ctx cosmos.Context) (tradePairs, Pools) {
+func (ob *OrderBookV114) getAssetPairs(ctx cosmos.Context) (tradePairs, Pools) {
 	result := make(tradePairs, 0)
 	var pools Pools
 
@@ -444,7 +444,14 @@ func (ob *OrderBookV104) getAssetPairs(ctx cosmos.Context) (tradePairs, Pools) {
 	return result, pools
 }
 
-func (ob *OrderBookV104) AddOrderBookItem(ctx cosmos.Context, msg MsgSwap) error {
+func (ob *OrderBookV114) AddOrderBookItem(ctx cosmos.Context, msg MsgSwap) error {
+	// limit orders without an expiry from the memo use the default ttl
+	if msg.OrderType == LimitOrder && msg.ExpiryHeight == 0 {
+		ttl := ob.k.GetConfigInt64(ctx, constants.OrderBookDefaultTTL)
+		if ttl > 0 {
+			msg.ExpiryHeight = ctx.BlockHeight() + ttl
+		}
+	}
 	if err := ob.k.SetOrderBookItem(ctx, msg); err != nil {
 		ctx.Logger().Error("fail to add order book item", "error", err)
 		return err
@@ -461,7 +468,7 @@ func (ob *OrderBookV104) AddOrderBookItem(ctx cosmos.Context, msg MsgSwap) error
 }
 
 // EndBlock trigger the real swap to be processed
-func (ob *OrderBookV104) EndBlock(ctx cosmos.Context, mgr Manager) error {
+func (ob *OrderBookV114) EndBlock(ctx cosmos.Context, mgr Manager) error {
 	handler := NewInternalHandler(mgr)
 
 	minSwapsPerBlock, err := ob.k.GetMimir(ctx, constants.MinSwapsPerBlock.String())
@@ -477,6 +484,10 @@ func (ob *OrderBookV104) EndBlock(ctx cosmos.Context, mgr Manager) error {
 		synthVirtualDepthMult = mgr.GetConstants().GetInt64Value(constants.VirtualMultSynthsBasisPoints)
 	}
 
+	// refund limit orders that have expired, before looking for any orders to
+	// execute
+	ob.expireOrders(ctx, mgr)
+
 	todo := make(tradePairs, 0)
 	pairs, pools := ob.getAssetPairs(ctx)
 
@@ -486,9 +497,10 @@ func (ob *OrderBookV104) EndBlock(ctx cosmos.Context, mgr Manager) error {
 		return err
 	}
 
-	// pull new limit orders added this block (if not already added)
+	// pull new limit orders added this block (if not already added, and not
+	// already removed from the order book)
 	for _, item := range ob.limitOrders {
-		if !swaps.HasItem(item.msg.Tx.ID) {
+		if !swaps.HasItem(item.msg.Tx.ID) && ob.k.HasOrderBookItem(ctx, item.msg.Tx.ID) {
 			swaps = append(swaps, item)
 		}
 	}
@@ -503,55 +515,17 @@ func (ob *OrderBookV104) EndBlock(ctx cosmos.Context, mgr Manager) error {
 
 	refund := func(msg MsgSwap, err error) {
 		ctx.Logger().Error("fail to execute order", "msg", msg.Tx.String(), "error", err)
-
-		var refundErr error
-
-		// Get the full ObservedTx from the TxID, for the vault ObservedPubKey to first try to refund from.
-		voter, voterErr := mgr.Keeper().GetObservedTxInVoter(ctx, msg.Tx.ID)
-		if voterErr == nil && !voter.Tx.IsEmpty() {
-			refundErr = refundTx(ctx, ObservedTx{Tx: msg.Tx, ObservedPubKey: voter.Tx.ObservedPubKey}, mgr, CodeSwapFail, err.Error(), "")
-		} else {
-			// If the full ObservedTx could not be retrieved, proceed with just the MsgSwap's Tx (no ObservedPubKey).
-			ctx.Logger().Error("fail to get non-empty observed tx", "error", voterErr)
-			refundErr = refundTx(ctx, ObservedTx{Tx: msg.Tx}, mgr, CodeSwapFail, err.Error(), "")
-		}
-
-		if nil != refundErr {
-			ctx.Logger().Error("fail to refund swap", "error", err)
-		}
+		ob.refund(ctx, mgr, msg, err.Error())
 	}
 
 	for i := int64(0); i < ob.getTodoNum(int64(len(swaps)), minSwapsPerBlock, maxSwapsPerBlock); i++ {
 		pick := swaps[i]
-		var msg, affiliateSwap MsgSwap
+		var msg MsgSwap
 		if err := copier.Copy(&msg, &pick.msg); err != nil {
 			ctx.Logger().Error("fail copy msg", "msg", msg.Tx.String(), "error", err)
 			continue
 		}
-		if !msg.AffiliateBasisPoints.IsZero() && msg.AffiliateAddress.IsChain(common.THORChain) {
-			affiliateAmt := common.GetSafeShare(
-				msg.AffiliateBasisPoints,
-				cosmos.NewUint(10000),
-				msg.Tx.Coins[0].Amount,
-			)
-			msg.Tx.Coins[0].Amount = common.SafeSub(msg.Tx.Coins[0].Amount, affiliateAmt)
-
-			affiliateSwap = *NewMsgSwap(
-				msg.Tx,
-				common.RuneAsset(),
-				msg.AffiliateAddress,
-				cosmos.ZeroUint(),
-				common.NoAddress,
-				cosmos.ZeroUint(),
-				"",
-				"", nil,
-				MarketOrder,
-				msg.Signer,
-			)
-			if affiliateSwap.Tx.Coins[0].Amount.GTE(affiliateAmt) {
-				affiliateSwap.Tx.Coins[0].Amount = affiliateAmt
-			}
-		}
+		affiliateFees := takeAffiliateFees(&msg)
 
 		// make the primary swap
 		_, err := handler(ctx, &msg)
@@ -572,27 +546,8 @@ func (ob *OrderBookV104) EndBlock(ctx cosmos.Context, mgr Manager) error {
 			}
 		} else {
 			todo = todo.findMatchingTrades(genTradePair(msg.Tx.Coins[0].Asset, msg.TargetAsset), pairs)
-			if !affiliateSwap.Tx.IsEmpty() {
-				// if asset sent in is native rune, no need
-				if affiliateSwap.Tx.Coins[0].Asset.IsNativeRune() {
-					toAddress, err := msg.AffiliateAddress.AccAddress()
-					if err != nil {
-						ctx.Logger().Error("fail to convert address into AccAddress", "msg", msg.AffiliateAddress, "error", err)
-						continue
-					}
-					// since native transaction fee has been charged to inbound from address, thus for affiliated fee , the network doesn't need to charge it again
-					coin := common.NewCoin(common.RuneAsset(), affiliateSwap.Tx.Coins[0].Amount)
-					sdkErr := mgr.Keeper().SendFromModuleToAccount(ctx, AsgardName, toAddress, common.NewCoins(coin))
-					if sdkErr != nil {
-						ctx.Logger().Error("fail to send native asset to affiliate", "msg", msg.AffiliateAddress, "error", err, "asset", coin.Asset)
-					}
-				} else {
-					// make the affiliate fee swap
-					_, err := handler(ctx, &affiliateSwap)
-					if err != nil {
-						ctx.Logger().Error("fail to execute affiliate swap", "msg", affiliateSwap.Tx.String(), "error", err)
-					}
-				}
+			for _, fee := range affiliateFees {
+				ob.payAffiliateFee(ctx, mgr, handler, fee)
 			}
 		}
 		if err := ob.k.RemoveOrderBookItem(ctx, pick.msg.Tx.ID); err != nil {
@@ -607,8 +562,104 @@ func (ob *OrderBookV104) EndBlock(ctx cosmos.Context, mgr Manager) error {
 	return nil
 }
 
+// payAffiliateFee pays the fee owed to an affiliate of a swap, by swapping it
+// to RUNE, or paying it directly when it's already native RUNE
+func (ob *OrderBookV114) payAffiliateFee(ctx cosmos.Context, mgr Manager, handler cosmos.Handler, fee affiliateFee) {
+	coin := fee.swap.Tx.Coins[0]
+	if coin.Asset.IsNativeRune() {
+		if err := payAffiliateFeeRune(ctx, mgr, fee); err != nil {
+			ctx.Logger().Error("fail to pay affiliate fee", "address", fee.affiliate.Address, "error", err, "asset", coin.Asset)
+			return
+		}
+	} else {
+		// make the affiliate fee swap
+		if _, err := handler(ctx, &fee.swap); err != nil {
+			ctx.Logger().Error("fail to execute affiliate swap", "msg", fee.swap.Tx.String(), "error", err)
+			return
+		}
+	}
+
+	if err := mgr.EventMgr().EmitEvent(ctx, fee.event()); err != nil {
+		ctx.Logger().Error("fail to emit affiliate fee event", "error", err)
+	}
+}
+
+// refund the inbound of the given order book item
+func (ob *OrderBookV114) refund(ctx cosmos.Context, mgr Manager, msg MsgSwap, reason string) {
+	var refundErr error
+
+	// Get the full ObservedTx from the TxID, for the vault ObservedPubKey to first try to refund from.
+	voter, voterErr := mgr.Keeper().GetObservedTxInVoter(ctx, msg.Tx.ID)
+	if voterErr == nil && !voter.Tx.IsEmpty() {
+		refundErr = refundTx(ctx, ObservedTx{Tx: msg.Tx, ObservedPubKey: voter.Tx.ObservedPubKey}, mgr, CodeSwapFail, reason, "")
+	} else {
+		// If the full ObservedTx could not be retrieved, proceed with just the MsgSwap's Tx (no ObservedPubKey).
+		ctx.Logger().Error("fail to get non-empty observed tx", "error", voterErr)
+		refundErr = refundTx(ctx, ObservedTx{Tx: msg.Tx}, mgr, CodeSwapFail, reason, "")
+	}
+
+	if nil != refundErr {
+		ctx.Logger().Error("fail to refund swap", "error", refundErr)
+	}
+}
+
+// expireOrders - refunds and removes every order book item whose expiry height
+// has been reached. Items are swept in ascending order of expiry height, and
+// in order of insertion for the same height.
+func (ob *OrderBookV114) expireOrders(ctx cosmos.Context, mgr Manager) {
+	expired := make(common.TxIDs, 0)
+	iter := ob.k.GetOrderBookExpiryIterator(ctx)
+	if iter == nil {
+		return
+	}
+	for ; iter.Valid(); iter.Next() {
+		height, err := ob.parseExpiryHeightFromKey(string(iter.Key()))
+		if err != nil {
+			ctx.Logger().Error("fail to parse expiry height", "key", string(iter.Key()), "error", err)
+			continue
+		}
+		if height > ctx.BlockHeight() {
+			break
+		}
+
+		value := ProtoStrings{Value: make([]string, 0)}
+		if err := ob.k.Cdc().Unmarshal(iter.Value(), &value); err != nil {
+			ctx.Logger().Error("fail to fetch indexed txn hashes", "error", err)
+			continue
+		}
+		for _, rec := range value.Value {
+			hash, err := common.NewTxID(rec)
+			if err != nil {
+				ctx.Logger().Error("fail to parse tx hash", "error", err)
+				continue
+			}
+			expired = append(expired, hash)
+		}
+	}
+	iter.Close()
+
+	for _, hash := range expired {
+		msg, err := ob.k.GetOrderBookItem(ctx, hash)
+		if err != nil {
+			ctx.Logger().Error("fail to fetch order book item", "hash", hash, "error", err)
+			continue
+		}
+		ob.refund(ctx, mgr, msg, "limit order expired")
+		if err := ob.k.RemoveOrderBookItem(ctx, hash); err != nil {
+			ctx.Logger().Error("fail to remove order book item", "msg", msg.Tx.String(), "error", err)
+		}
+
+		source := msg.Tx.Coins[0]
+		target := common.NewCoin(msg.TargetAsset, msg.TradeTarget)
+		evt := NewEventLimitOrderExpired(source, target, msg.Tx.ID)
+		if err := mgr.EventMgr().EmitEvent(ctx, evt); err != nil {
+			ctx.Logger().Error("fail to emit limit order expired event", "error", err)
+		}
+	}
+}
+
 // getTodoNum - determine how many swaps to do.
-func (ob *OrderBookV104) getTodoNum(queueLen, minSwapsPerBlock, maxSwapsPerBlock int64) int64 {
+func (ob *OrderBookV114) getTodoNum(queueLen, minSwapsPerBlock, maxSwapsPerBlock int64) int64 {
 	// Do half the length of the queue. Unless...
 	//	1. The queue length is greater than maxSwapsPerBlock
 	//  2. The queue legnth is less than minSwapsPerBlock
@@ -624,7 +675,7 @@ func (ob *OrderBookV104) getTodoNum(queueLen, minSwapsPerBlock, maxSwapsPerBlock
 
 // scoreMsgs - this takes a list of MsgSwap, and converts them to a scored
 // orderItem list
-func (ob *OrderBookV104) scoreMsgs(ctx cosmos.Context, items orderItems, synthVirtualDepthMult int64) (orderItems, error) {
+func (ob *OrderBookV114) scoreMsgs(ctx cosmos.Context, items orderItems, synthVirtualDepthMult int64) (orderItems, error) {
 	pools := make(map[common.Asset]Pool)
 
 	for i, item := range items {
@@ -684,7 +735,7 @@ func (ob *OrderBookV104) scoreMsgs(ctx cosmos.Context, items orderItems, synthVi
 }
 
 // getLiquidityFeeAndSlip calculate liquidity fee and slip, fee is in RUNE
-func (ob *OrderBookV104) getLiquidityFeeAndSlip(ctx cosmos.Context, pool Pool, sourceCoin common.Coin, item *orderItem, virtualDepthMult int64) {
+func (ob *OrderBookV114) getLiquidityFeeAndSlip(ctx cosmos.Context, pool Pool, sourceCoin common.Coin, item *orderItem, virtualDepthMult int64) {
 	// Get our X, x, Y values
 	var X, x, Y cosmos.Uint
 	x = sourceCoin.Amount
@@ -713,7 +764,15 @@ func (ob *OrderBookV104) getLiquidityFeeAndSlip(ctx cosmos.Context, pool Pool, s
 	item.slip = item.slip.Add(slip)
 }
 
-func (ob *OrderBookV104) parseRatioFromKey(key string) (uint64, error) {
+func (ob *OrderBookV114) parseExpiryHeightFromKey(key string) (int64, error) {
+	parts := strings.Split(key, "/")
+	if len(parts) < 2 {
+		return 0, fmt.Errorf("invalid key format")
+	}
+	return strconv.ParseInt(parts[len(parts)-1], 10, 64)
+}
+
+func (ob *OrderBookV114) parseRatioFromKey(key string) (uint64, error) {
 	parts := strings.Split(key, "/")
 	if len(parts) < 5 {
 		return 0, fmt.Errorf("invalid key format")
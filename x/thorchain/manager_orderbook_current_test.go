@@ -7,15 +7,16 @@ import (
 
 	"gitlab.com/thorchain/thornode/common"
 	"gitlab.com/thorchain/thornode/common/cosmos"
+	"gitlab.com/thorchain/thornode/constants"
 	"gitlab.com/thorchain/thornode/x/thorchain/keeper"
 )
 
-type OrderBookV104Suite struct{}
+type OrderBookV114Suite struct{}
 
-var _ = Suite(&OrderBookV104Suite{})
+var _ = Suite(&OrderBookV114Suite{})
 
-func (s OrderBookV104Suite) TestGetTodoNum(c *C) {
-	book := newOrderBookV104(keeper.KVStoreDummy{})
+func (s OrderBookV114Suite) TestGetTodoNum(c *C) {
+	book := newOrderBookV114(keeper.KVStoreDummy{})
 
 	c.Check(book.getTodoNum(50, 10, 100), Equals, int64(25))     // halves it
 	c.Check(book.getTodoNum(11, 10, 100), Equals, int64(5))      // halves it
@@ -26,7 +27,7 @@ func (s OrderBookV104Suite) TestGetTodoNum(c *C) {
 	c.Check(book.getTodoNum(200, 10, 100), Equals, int64(100))   // does max 100
 }
 
-func (s OrderBookV104Suite) TestScoreMsgs(c *C) {
+func (s OrderBookV114Suite) TestScoreMsgs(c *C) {
 	ctx, k := setupKeeperForTest(c)
 
 	pool := NewPool()
@@ -40,7 +41,7 @@ func (s OrderBookV104Suite) TestScoreMsgs(c *C) {
 	pool.BalanceAsset = cosmos.NewUint(1000 * common.One)
 	c.Assert(k.SetPool(ctx, pool), IsNil)
 
-	book := newOrderBookV104(k)
+	book := newOrderBookV114(k)
 
 	// check that we sort by liquidity ok
 	msgs := []*MsgSwap{
@@ -249,9 +250,9 @@ func (s OrderBookV104Suite) TestScoreMsgs(c *C) {
 	c.Check(swaps[10].msg.Tx.Coins[0].Asset.Equals(common.BNBAsset), Equals, true)
 }
 
-func (s OrderBookV104Suite) TestFetchQueue(c *C) {
+func (s OrderBookV114Suite) TestFetchQueue(c *C) {
 	ctx, mgr := setupManagerForTest(c)
-	book := newOrderBookV104(mgr.Keeper())
+	book := newOrderBookV114(mgr.Keeper())
 
 	pool := NewPool()
 	pool.Asset = common.BNBAsset
@@ -303,10 +304,10 @@ func (s OrderBookV104Suite) TestFetchQueue(c *C) {
 	c.Check(items, HasLen, 2, Commentf("%d", len(items)))
 }
 
-func (s OrderBookV104Suite) TestgetAssetPairs(c *C) {
+func (s OrderBookV114Suite) TestgetAssetPairs(c *C) {
 	ctx, k := setupKeeperForTest(c)
 
-	book := newOrderBookV104(k)
+	book := newOrderBookV114(k)
 
 	pool := NewPool()
 	pool.Asset = common.BTCAsset
@@ -319,7 +320,7 @@ func (s OrderBookV104Suite) TestgetAssetPairs(c *C) {
 	c.Check(pairs, HasLen, len(pools)*(len(pools)+1))
 }
 
-func (s OrderBookV104Suite) TestTradePairsTodo(c *C) {
+func (s OrderBookV114Suite) TestTradePairsTodo(c *C) {
 	pairs := tradePairs{
 		{common.RuneAsset(), common.BNBAsset},
 		{common.BNBAsset, common.RuneAsset()},
@@ -356,7 +357,7 @@ func (s OrderBookV104Suite) TestTradePairsTodo(c *C) {
 	c.Check(todo[2].Equals(genTradePair(common.BNBAsset, common.BTCAsset)), Equals, true, Commentf("%s", todo[2]))
 }
 
-func (s OrderBookV104Suite) TestConvertProc(c *C) {
+func (s OrderBookV114Suite) TestConvertProc(c *C) {
 	_, k := setupKeeperForTest(c)
 
 	pairs := tradePairs{
@@ -368,7 +369,7 @@ func (s OrderBookV104Suite) TestConvertProc(c *C) {
 		{common.BTCAsset, common.BNBAsset},
 	}
 
-	book := newOrderBookV104(k)
+	book := newOrderBookV114(k)
 
 	result, ok := book.convertProcToAssetArrays(nil, pairs)
 	c.Assert(result, HasLen, 0)
@@ -409,10 +410,10 @@ func (s OrderBookV104Suite) TestConvertProc(c *C) {
 	c.Assert(ok, Equals, false)
 }
 
-func (s OrderBookV104Suite) TestEndBlock(c *C) {
+func (s OrderBookV114Suite) TestEndBlock(c *C) {
 	ctx, mgr := setupManagerForTest(c)
 	mgr.txOutStore = NewTxStoreDummy()
-	book := newOrderBookV104(mgr.Keeper())
+	book := newOrderBookV114(mgr.Keeper())
 
 	pool := NewPool()
 	pool.Asset = common.BNBAsset
@@ -467,3 +468,112 @@ func (s OrderBookV104Suite) TestEndBlock(c *C) {
 	c.Assert(err, IsNil)
 	c.Check(proc, DeepEquals, []bool{false, true, true, true, false, false}, Commentf("%+v", proc))
 }
+
+func (s OrderBookV114Suite) TestAddOrderBookItem(c *C) {
+	ctx, mgr := setupManagerForTest(c)
+	ctx = ctx.WithBlockHeight(100)
+	book := newOrderBookV114(mgr.Keeper())
+
+	tx := GetRandomTx()
+	tx.Coins = common.NewCoins(common.NewCoin(common.BTCAsset, cosmos.NewUint(1*common.One)))
+	limit := NewMsgSwap(
+		tx, common.BNBAsset, GetRandomBNBAddress(), cosmos.NewUint(856815149),
+		common.NoAddress, cosmos.ZeroUint(),
+		"", "", nil,
+		LimitOrder,
+		GetRandomBech32Addr())
+	c.Assert(book.AddOrderBookItem(ctx, *limit), IsNil)
+
+	// limit orders without an expiry get the default ttl
+	ttl := mgr.Keeper().GetConfigInt64(ctx, constants.OrderBookDefaultTTL)
+	msg, err := mgr.Keeper().GetOrderBookItem(ctx, tx.ID)
+	c.Assert(err, IsNil)
+	c.Check(msg.ExpiryHeight, Equals, 100+ttl)
+	c.Check(book.limitOrders, HasLen, 1)
+
+	// an expiry set from the memo is kept
+	tx = GetRandomTx()
+	tx.Coins = common.NewCoins(common.NewCoin(common.BTCAsset, cosmos.NewUint(1*common.One)))
+	limit = NewMsgSwap(
+		tx, common.BNBAsset, GetRandomBNBAddress(), cosmos.NewUint(856815149),
+		common.NoAddress, cosmos.ZeroUint(),
+		"", "", nil,
+		LimitOrder,
+		GetRandomBech32Addr())
+	limit.ExpiryHeight = 150
+	c.Assert(book.AddOrderBookItem(ctx, *limit), IsNil)
+	msg, err = mgr.Keeper().GetOrderBookItem(ctx, tx.ID)
+	c.Assert(err, IsNil)
+	c.Check(msg.ExpiryHeight, Equals, int64(150))
+
+	// market orders never expire
+	tx = GetRandomTx()
+	tx.Coins = common.NewCoins(common.NewCoin(common.RuneAsset(), cosmos.NewUint(2*common.One)))
+	market := NewMsgSwap(
+		tx, common.BNBAsset, GetRandomBNBAddress(), cosmos.ZeroUint(),
+		common.NoAddress, cosmos.ZeroUint(),
+		"", "", nil,
+		MarketOrder,
+		GetRandomBech32Addr())
+	c.Assert(book.AddOrderBookItem(ctx, *market), IsNil)
+	msg, err = mgr.Keeper().GetOrderBookItem(ctx, tx.ID)
+	c.Assert(err, IsNil)
+	c.Check(msg.ExpiryHeight, Equals, int64(0))
+	c.Check(book.limitOrders, HasLen, 2)
+}
+
+func (s OrderBookV114Suite) TestExpireOrders(c *C) {
+	ctx, mgr := setupManagerForTest(c)
+	ctx = ctx.WithBlockHeight(100)
+	mgr.txOutStore = NewTxStoreDummy()
+	book := newOrderBookV114(mgr.Keeper())
+
+	pool := NewPool()
+	pool.Asset = common.BTCAsset
+	pool.BalanceAsset = cosmos.NewUint(97645470445)
+	pool.BalanceRune = cosmos.NewUint(798072095218642)
+	pool.Status = PoolAvailable
+	c.Check(mgr.Keeper().SetPool(ctx, pool), IsNil)
+
+	tx := GetRandomTx()
+	tx.Chain = common.BTCChain
+	tx.FromAddress = GetRandomBTCAddress()
+	tx.Coins = common.NewCoins(common.NewCoin(common.BTCAsset, cosmos.NewUint(1*common.One)))
+	expired := NewMsgSwap(
+		tx, common.BNBAsset, GetRandomBNBAddress(), cosmos.NewUint(856815149),
+		common.NoAddress, cosmos.ZeroUint(),
+		"", "", nil,
+		LimitOrder,
+		GetRandomBech32Addr())
+	expired.ExpiryHeight = 100
+	c.Assert(mgr.Keeper().SetOrderBookItem(ctx, *expired), IsNil)
+
+	tx = GetRandomTx()
+	tx.Chain = common.BTCChain
+	tx.FromAddress = GetRandomBTCAddress()
+	tx.Coins = common.NewCoins(common.NewCoin(common.BTCAsset, cosmos.NewUint(1*common.One)))
+	pending := NewMsgSwap(
+		tx, common.BNBAsset, GetRandomBNBAddress(), cosmos.NewUint(856815149),
+		common.NoAddress, cosmos.ZeroUint(),
+		"", "", nil,
+		LimitOrder,
+		GetRandomBech32Addr())
+	pending.ExpiryHeight = 101
+	c.Assert(mgr.Keeper().SetOrderBookItem(ctx, *pending), IsNil)
+
+	book.expireOrders(ctx, mgr)
+
+	c.Check(mgr.Keeper().HasOrderBookItem(ctx, expired.Tx.ID), Equals, false)
+	c.Check(mgr.Keeper().HasOrderBookItem(ctx, pending.Tx.ID), Equals, true)
+
+	items, err := mgr.TxOutStore().GetOutboundItems(ctx)
+	c.Assert(err, IsNil)
+	c.Assert(items, HasLen, 1)
+	c.Check(items[0].InHash.Equals(expired.Tx.ID), Equals, true)
+	c.Check(items[0].ToAddress.Equals(expired.Tx.FromAddress), Equals, true)
+
+	// the remaining order expires on the next block
+	ctx = ctx.WithBlockHeight(101)
+	book.expireOrders(ctx, mgr)
+	c.Check(mgr.Keeper().HasOrderBookItem(ctx, pending.Tx.ID), Equals, false)
+}
@@ -95,6 +95,9 @@ func (smgr *StoreMgr) migrate(ctx cosmos.Context, i uint64) error {
 		migrateStoreV113(ctx, smgr.mgr)
 	case 114:
 		migrateStoreV114(ctx, smgr.mgr)
+		indexTHORNames(ctx, smgr.mgr)
+		seedPOLPools(ctx, smgr.mgr)
+		stampOrderBookExpiries(ctx, smgr.mgr)
 	}
 
 	smgr.mgr.Keeper().SetStoreVersion(ctx, int64(i))
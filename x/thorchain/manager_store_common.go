@@ -306,3 +306,121 @@ func resetObservationHeights(ctx cosmos.Context, mgr *Mgrs, version int, chain c
 	// force set chain height
 	mgr.Keeper().ForceSetLastChainHeight(ctx, chain, height)
 }
+
+// indexTHORNames builds the reverse index from alias addresses to THORNames,
+// for the THORNames that were set before the index existed.
+func indexTHORNames(ctx cosmos.Context, mgr *Mgrs) {
+	defer func() {
+		if err := recover(); err != nil {
+			ctx.Logger().Error("fail to index THORNames", "error", err)
+		}
+	}()
+
+	// collect the names first, so the store isn't written to while iterating
+	names := make([]THORName, 0)
+	iter := mgr.Keeper().GetTHORNameIterator(ctx)
+	for ; iter.Valid(); iter.Next() {
+		var name THORName
+		if err := mgr.Keeper().Cdc().Unmarshal(iter.Value(), &name); err != nil {
+			ctx.Logger().Error("fail to unmarshal THORName", "error", err)
+			continue
+		}
+		names = append(names, name)
+	}
+	iter.Close()
+
+	for _, name := range names {
+		mgr.Keeper().SetTHORName(ctx, name)
+	}
+}
+
+// seedPOLPools seeds the per pool protocol owned liquidity records from the
+// current value of the POL positions, as the rune deposited and withdrawn per
+// pool wasn't tracked before. The PnL of each pool is counted from this point on.
+func seedPOLPools(ctx cosmos.Context, mgr *Mgrs) {
+	defer func() {
+		if err := recover(); err != nil {
+			ctx.Logger().Error("fail to seed POL pools", "error", err)
+		}
+	}()
+
+	polAddress, err := mgr.Keeper().GetModuleAddress(ReserveName)
+	if err != nil {
+		ctx.Logger().Error("fail to get POL address", "error", err)
+		return
+	}
+	pools, err := mgr.Keeper().GetPools(ctx)
+	if err != nil {
+		ctx.Logger().Error("fail to get pools", "error", err)
+		return
+	}
+
+	for _, pool := range pools {
+		if pool.Asset.IsNative() {
+			continue
+		}
+		lp, err := mgr.Keeper().GetLiquidityProvider(ctx, pool.Asset, polAddress)
+		if err != nil {
+			ctx.Logger().Error("fail to get POL liquidity provider", "pool", pool.Asset, "error", err)
+			continue
+		}
+		if lp.Units.IsZero() {
+			continue
+		}
+		polPool, err := mgr.Keeper().GetPOLPool(ctx, pool.Asset)
+		if err != nil {
+			ctx.Logger().Error("fail to get POL of pool", "pool", pool.Asset, "error", err)
+			continue
+		}
+		// don't overwrite a pool that has been tracked already
+		if !polPool.RuneDeposited.IsZero() || !polPool.RuneWithdrawn.IsZero() {
+			continue
+		}
+
+		synthSupply := mgr.Keeper().GetTotalSupply(ctx, pool.Asset.GetSyntheticAsset())
+		pool.CalcUnits(mgr.GetVersion(), synthSupply)
+		polPool.RuneDeposited = common.GetSafeShare(lp.Units, pool.GetPoolUnits(), pool.BalanceRune).MulUint64(2)
+		if err := mgr.Keeper().SetPOLPool(ctx, pool.Asset, polPool); err != nil {
+			ctx.Logger().Error("fail to save POL of pool", "pool", pool.Asset, "error", err)
+		}
+	}
+}
+
+// stampOrderBookExpiries sets the default expiry on the limit orders that were
+// placed before order book items carried an expiry height, and indexes them so
+// they are swept once expired.
+func stampOrderBookExpiries(ctx cosmos.Context, mgr *Mgrs) {
+	defer func() {
+		if err := recover(); err != nil {
+			ctx.Logger().Error("fail to stamp order book expiries", "error", err)
+		}
+	}()
+
+	ttl := mgr.Keeper().GetConfigInt64(ctx, constants.OrderBookDefaultTTL)
+	if ttl <= 0 {
+		return
+	}
+
+	// collect the orders first, so the store isn't written to while iterating
+	orders := make([]MsgSwap, 0)
+	iter := mgr.Keeper().GetOrderBookItemIterator(ctx)
+	for ; iter.Valid(); iter.Next() {
+		var msg MsgSwap
+		if err := mgr.Keeper().Cdc().Unmarshal(iter.Value(), &msg); err != nil {
+			ctx.Logger().Error("fail to unmarshal order book item", "error", err)
+			continue
+		}
+		if msg.OrderType != LimitOrder || msg.ExpiryHeight > 0 {
+			continue
+		}
+		orders = append(orders, msg)
+	}
+	iter.Close()
+
+	for _, msg := range orders {
+		msg.ExpiryHeight = ctx.BlockHeight() + ttl
+		if err := mgr.Keeper().SetOrderBookItem(ctx, msg); err != nil {
+			ctx.Logger().Error("fail to save order book item", "tx_id", msg.Tx.ID, "error", err)
+		}
+	}
+}
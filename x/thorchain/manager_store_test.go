@@ -8,6 +8,8 @@ import (
 
 	"gitlab.com/thorchain/thornode/common"
 	"gitlab.com/thorchain/thornode/common/cosmos"
+	"gitlab.com/thorchain/thornode/constants"
+	"gitlab.com/thorchain/thornode/x/thorchain/types"
 )
 
 type StoreManagerTestSuite struct{}
@@ -286,6 +288,98 @@ func (s *StoreManagerTestSuite) TestMigrateStoreV94(c *C) {
 	c.Assert(poolLunaAfter.IsEmpty(), Equals, true)
 }
 
+func (s *StoreManagerTestSuite) TestSeedPOLPools(c *C) {
+	ctx, mgr := setupManagerForTest(c)
+	polAddress, err := mgr.Keeper().GetModuleAddress(ReserveName)
+	c.Assert(err, IsNil)
+
+	for _, asset := range []common.Asset{common.BTCAsset, common.BNBAsset, common.ETHAsset} {
+		pool := NewPool()
+		pool.Asset = asset
+		pool.Status = PoolAvailable
+		pool.BalanceRune = cosmos.NewUint(1000 * common.One)
+		pool.BalanceAsset = cosmos.NewUint(10 * common.One)
+		pool.LPUnits = cosmos.NewUint(1000)
+		c.Assert(mgr.Keeper().SetPool(ctx, pool), IsNil)
+
+		// POL isn't in the eth pool
+		if asset.Equals(common.ETHAsset) {
+			continue
+		}
+		mgr.Keeper().SetLiquidityProvider(ctx, LiquidityProvider{
+			Asset:             asset,
+			RuneAddress:       polAddress,
+			Units:             cosmos.NewUint(250),
+			PendingRune:       cosmos.ZeroUint(),
+			PendingAsset:      cosmos.ZeroUint(),
+			RuneDepositValue:  cosmos.ZeroUint(),
+			AssetDepositValue: cosmos.ZeroUint(),
+		})
+	}
+	// a pool that is tracked already is left alone
+	tracked := NewProtocolOwnedLiquidity()
+	tracked.RuneDeposited = cosmos.NewUint(100 * common.One)
+	c.Assert(mgr.Keeper().SetPOLPool(ctx, common.BNBAsset, tracked), IsNil)
+
+	seedPOLPools(ctx, mgr)
+
+	polPool, err := mgr.Keeper().GetPOLPool(ctx, common.BTCAsset)
+	c.Assert(err, IsNil)
+	c.Check(polPool.RuneDeposited.Uint64(), Equals, uint64(500*common.One))
+	c.Check(polPool.RuneWithdrawn.Uint64(), Equals, uint64(0))
+	c.Check(polPool.CurrentDeposit().Int64(), Equals, int64(500*common.One))
+	c.Check(polPool.PnL(cosmos.NewUint(500*common.One)).Int64(), Equals, int64(0))
+
+	polPool, err = mgr.Keeper().GetPOLPool(ctx, common.BNBAsset)
+	c.Assert(err, IsNil)
+	c.Check(polPool.RuneDeposited.Uint64(), Equals, uint64(100*common.One))
+
+	polPool, err = mgr.Keeper().GetPOLPool(ctx, common.ETHAsset)
+	c.Assert(err, IsNil)
+	c.Check(polPool.RuneDeposited.IsZero(), Equals, true)
+}
+
+func (s *StoreManagerTestSuite) TestStampOrderBookExpiries(c *C) {
+	ctx, mgr := setupManagerForTest(c)
+	ctx = ctx.WithBlockHeight(100)
+	newOrder := func(orderType types.OrderType, expiry int64) MsgSwap {
+		tx := GetRandomTx()
+		tx.Coins = common.NewCoins(common.NewCoin(common.BTCAsset, cosmos.NewUint(1*common.One)))
+		msg := NewMsgSwap(
+			tx, common.BNBAsset, GetRandomBNBAddress(), cosmos.NewUint(856815149),
+			common.NoAddress, cosmos.ZeroUint(),
+			"", "", nil,
+			orderType,
+			GetRandomBech32Addr())
+		msg.ExpiryHeight = expiry
+		c.Assert(mgr.Keeper().SetOrderBookItem(ctx, *msg), IsNil)
+		return *msg
+	}
+	legacy := newOrder(LimitOrder, 0)
+	stamped := newOrder(LimitOrder, 150)
+	market := newOrder(MarketOrder, 0)
+
+	stampOrderBookExpiries(ctx, mgr)
+
+	ttl := mgr.Keeper().GetConfigInt64(ctx, constants.OrderBookDefaultTTL)
+	msg, err := mgr.Keeper().GetOrderBookItem(ctx, legacy.Tx.ID)
+	c.Assert(err, IsNil)
+	c.Check(msg.ExpiryHeight, Equals, 100+ttl)
+	msg, err = mgr.Keeper().GetOrderBookItem(ctx, stamped.Tx.ID)
+	c.Assert(err, IsNil)
+	c.Check(msg.ExpiryHeight, Equals, int64(150))
+	msg, err = mgr.Keeper().GetOrderBookItem(ctx, market.Tx.ID)
+	c.Assert(err, IsNil)
+	c.Check(msg.ExpiryHeight, Equals, int64(0))
+
+	// the stamped order is indexed, and swept once expired
+	ctx = ctx.WithBlockHeight(100 + ttl)
+	mgr.txOutStore = NewTxStoreDummy()
+	newOrderBookV114(mgr.Keeper()).expireOrders(ctx, mgr)
+	c.Check(mgr.Keeper().HasOrderBookItem(ctx, legacy.Tx.ID), Equals, false)
+	c.Check(mgr.Keeper().HasOrderBookItem(ctx, market.Tx.ID), Equals, true)
+}
+
 // Check that the hashing behaves as expeected.
 func (s *StoreManagerTestSuite) TestMemoHash(c *C) {
 	inboundTxID := "B07A6B1B40ADBA2E404D9BCE1BEF6EDE6F70AD135E83806E4F4B6863CF637D0B"
@@ -86,18 +86,20 @@ func (items swapItems) Sort() swapItems {
 	return sorted
 }
 
-// SwapQueueV104 is going to manage the swaps queue
-type SwapQueueV104 struct {
+// SwapQueueV114 is going to manage the swaps queue
+type SwapQueueV114 struct {
 	k keeper.Keeper
 }
 
-// newSwapQueueV104 create a new vault manager
-func newSwapQueueV104(k keeper.Keeper) *SwapQueueV104 {
-	return &SwapQueueV104{k: k}
+// newSwapQueueV114 create a new vault manager
+func newSwapQueueV114(k keeper.Keeper) *SwapQueueV114 {
+	return &SwapQueueV114{k: k}
 }
 
-// FetchQueue - grabs all swap queue items from the kvstore and returns them
-func (vm *SwapQueueV104) FetchQueue(ctx cosmos.Context) (swapItems, error) { // nolint
+// FetchQueue - grabs all swap queue items from the kvstore and returns them.
+// Streaming swaps are only returned when their next sub-swap is due, and are
+// sized down to that sub-swap.
+func (vm *SwapQueueV114) FetchQueue(ctx cosmos.Context, mgr Manager) (swapItems, error) { // nolint
 	items := make(swapItems, 0)
 	iterator := vm.k.GetSwapQueueIterator(ctx)
 	defer iterator.Close()
@@ -115,6 +117,18 @@ func (vm *SwapQueueV104) FetchQueue(ctx cosmos.Context) (swapItems, error) { //
 			continue
 		}
 
+		if msg.IsStreaming() {
+			swp, err := vm.getStreamingSwap(ctx, mgr, msg)
+			if err != nil {
+				ctx.Logger().Error("fail to get streaming swap", "tx", msg.Tx.ID, "error", err)
+				continue
+			}
+			if !swp.IsReady(ctx.BlockHeight()) {
+				continue
+			}
+			msg.Tx.Coins[0].Amount, msg.TradeTarget = swp.NextSize()
+		}
+
 		items = append(items, swapItem{
 			msg:   msg,
 			index: i,
@@ -126,8 +140,31 @@ func (vm *SwapQueueV104) FetchQueue(ctx cosmos.Context) (swapItems, error) { //
 	return items, nil
 }
 
+// getStreamingSwap returns the streaming swap record of the given msg, creating
+// it ahead of the first sub-swap
+func (vm *SwapQueueV114) getStreamingSwap(ctx cosmos.Context, mgr Manager, msg MsgSwap) (StreamingSwap, error) {
+	if vm.k.HasStreamingSwap(ctx, msg.Tx.ID) {
+		return vm.k.GetStreamingSwap(ctx, msg.Tx.ID)
+	}
+
+	swp := NewStreamingSwap(msg.Tx.ID, msg.StreamQuantity, msg.StreamInterval, msg.TradeTarget, msg.Tx.Coins[0].Amount)
+	maxQuantity, err := getMaxSwapQuantity(ctx, mgr, msg.Tx.Coins[0].Asset, msg.TargetAsset, swp)
+	if err != nil {
+		ctx.Logger().Error("fail to get max streaming swap quantity", "error", err)
+		maxQuantity = 1
+	}
+	if swp.Quantity == 0 || swp.Quantity > maxQuantity {
+		swp.Quantity = maxQuantity
+	}
+	if err := swp.Valid(); err != nil {
+		return swp, err
+	}
+	vm.k.SetStreamingSwap(ctx, swp)
+	return swp, nil
+}
+
 // EndBlock trigger the real swap to be processed
-func (vm *SwapQueueV104) EndBlock(ctx cosmos.Context, mgr Manager) error {
+func (vm *SwapQueueV114) EndBlock(ctx cosmos.Context, mgr Manager) error {
 	handler := NewInternalHandler(mgr)
 
 	minSwapsPerBlock, err := vm.k.GetMimir(ctx, constants.MinSwapsPerBlock.String())
@@ -143,7 +180,7 @@ func (vm *SwapQueueV104) EndBlock(ctx cosmos.Context, mgr Manager) error {
 		synthVirtualDepthMult = mgr.GetConstants().GetInt64Value(constants.VirtualMultSynthsBasisPoints)
 	}
 
-	swaps, err := vm.FetchQueue(ctx)
+	swaps, err := vm.FetchQueue(ctx, mgr)
 	if err != nil {
 		ctx.Logger().Error("fail to fetch swap queue from store", "error", err)
 		return err
@@ -158,32 +195,116 @@ func (vm *SwapQueueV104) EndBlock(ctx cosmos.Context, mgr Manager) error {
 	for i := int64(0); i < vm.getTodoNum(int64(len(swaps)), minSwapsPerBlock, maxSwapsPerBlock); i++ {
 		pick := swaps[i]
 		_, err := handler(ctx, &pick.msg)
+		if pick.msg.IsStreaming() {
+			vm.processStreamingSwap(ctx, mgr, pick, err)
+			continue
+		}
 		if err != nil {
 			ctx.Logger().Error("fail to swap", "msg", pick.msg.Tx.String(), "error", err)
+			if refundErr := vm.refund(ctx, mgr, pick.msg.Tx, err.Error()); refundErr != nil {
+				ctx.Logger().Error("fail to refund swap", "error", refundErr)
+			}
+		}
+		vm.k.RemoveSwapQueueItem(ctx, pick.msg.Tx.ID, pick.index)
+	}
+	return nil
+}
 
-			var refundErr error
+// processStreamingSwap records the result of a streaming sub-swap, and settles
+// the streaming swap once all of its sub-swaps have been attempted
+func (vm *SwapQueueV114) processStreamingSwap(ctx cosmos.Context, mgr Manager, pick swapItem, swapErr error) {
+	// the swap handler has already recorded the in/out amounts of a successful
+	// sub-swap
+	swp, err := vm.k.GetStreamingSwap(ctx, pick.msg.Tx.ID)
+	if err != nil {
+		ctx.Logger().Error("fail to get streaming swap", "tx", pick.msg.Tx.ID, "error", err)
+		return
+	}
+	swp.Count++
+	swp.LastHeight = ctx.BlockHeight()
+	if swapErr != nil {
+		ctx.Logger().Error("fail to streaming swap", "msg", pick.msg.Tx.String(), "count", swp.Count, "error", swapErr)
+		swp.FailedSwaps = append(swp.FailedSwaps, swp.Count)
+		swp.FailedSwapReasons = append(swp.FailedSwapReasons, swapErr.Error())
+	}
 
-			// Get the full ObservedTx from the TxID, for the vault ObservedPubKey to first try to refund from.
-			voter, voterErr := mgr.Keeper().GetObservedTxInVoter(ctx, pick.msg.Tx.ID)
-			if voterErr == nil && !voter.Tx.IsEmpty() {
-				refundErr = refundTx(ctx, ObservedTx{Tx: pick.msg.Tx, ObservedPubKey: voter.Tx.ObservedPubKey}, mgr, CodeSwapFail, err.Error(), "")
-			} else {
-				// If the full ObservedTx could not be retrieved, proceed with just the MsgSwap's Tx (no ObservedPubKey).
-				ctx.Logger().Error("fail to get non-empty observed tx", "error", voterErr)
-				refundErr = refundTx(ctx, ObservedTx{Tx: pick.msg.Tx}, mgr, CodeSwapFail, err.Error(), "")
-			}
+	if !swp.IsDone() {
+		vm.k.SetStreamingSwap(ctx, swp)
+		return
+	}
 
-			if nil != refundErr {
-				ctx.Logger().Error("fail to refund swap", "error", err)
-			}
+	if err := vm.settleStreamingSwap(ctx, mgr, pick.msg, swp); err != nil {
+		ctx.Logger().Error("fail to settle streaming swap", "tx", pick.msg.Tx.ID, "error", err)
+	}
+	vm.k.RemoveStreamingSwap(ctx, pick.msg.Tx.ID)
+	vm.k.RemoveSwapQueueItem(ctx, pick.msg.Tx.ID, pick.index)
+}
+
+// settleStreamingSwap refunds the part of the deposit that was never swapped,
+// and pays out the output accumulated by all sub-swaps in a single outbound
+func (vm *SwapQueueV114) settleStreamingSwap(ctx cosmos.Context, mgr Manager, msg MsgSwap, swp StreamingSwap) error {
+	source := msg.Tx.Coins[0].Asset
+	evt := NewEventStreamingSwap(source, msg.TargetAsset, swp)
+	if err := mgr.EventMgr().EmitEvent(ctx, evt); err != nil {
+		ctx.Logger().Error("fail to emit streaming swap event", "error", err)
+	}
+
+	if remaining := swp.RemainingIn(); !remaining.IsZero() {
+		tx := msg.Tx
+		tx.Coins = common.NewCoins(common.NewCoin(source, remaining))
+		reason := "streaming swap incomplete"
+		if len(swp.FailedSwapReasons) > 0 {
+			reason = swp.FailedSwapReasons[len(swp.FailedSwapReasons)-1]
+		}
+		if err := vm.refund(ctx, mgr, tx, reason); err != nil {
+			ctx.Logger().Error("fail to refund streaming swap", "error", err)
 		}
-		vm.k.RemoveSwapQueueItem(ctx, pick.msg.Tx.ID, pick.index)
+	}
+
+	// sub-swaps to a noop destination have already been settled by the swapper
+	if swp.Out.IsZero() || msg.Destination.IsNoop() {
+		return nil
+	}
+	toi := TxOutItem{
+		Chain:                 msg.TargetAsset.GetChain(),
+		InHash:                msg.Tx.ID,
+		ToAddress:             msg.Destination,
+		Coin:                  common.NewCoin(msg.TargetAsset, swp.Out),
+		AggregatorTargetAsset: msg.AggregatorTargetAddress,
+		AggregatorTargetLimit: msg.AggregatorTargetLimit,
+	}
+	if len(msg.Aggregator) > 0 {
+		dexAgg, err := FetchDexAggregator(mgr.GetVersion(), msg.TargetAsset.Chain, msg.Aggregator)
+		if err != nil {
+			ctx.Logger().Error("fail to fetch dex aggregator, ignore it", "aggregator", msg.Aggregator, "error", err)
+		} else {
+			toi.Aggregator = dexAgg
+		}
+	}
+	ok, err := mgr.TxOutStore().TryAddTxOutItem(ctx, mgr, toi, cosmos.ZeroUint())
+	if err != nil {
+		return ErrInternal(err, "fail to add outbound tx")
+	}
+	if !ok {
+		return errFailAddOutboundTx
 	}
 	return nil
 }
 
+// refund the given swap tx back to the sender
+func (vm *SwapQueueV114) refund(ctx cosmos.Context, mgr Manager, tx common.Tx, reason string) error {
+	// Get the full ObservedTx from the TxID, for the vault ObservedPubKey to first try to refund from.
+	voter, voterErr := mgr.Keeper().GetObservedTxInVoter(ctx, tx.ID)
+	if voterErr == nil && !voter.Tx.IsEmpty() {
+		return refundTx(ctx, ObservedTx{Tx: tx, ObservedPubKey: voter.Tx.ObservedPubKey}, mgr, CodeSwapFail, reason, "")
+	}
+	// If the full ObservedTx could not be retrieved, proceed with just the MsgSwap's Tx (no ObservedPubKey).
+	ctx.Logger().Error("fail to get non-empty observed tx", "error", voterErr)
+	return refundTx(ctx, ObservedTx{Tx: tx}, mgr, CodeSwapFail, reason, "")
+}
+
 // getTodoNum - determine how many swaps to do.
-func (vm *SwapQueueV104) getTodoNum(queueLen, minSwapsPerBlock, maxSwapsPerBlock int64) int64 {
+func (vm *SwapQueueV114) getTodoNum(queueLen, minSwapsPerBlock, maxSwapsPerBlock int64) int64 {
 	// Do half the length of the queue. Unless...
 	//	1. The queue length is greater than maxSwapsPerBlock
 	//  2. The queue legnth is less than minSwapsPerBlock
@@ -199,7 +320,7 @@ func (vm *SwapQueueV104) getTodoNum(queueLen, minSwapsPerBlock, maxSwapsPerBlock
 
 // scoreMsgs - this takes a list of MsgSwap, and converts them to a scored
 // swapItem list
-func (vm *SwapQueueV104) scoreMsgs(ctx cosmos.Context, items swapItems, synthVirtualDepthMult int64) (swapItems, error) {
+func (vm *SwapQueueV114) scoreMsgs(ctx cosmos.Context, items swapItems, synthVirtualDepthMult int64) (swapItems, error) {
 	pools := make(map[common.Asset]Pool)
 
 	for i, item := range items {
@@ -263,7 +384,7 @@ func (vm *SwapQueueV104) scoreMsgs(ctx cosmos.Context, items swapItems, synthVir
 }
 
 // getLiquidityFeeAndSlip calculate liquidity fee and slip, fee is in RUNE
-func (vm *SwapQueueV104) getLiquidityFeeAndSlip(ctx cosmos.Context, pool Pool, sourceCoin common.Coin, item *swapItem, virtualDepthMult int64) {
+func (vm *SwapQueueV114) getLiquidityFeeAndSlip(ctx cosmos.Context, pool Pool, sourceCoin common.Coin, item *swapItem, virtualDepthMult int64) {
 	// Get our X, x, Y values
 	var X, x, Y cosmos.Uint
 	x = sourceCoin.Amount
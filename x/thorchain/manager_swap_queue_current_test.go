@@ -8,12 +8,12 @@ import (
 	"gitlab.com/thorchain/thornode/x/thorchain/keeper"
 )
 
-type SwapQueueV104Suite struct{}
+type SwapQueueV114Suite struct{}
 
-var _ = Suite(&SwapQueueV104Suite{})
+var _ = Suite(&SwapQueueV114Suite{})
 
-func (s SwapQueueV104Suite) TestGetTodoNum(c *C) {
-	queue := newSwapQueueV104(keeper.KVStoreDummy{})
+func (s SwapQueueV114Suite) TestGetTodoNum(c *C) {
+	queue := newSwapQueueV114(keeper.KVStoreDummy{})
 
 	c.Check(queue.getTodoNum(50, 10, 100), Equals, int64(25))     // halves it
 	c.Check(queue.getTodoNum(11, 10, 100), Equals, int64(5))      // halves it
@@ -24,7 +24,7 @@ func (s SwapQueueV104Suite) TestGetTodoNum(c *C) {
 	c.Check(queue.getTodoNum(200, 10, 100), Equals, int64(100))   // does max 100
 }
 
-func (s SwapQueueV104Suite) TestScoreMsgs(c *C) {
+func (s SwapQueueV114Suite) TestScoreMsgs(c *C) {
 	ctx, k := setupKeeperForTest(c)
 
 	pool := NewPool()
@@ -44,7 +44,7 @@ func (s SwapQueueV104Suite) TestScoreMsgs(c *C) {
 	pool.Status = PoolStaged
 	c.Assert(k.SetPool(ctx, pool), IsNil)
 
-	queue := newSwapQueueV104(k)
+	queue := newSwapQueueV114(k)
 
 	// check that we sort by liquidity ok
 	msgs := []*MsgSwap{
@@ -260,3 +260,70 @@ func (s SwapQueueV104Suite) TestScoreMsgs(c *C) {
 	c.Check(swaps[10].msg.Tx.Coins[0].Amount.Equal(cosmos.NewUint(1*common.One)), Equals, true, Commentf("%d", swaps[10].msg.Tx.Coins[0].Amount.Uint64()))
 	c.Check(swaps[10].msg.Tx.Coins[0].Asset.Equals(common.BNBAsset), Equals, true)
 }
+
+func (s SwapQueueV114Suite) TestFetchQueueStreamingSwap(c *C) {
+	ctx, k := setupKeeperForTest(c)
+	mgr := NewDummyMgrWithKeeper(k)
+
+	pool := NewPool()
+	pool.Asset = common.BNBAsset
+	pool.BalanceRune = cosmos.NewUint(10000 * common.One)
+	pool.BalanceAsset = cosmos.NewUint(10000 * common.One)
+	c.Assert(k.SetPool(ctx, pool), IsNil)
+
+	queue := newSwapQueueV114(k)
+
+	msg := NewMsgSwap(common.Tx{
+		ID:    GetRandomTxHash(),
+		Coins: common.Coins{common.NewCoin(common.RuneAsset(), cosmos.NewUint(100*common.One))},
+	}, common.BNBAsset, GetRandomBNBAddress(), cosmos.NewUint(80*common.One), common.NoAddress, cosmos.ZeroUint(),
+		"", "", nil,
+		MarketOrder,
+		GetRandomBech32Addr())
+	msg.StreamInterval = 5
+	msg.StreamQuantity = 4
+	c.Assert(k.SetSwapQueueItem(ctx, *msg, 0), IsNil)
+
+	// the first sub-swap is due right away
+	swaps, err := queue.FetchQueue(ctx, mgr)
+	c.Assert(err, IsNil)
+	c.Assert(swaps, HasLen, 1)
+	c.Check(swaps[0].msg.Tx.Coins[0].Amount.Uint64(), Equals, uint64(25*common.One))
+	c.Check(swaps[0].msg.TradeTarget.Uint64(), Equals, uint64(20*common.One))
+
+	swp, err := k.GetStreamingSwap(ctx, msg.Tx.ID)
+	c.Assert(err, IsNil)
+	c.Check(swp.Quantity, Equals, uint64(4))
+	c.Check(swp.Interval, Equals, uint64(5))
+	c.Check(swp.Deposit.Uint64(), Equals, uint64(100*common.One))
+
+	// record a successful sub-swap, next one isn't due until the interval passed
+	swp.In = cosmos.NewUint(25 * common.One)
+	swp.Out = cosmos.NewUint(24 * common.One)
+	swp.Count = 1
+	swp.LastHeight = ctx.BlockHeight()
+	k.SetStreamingSwap(ctx, swp)
+
+	swaps, err = queue.FetchQueue(ctx, mgr)
+	c.Assert(err, IsNil)
+	c.Assert(swaps, HasLen, 0)
+
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 5)
+	swaps, err = queue.FetchQueue(ctx, mgr)
+	c.Assert(err, IsNil)
+	c.Assert(swaps, HasLen, 1)
+	c.Check(swaps[0].msg.Tx.Coins[0].Amount.Uint64(), Equals, uint64(25*common.One))
+
+	// quantity is capped by the min fee each sub-swap should pay
+	msg = NewMsgSwap(common.Tx{
+		ID:    GetRandomTxHash(),
+		Coins: common.Coins{common.NewCoin(common.RuneAsset(), cosmos.NewUint(100*common.One))},
+	}, common.BNBAsset, GetRandomBNBAddress(), cosmos.ZeroUint(), common.NoAddress, cosmos.ZeroUint(),
+		"", "", nil,
+		MarketOrder,
+		GetRandomBech32Addr())
+	msg.StreamInterval = 1
+	swp, err = queue.getStreamingSwap(ctx, mgr, *msg)
+	c.Assert(err, IsNil)
+	c.Check(swp.Quantity, Equals, uint64(20))
+}
@@ -40,6 +40,7 @@ type Manager interface {
 	PoolMgr() PoolManager
 	SwapQ() SwapQueue
 	OrderBookMgr() OrderBook
+	LendingMgr() LendingManager
 	Slasher() Slasher
 	YggManager() YggManager
 }
@@ -120,6 +121,15 @@ type SwapQueue interface {
 // OrderBook interface define the contract of Order Book
 type OrderBook interface {
 	EndBlock(ctx cosmos.Context, mgr Manager) error
+	AddOrderBookItem(ctx cosmos.Context, msg MsgSwap) error
+}
+
+// LendingManager interface define the contract of Lending Manager
+type LendingManager interface {
+	EndBlock(ctx cosmos.Context, mgr Manager) error
+	InterestRate(ctx cosmos.Context, asset common.Asset) (cosmos.Uint, error)
+	AccruedInterest(ctx cosmos.Context, loan Loan) (cosmos.Uint, error)
+	AccrueInterest(ctx cosmos.Context, loan Loan) (Loan, error)
 }
 
 // Slasher define all the method to perform slash
@@ -151,6 +161,7 @@ type Mgrs struct {
 	poolMgr        PoolManager
 	swapQ          SwapQueue
 	orderBook      OrderBook
+	lendingMgr     LendingManager
 	slasher        Slasher
 	yggManager     YggManager
 
@@ -265,6 +276,11 @@ func (mgr *Mgrs) BeginBlock(ctx cosmos.Context) error {
 		return fmt.Errorf("fail to create order book: %w", err)
 	}
 
+	mgr.lendingMgr, err = GetLendingManager(v, mgr.K, mgr.eventMgr)
+	if err != nil {
+		return fmt.Errorf("fail to create lending manager: %w", err)
+	}
+
 	mgr.slasher, err = GetSlasher(v, mgr.K, mgr.eventMgr)
 	if err != nil {
 		return fmt.Errorf("fail to create swap queue: %w", err)
@@ -307,6 +323,9 @@ func (mgr *Mgrs) SwapQ() SwapQueue { return mgr.swapQ }
 // OrderBookMgr
 func (mgr *Mgrs) OrderBookMgr() OrderBook { return mgr.orderBook }
 
+// LendingMgr return the lending manager
+func (mgr *Mgrs) LendingMgr() LendingManager { return mgr.lendingMgr }
+
 // Slasher return an implementation of Slasher
 func (mgr *Mgrs) Slasher() Slasher { return mgr.slasher }
 
@@ -399,6 +418,8 @@ func GetTxOutStore(version semver.Version, keeper keeper.Keeper, eventMgr EventM
 // GetNetworkManager  retrieve a NetworkManager that is compatible with the given version
 func GetNetworkManager(version semver.Version, keeper keeper.Keeper, txOutStore TxOutStore, eventMgr EventManager) (NetworkManager, error) {
 	switch {
+	case version.GTE(semver.MustParse("1.114.0")):
+		return newNetworkMgrV114(keeper, txOutStore, eventMgr), nil
 	case version.GTE(semver.MustParse("1.112.0")):
 		return newNetworkMgrV112(keeper, txOutStore, eventMgr), nil
 	case version.GTE(semver.MustParse("1.111.0")):
@@ -496,6 +517,8 @@ func GetPoolManager(version semver.Version) (PoolManager, error) {
 // GetSwapQueue retrieve a SwapQueue that is compatible with the given version
 func GetSwapQueue(version semver.Version, keeper keeper.Keeper) (SwapQueue, error) {
 	switch {
+	case version.GTE(semver.MustParse("1.114.0")):
+		return newSwapQueueV114(keeper), nil
 	case version.GTE(semver.MustParse("1.104.0")):
 		return newSwapQueueV104(keeper), nil
 	case version.GTE(semver.MustParse("1.103.0")):
@@ -514,6 +537,8 @@ func GetSwapQueue(version semver.Version, keeper keeper.Keeper) (SwapQueue, erro
 // GetOrderBook retrieve a OrderBook that is compatible with the given version
 func GetOrderBook(version semver.Version, keeper keeper.Keeper) (OrderBook, error) {
 	switch {
+	case version.GTE(semver.MustParse("1.114.0")):
+		return newOrderBookV114(keeper), nil
 	case version.GTE(semver.MustParse("1.104.0")):
 		return newOrderBookV104(keeper), nil
 	case version.GTE(semver.MustParse("1.103.0")):
@@ -525,6 +550,18 @@ func GetOrderBook(version semver.Version, keeper keeper.Keeper) (OrderBook, erro
 	}
 }
 
+// GetLendingManager return an implementation of LendingManager
+func GetLendingManager(version semver.Version, keeper keeper.Keeper, eventMgr EventManager) (LendingManager, error) {
+	switch {
+	case version.GTE(semver.MustParse("1.114.0")):
+		return newLendingMgrV114(keeper, eventMgr), nil
+	case version.GTE(semver.MustParse("0.1.0")):
+		return newLendingMgrV1(), nil
+	default:
+		return nil, errInvalidVersion
+	}
+}
+
 // GetSlasher return an implementation of Slasher
 func GetSlasher(version semver.Version, keeper keeper.Keeper, eventMgr EventManager) (Slasher, error) {
 	switch {
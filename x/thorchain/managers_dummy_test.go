@@ -18,6 +18,7 @@ type DummyMgr struct {
 	poolMgr       PoolManager
 	swapQ         SwapQueue
 	orderBook     OrderBook
+	lendingMgr    LendingManager
 	slasher       Slasher
 	yggManager    YggManager
 }
@@ -72,3 +73,4 @@ func (m DummyMgr) SwapQ() SwapQueue                       { return m.swapQ }
 func (m DummyMgr) Slasher() Slasher                       { return m.slasher }
 func (m DummyMgr) YggManager() YggManager                 { return m.yggManager }
 func (m DummyMgr) OrderBookMgr() OrderBook                { return m.orderBook }
+func (m DummyMgr) LendingMgr() LendingManager             { return m.lendingMgr }
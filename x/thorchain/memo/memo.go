@@ -10,6 +10,7 @@ import (
 	"gitlab.com/thorchain/thornode/common/cosmos"
 	"gitlab.com/thorchain/thornode/constants"
 	"gitlab.com/thorchain/thornode/x/thorchain/keeper"
+	"gitlab.com/thorchain/thornode/x/thorchain/types"
 
 	"github.com/blang/semver"
 )
@@ -41,6 +42,7 @@ const (
 	TxTHORName
 	TxLoanOpen
 	TxLoanRepayment
+	TxModifyLimitOrder
 )
 
 var stringToTxTypeMap = map[string]TxType{
@@ -76,30 +78,33 @@ var stringToTxTypeMap = map[string]TxType{
 	"loan+":       TxLoanOpen,
 	"$-":          TxLoanRepayment,
 	"loan-":       TxLoanRepayment,
+	"modify":      TxModifyLimitOrder,
+	"m":           TxModifyLimitOrder,
 }
 
 var txToStringMap = map[TxType]string{
-	TxAdd:             "add",
-	TxWithdraw:        "withdraw",
-	TxSwap:            "swap",
-	TxLimitOrder:      "limito",
-	TxOutbound:        "out",
-	TxRefund:          "refund",
-	TxDonate:          "donate",
-	TxBond:            "bond",
-	TxUnbond:          "unbond",
-	TxLeave:           "leave",
-	TxYggdrasilFund:   "yggdrasil+",
-	TxYggdrasilReturn: "yggdrasil-",
-	TxReserve:         "reserve",
-	TxMigrate:         "migrate",
-	TxRagnarok:        "ragnarok",
-	TxSwitch:          "switch",
-	TxNoOp:            "noop",
-	TxConsolidate:     "consolidate",
-	TxTHORName:        "thorname",
-	TxLoanOpen:        "$+",
-	TxLoanRepayment:   "$-",
+	TxAdd:              "add",
+	TxWithdraw:         "withdraw",
+	TxSwap:             "swap",
+	TxLimitOrder:       "limito",
+	TxOutbound:         "out",
+	TxRefund:           "refund",
+	TxDonate:           "donate",
+	TxBond:             "bond",
+	TxUnbond:           "unbond",
+	TxLeave:            "leave",
+	TxYggdrasilFund:    "yggdrasil+",
+	TxYggdrasilReturn:  "yggdrasil-",
+	TxReserve:          "reserve",
+	TxMigrate:          "migrate",
+	TxRagnarok:         "ragnarok",
+	TxSwitch:           "switch",
+	TxNoOp:             "noop",
+	TxConsolidate:      "consolidate",
+	TxTHORName:         "thorname",
+	TxLoanOpen:         "$+",
+	TxLoanRepayment:    "$-",
+	TxModifyLimitOrder: "modify",
 }
 
 // converts a string into a txType
@@ -115,7 +120,7 @@ func StringToTxType(s string) (TxType, error) {
 
 func (tx TxType) IsInbound() bool {
 	switch tx {
-	case TxAdd, TxWithdraw, TxSwap, TxLimitOrder, TxDonate, TxBond, TxUnbond, TxLeave, TxSwitch, TxReserve, TxNoOp, TxTHORName, TxLoanOpen, TxLoanRepayment:
+	case TxAdd, TxWithdraw, TxSwap, TxLimitOrder, TxDonate, TxBond, TxUnbond, TxLeave, TxSwitch, TxReserve, TxNoOp, TxTHORName, TxLoanOpen, TxLoanRepayment, TxModifyLimitOrder:
 		return true
 	default:
 		return false
@@ -143,7 +148,7 @@ func (tx TxType) IsInternal() bool {
 // HasOutbound whether the txtype might trigger outbound tx
 func (tx TxType) HasOutbound() bool {
 	switch tx {
-	case TxAdd, TxBond, TxDonate, TxYggdrasilReturn, TxReserve, TxMigrate, TxRagnarok, TxSwitch:
+	case TxAdd, TxBond, TxDonate, TxYggdrasilReturn, TxReserve, TxMigrate, TxRagnarok, TxSwitch, TxModifyLimitOrder:
 		return false
 	default:
 		return true
@@ -289,6 +294,11 @@ func ParseMemo(version semver.Version, memo string) (mem Memo, err error) {
 		return ParseLoanOpenMemo(cosmos.Context{}, version, nil, asset, parts)
 	case TxLoanRepayment:
 		return ParseLoanRepaymentMemo(cosmos.Context{}, version, nil, asset, parts)
+	case TxModifyLimitOrder:
+		if version.LT(semver.MustParse("1.114.0")) {
+			return mem, fmt.Errorf("TxType not supported: %s", mem.GetType().String())
+		}
+		return ParseModifyLimitOrderMemo(parts)
 	default:
 		return mem, fmt.Errorf("TxType not supported: %s", mem.GetType().String())
 	}
@@ -352,6 +362,11 @@ func ParseMemoWithTHORNames(ctx cosmos.Context, keeper keeper.Keeper, memo strin
 		return ParseLoanOpenMemo(ctx, keeper.GetVersion(), keeper, asset, parts)
 	case TxLoanRepayment:
 		return ParseLoanRepaymentMemo(ctx, keeper.GetVersion(), keeper, asset, parts)
+	case TxModifyLimitOrder:
+		if keeper.GetVersion().LT(semver.MustParse("1.114.0")) {
+			return mem, fmt.Errorf("TxType not supported: %s", mem.GetType().String())
+		}
+		return ParseModifyLimitOrderMemo(parts)
 	default:
 		return mem, fmt.Errorf("TxType not supported: %s", mem.GetType().String())
 	}
@@ -364,6 +379,10 @@ func FetchAddress(ctx cosmos.Context, keeper keeper.Keeper, name string, chain c
 		return addr, nil
 	}
 
+	if keeper.GetVersion().GTE(semver.MustParse("1.114.0")) {
+		return fetchAddressV114(ctx, keeper, name, chain)
+	}
+
 	parts := strings.SplitN(name, ".", 2)
 	if len(parts) > 1 {
 		chain, err = common.NewChain(parts[1])
@@ -383,7 +402,31 @@ func FetchAddress(ctx cosmos.Context, keeper keeper.Keeper, name string, chain c
 	return common.NoAddress, fmt.Errorf("%s is not recognizable", name)
 }
 
-func ParseAffiliateBasisPoints(ctx cosmos.Context, keeper keeper.Keeper, affBasisPoints string) (cosmos.Uint, error) {
+func fetchAddressV114(ctx cosmos.Context, keeper keeper.Keeper, name string, chain common.Chain) (common.Address, error) {
+	// sub-names are separated from their parent by a dot (alice.wallet), so
+	// the last part is only taken as a chain suffix (alice.wallet.BTC) when it
+	// is a known chain
+	if i := strings.LastIndex(name, "."); i > 0 {
+		if c, err := common.NewChain(name[i+1:]); err == nil && c.IsKnown() {
+			name = name[:i]
+			chain = c
+		}
+	}
+
+	if keeper.THORNameExists(ctx, name) {
+		thorname, err := keeper.GetTHORName(ctx, name)
+		if err != nil {
+			return common.NoAddress, err
+		}
+		return thorname.GetAlias(chain), nil
+	}
+
+	return common.NoAddress, fmt.Errorf("%s is not recognizable", name)
+}
+
+// getMaxAffiliateFeeBasisPoints returns the max affiliate fee basis points,
+// which can be lowered by mimir
+func getMaxAffiliateFeeBasisPoints(ctx cosmos.Context, keeper keeper.Keeper) int64 {
 	maxAffFeeBasisPoints := int64(10_000)
 	if keeper != nil {
 		mimirMaxAffFeeBasisPoints, err := keeper.GetMimir(ctx, constants.MaxAffiliateFeeBasisPoints.String())
@@ -391,6 +434,11 @@ func ParseAffiliateBasisPoints(ctx cosmos.Context, keeper keeper.Keeper, affBasi
 			maxAffFeeBasisPoints = mimirMaxAffFeeBasisPoints
 		}
 	}
+	return maxAffFeeBasisPoints
+}
+
+func ParseAffiliateBasisPoints(ctx cosmos.Context, keeper keeper.Keeper, affBasisPoints string) (cosmos.Uint, error) {
+	maxAffFeeBasisPoints := getMaxAffiliateFeeBasisPoints(ctx, keeper)
 
 	pts, err := strconv.ParseUint(affBasisPoints, 10, 64)
 	if err != nil {
@@ -402,6 +450,69 @@ func ParseAffiliateBasisPoints(ctx cosmos.Context, keeper keeper.Keeper, affBasi
 	return cosmos.NewUint(pts), nil
 }
 
+// ParseAffiliates parses the affiliate addresses and basis points of a memo.
+// Several affiliates can be given by separating their addresses and basis
+// points with a "/", ie `aff1/aff2:10/5`, in which case their basis points
+// summed together cannot exceed the max affiliate fee.
+func ParseAffiliates(ctx cosmos.Context, keeper keeper.Keeper, affAddrs, affBasisPoints string) ([]types.Affiliate, error) {
+	addrs := strings.Split(affAddrs, "/")
+	pts := strings.Split(affBasisPoints, "/")
+	if len(addrs) != len(pts) {
+		return nil, fmt.Errorf("affiliate addresses (%d) and basis points (%d) don't match", len(addrs), len(pts))
+	}
+
+	total := cosmos.ZeroUint()
+	affiliates := make([]types.Affiliate, len(addrs))
+	for i := range addrs {
+		var addr common.Address
+		var err error
+		if keeper == nil {
+			addr, err = common.NewAddress(addrs[i])
+		} else {
+			addr, err = FetchAddress(ctx, keeper, addrs[i], common.THORChain)
+		}
+		if err != nil {
+			return nil, err
+		}
+		bps, err := ParseAffiliateBasisPoints(ctx, keeper, pts[i])
+		if err != nil {
+			return nil, err
+		}
+		affiliates[i] = types.NewAffiliate(addr, bps)
+		// keep track of the THORName the fee is directed at, so it can be
+		// accrued and paid out in its preferred asset
+		if _, err := common.NewAddress(addrs[i]); err != nil && keeper != nil && keeper.THORNameExists(ctx, addrs[i]) {
+			affiliates[i].THORName = addrs[i]
+		}
+		total = total.Add(bps)
+	}
+
+	maxAffFeeBasisPoints := getMaxAffiliateFeeBasisPoints(ctx, keeper)
+	if total.GT(cosmos.NewUint(uint64(maxAffFeeBasisPoints))) {
+		return nil, fmt.Errorf("total affiliate fee basis points (%s) can't be more than %d", total, maxAffFeeBasisPoints)
+	}
+	return affiliates, nil
+}
+
+// affiliatesString returns the affiliate addresses and basis points parts of a
+// memo, joining them with a "/" when there are several affiliates. Affiliates
+// given as a THORName are written as such.
+func affiliatesString(affAddr common.Address, affPts cosmos.Uint, affiliates []types.Affiliate) (string, string) {
+	if len(affiliates) == 0 {
+		return affAddr.String(), affPts.String()
+	}
+	addrs := make([]string, len(affiliates))
+	pts := make([]string, len(affiliates))
+	for i, aff := range affiliates {
+		addrs[i] = aff.Address.String()
+		if aff.THORName != "" {
+			addrs[i] = aff.THORName
+		}
+		pts[i] = aff.BasisPoints.String()
+	}
+	return strings.Join(addrs, "/"), strings.Join(pts, "/")
+}
+
 // Safe accessor for split memo parts - always returns empty
 // string for indices that are out of bounds.
 func GetPart(parts []string, idx int) string {
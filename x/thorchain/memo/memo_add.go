@@ -7,6 +7,7 @@ import (
 	"gitlab.com/thorchain/thornode/common"
 	cosmos "gitlab.com/thorchain/thornode/common/cosmos"
 	"gitlab.com/thorchain/thornode/x/thorchain/keeper"
+	"gitlab.com/thorchain/thornode/x/thorchain/types"
 )
 
 type AddLiquidityMemo struct {
@@ -14,9 +15,11 @@ type AddLiquidityMemo struct {
 	Address              common.Address
 	AffiliateAddress     common.Address
 	AffiliateBasisPoints cosmos.Uint
+	Affiliates           []types.Affiliate
 }
 
-func (m AddLiquidityMemo) GetDestination() common.Address { return m.Address }
+func (m AddLiquidityMemo) GetDestination() common.Address   { return m.Address }
+func (m AddLiquidityMemo) GetAffiliates() []types.Affiliate { return m.Affiliates }
 
 func (m AddLiquidityMemo) String() string {
 	txType := m.TxType.String()
@@ -24,12 +27,14 @@ func (m AddLiquidityMemo) String() string {
 		txType = "+"
 	}
 
+	affAddr, affPts := affiliatesString(m.AffiliateAddress, m.AffiliateBasisPoints, m.Affiliates)
+
 	args := []string{
 		txType,
 		m.Asset.String(),
 		m.Address.String(),
-		m.AffiliateAddress.String(),
-		m.AffiliateBasisPoints.String(),
+		affAddr,
+		affPts,
 	}
 
 	last := 2
@@ -57,6 +62,8 @@ func ParseAddLiquidityMemo(ctx cosmos.Context, keeper keeper.Keeper, asset commo
 		return ParseAddLiquidityMemoV1(ctx, keeper, asset, parts)
 	}
 	switch {
+	case keeper.GetVersion().GTE(semver.MustParse("1.114.0")):
+		return ParseAddLiquidityMemoV114(ctx, keeper, asset, parts)
 	case keeper.GetVersion().GTE(semver.MustParse("1.104.0")):
 		return ParseAddLiquidityMemoV104(ctx, keeper, asset, parts)
 	default:
@@ -64,7 +71,7 @@ func ParseAddLiquidityMemo(ctx cosmos.Context, keeper keeper.Keeper, asset commo
 	}
 }
 
-func ParseAddLiquidityMemoV104(ctx cosmos.Context, keeper keeper.Keeper, asset common.Asset, parts []string) (AddLiquidityMemo, error) {
+func ParseAddLiquidityMemoV114(ctx cosmos.Context, keeper keeper.Keeper, asset common.Asset, parts []string) (AddLiquidityMemo, error) {
 	var err error
 	addr := common.NoAddress
 	affAddr := common.NoAddress
@@ -80,21 +87,22 @@ func ParseAddLiquidityMemoV104(ctx cosmos.Context, keeper keeper.Keeper, asset c
 		}
 	}
 
+	// several affiliates can be given, in the form of AFF1/AFF2:BPS1/BPS2
+	var affiliates []types.Affiliate
 	affAddrStr := GetPart(parts, 3)
 	affPtsStr := GetPart(parts, 4)
 	if affAddrStr != "" && affPtsStr != "" {
-		if keeper == nil {
-			affAddr, err = common.NewAddress(affAddrStr)
-		} else {
-			affAddr, err = FetchAddress(ctx, keeper, affAddrStr, common.THORChain)
-		}
-		if err != nil {
-			return AddLiquidityMemo{}, err
-		}
-		affPts, err = ParseAffiliateBasisPoints(ctx, keeper, affPtsStr)
+		affiliates, err = ParseAffiliates(ctx, keeper, affAddrStr, affPtsStr)
 		if err != nil {
 			return AddLiquidityMemo{}, err
 		}
+		affAddr = affiliates[0].Address
+		affPts = types.Affiliates(affiliates).TotalBasisPoints()
+	}
+
+	m := NewAddLiquidityMemo(asset, addr, affAddr, affPts)
+	if len(affiliates) > 1 || types.Affiliates(affiliates).HasTHORName() {
+		m.Affiliates = affiliates
 	}
-	return NewAddLiquidityMemo(asset, addr, affAddr, affPts), nil
+	return m, nil
 }
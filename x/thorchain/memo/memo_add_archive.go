@@ -41,3 +41,38 @@ func ParseAddLiquidityMemoV1(ctx cosmos.Context, keeper keeper.Keeper, asset com
 	}
 	return NewAddLiquidityMemo(asset, addr, affAddr, affPts), nil
 }
+
+func ParseAddLiquidityMemoV104(ctx cosmos.Context, keeper keeper.Keeper, asset common.Asset, parts []string) (AddLiquidityMemo, error) {
+	var err error
+	addr := common.NoAddress
+	affAddr := common.NoAddress
+	affPts := cosmos.ZeroUint()
+	if addrStr := GetPart(parts, 2); addrStr != "" {
+		if keeper == nil {
+			addr, err = common.NewAddress(addrStr)
+		} else {
+			addr, err = FetchAddress(ctx, keeper, addrStr, asset.Chain)
+		}
+		if err != nil {
+			return AddLiquidityMemo{}, err
+		}
+	}
+
+	affAddrStr := GetPart(parts, 3)
+	affPtsStr := GetPart(parts, 4)
+	if affAddrStr != "" && affPtsStr != "" {
+		if keeper == nil {
+			affAddr, err = common.NewAddress(affAddrStr)
+		} else {
+			affAddr, err = FetchAddress(ctx, keeper, affAddrStr, common.THORChain)
+		}
+		if err != nil {
+			return AddLiquidityMemo{}, err
+		}
+		affPts, err = ParseAffiliateBasisPoints(ctx, keeper, affPtsStr)
+		if err != nil {
+			return AddLiquidityMemo{}, err
+		}
+	}
+	return NewAddLiquidityMemo(asset, addr, affAddr, affPts), nil
+}
@@ -0,0 +1,52 @@
+package thorchain
+
+import (
+	"fmt"
+
+	"gitlab.com/thorchain/thornode/common"
+	"gitlab.com/thorchain/thornode/common/cosmos"
+)
+
+// ModifyLimitOrderMemo changes the target amount of an existing limit order,
+// or cancels it when the modified target amount is zero
+type ModifyLimitOrderMemo struct {
+	MemoBase
+	TxID                 common.TxID
+	ModifiedTargetAmount cosmos.Uint
+}
+
+func (m ModifyLimitOrderMemo) GetTxID() common.TxID   { return m.TxID }
+func (m ModifyLimitOrderMemo) GetAmount() cosmos.Uint { return m.ModifiedTargetAmount }
+
+// IsCancel returns true when the memo cancels the limit order
+func (m ModifyLimitOrderMemo) IsCancel() bool { return m.ModifiedTargetAmount.IsZero() }
+
+// String implement fmt.Stringer
+func (m ModifyLimitOrderMemo) String() string {
+	return fmt.Sprintf("MODIFY:%s:%s", m.TxID, m.ModifiedTargetAmount)
+}
+
+// NewModifyLimitOrderMemo create a new instance of ModifyLimitOrderMemo
+func NewModifyLimitOrderMemo(txID common.TxID, target cosmos.Uint) ModifyLimitOrderMemo {
+	return ModifyLimitOrderMemo{
+		MemoBase:             MemoBase{TxType: TxModifyLimitOrder},
+		TxID:                 txID,
+		ModifiedTargetAmount: target,
+	}
+}
+
+// ParseModifyLimitOrderMemo try to parse the memo
+func ParseModifyLimitOrderMemo(parts []string) (ModifyLimitOrderMemo, error) {
+	if len(parts) < 3 {
+		return ModifyLimitOrderMemo{}, fmt.Errorf("not enough parameters")
+	}
+	txID, err := common.NewTxID(parts[1])
+	if err != nil {
+		return ModifyLimitOrderMemo{}, fmt.Errorf("fail to parse tx id: %w", err)
+	}
+	target, err := parseTradeTarget(parts[2])
+	if err != nil {
+		return ModifyLimitOrderMemo{}, fmt.Errorf("fail to parse modified target amount: %w", err)
+	}
+	return NewModifyLimitOrderMemo(txID, target), nil
+}
@@ -2,6 +2,7 @@ package thorchain
 
 import (
 	"fmt"
+	"strconv"
 	"strings"
 
 	"github.com/blang/semver"
@@ -12,52 +13,75 @@ import (
 	"gitlab.com/thorchain/thornode/x/thorchain/types"
 )
 
+// maxOrderTTL is the maximum number of blocks a limit order can stay in the
+// order book, about a year of blocks
+const maxOrderTTL int64 = 5_256_000
+
 type SwapMemo struct {
 	MemoBase
 	Destination          common.Address
 	SlipLimit            cosmos.Uint
 	AffiliateAddress     common.Address
 	AffiliateBasisPoints cosmos.Uint
+	Affiliates           []types.Affiliate
 	DexAggregator        string
 	DexTargetAddress     string
 	DexTargetLimit       *cosmos.Uint
 	OrderType            types.OrderType
+	StreamInterval       uint64
+	StreamQuantity       uint64
+	OrderTTL             int64
 }
 
 func (m SwapMemo) GetDestination() common.Address       { return m.Destination }
 func (m SwapMemo) GetSlipLimit() cosmos.Uint            { return m.SlipLimit }
 func (m SwapMemo) GetAffiliateAddress() common.Address  { return m.AffiliateAddress }
 func (m SwapMemo) GetAffiliateBasisPoints() cosmos.Uint { return m.AffiliateBasisPoints }
+func (m SwapMemo) GetAffiliates() []types.Affiliate     { return m.Affiliates }
 func (m SwapMemo) GetDexAggregator() string             { return m.DexAggregator }
 func (m SwapMemo) GetDexTargetAddress() string          { return m.DexTargetAddress }
 func (m SwapMemo) GetDexTargetLimit() *cosmos.Uint      { return m.DexTargetLimit }
 func (m SwapMemo) GetOrderType() types.OrderType        { return m.OrderType }
+func (m SwapMemo) GetStreamInterval() uint64            { return m.StreamInterval }
+func (m SwapMemo) GetStreamQuantity() uint64            { return m.StreamQuantity }
+func (m SwapMemo) GetOrderTTL() int64                   { return m.OrderTTL }
 
 func (m SwapMemo) String() string {
 	slipLimit := m.SlipLimit.String()
 	if m.SlipLimit.IsZero() {
 		slipLimit = ""
 	}
+	if m.StreamInterval > 0 {
+		slipLimit = fmt.Sprintf("%s/%d/%d", m.SlipLimit.String(), m.StreamInterval, m.StreamQuantity)
+	}
+	if m.OrderTTL > 0 {
+		slipLimit = fmt.Sprintf("%s/%d", m.SlipLimit.String(), m.OrderTTL)
+	}
 
 	// prefer short notation for generate swap memo
 	txType := m.TxType.String()
 	if m.TxType == TxSwap {
 		txType = "="
 	}
+	if m.OrderType == types.OrderType_limit {
+		txType = "lo"
+	}
+
+	affAddr, affPts := affiliatesString(m.AffiliateAddress, m.AffiliateBasisPoints, m.Affiliates)
 
 	args := []string{
 		txType,
 		m.Asset.String(),
 		m.Destination.String(),
 		slipLimit,
-		m.AffiliateAddress.String(),
-		m.AffiliateBasisPoints.String(),
+		affAddr,
+		affPts,
 		m.DexAggregator,
 		m.DexTargetAddress,
 	}
 
 	last := 3
-	if !m.SlipLimit.IsZero() {
+	if !m.SlipLimit.IsZero() || m.StreamInterval > 0 || m.OrderTTL > 0 {
 		last = 4
 	}
 
@@ -99,6 +123,8 @@ func ParseSwapMemo(ctx cosmos.Context, keeper keeper.Keeper, asset common.Asset,
 		return ParseSwapMemoV1(ctx, keeper, asset, parts)
 	}
 	switch {
+	case keeper.GetVersion().GTE(semver.MustParse("1.114.0")):
+		return ParseSwapMemoV114(ctx, keeper, asset, parts)
 	case keeper.GetVersion().GTE(semver.MustParse("1.112.0")):
 		return ParseSwapMemoV112(ctx, keeper, asset, parts)
 	case keeper.GetVersion().GTE(semver.MustParse("1.104.0")):
@@ -112,7 +138,7 @@ func ParseSwapMemo(ctx cosmos.Context, keeper keeper.Keeper, asset common.Asset,
 	}
 }
 
-func ParseSwapMemoV112(ctx cosmos.Context, keeper keeper.Keeper, asset common.Asset, parts []string) (SwapMemo, error) {
+func ParseSwapMemoV114(ctx cosmos.Context, keeper keeper.Keeper, asset common.Asset, parts []string) (SwapMemo, error) {
 	var err error
 	var order types.OrderType
 	dexAgg := ""
@@ -139,30 +165,59 @@ func ParseSwapMemoV112(ctx cosmos.Context, keeper keeper.Keeper, asset common.As
 		}
 	}
 	// price limit can be empty , when it is empty , there is no price protection
+	// the limit can optionally be followed by a streaming interval and
+	// quantity, in the form of LIM/INTERVAL/QUANTITY, or for limit orders by
+	// the number of blocks until the order expires, in the form of LIM/TTL
 	slip := cosmos.ZeroUint()
+	var streamInterval, streamQuantity uint64
+	var orderTTL int64
 	if limitStr := GetPart(parts, 3); limitStr != "" {
-		slip, err = parseTradeTarget(limitStr)
-		if err != nil {
-			return SwapMemo{}, err
+		limitParts := strings.Split(limitStr, "/")
+		if len(limitParts) > 3 {
+			return SwapMemo{}, fmt.Errorf("invalid streaming swap format: %s", limitStr)
+		}
+		if limitParts[0] != "" {
+			slip, err = parseTradeTarget(limitParts[0])
+			if err != nil {
+				return SwapMemo{}, err
+			}
+		}
+		if order == types.OrderType_limit && len(limitParts) > 1 {
+			if len(limitParts) > 2 {
+				return SwapMemo{}, fmt.Errorf("limit orders cannot be streamed")
+			}
+			orderTTL, err = strconv.ParseInt(limitParts[1], 10, 64)
+			if err != nil || orderTTL < 0 {
+				return SwapMemo{}, fmt.Errorf("invalid limit order ttl: %s", limitParts[1])
+			}
+			if orderTTL > maxOrderTTL {
+				return SwapMemo{}, fmt.Errorf("limit order ttl (%d) exceeds the maximum (%d)", orderTTL, maxOrderTTL)
+			}
+		} else if len(limitParts) > 1 {
+			streamInterval, err = strconv.ParseUint(limitParts[1], 10, 64)
+			if err != nil {
+				return SwapMemo{}, fmt.Errorf("invalid streaming swap interval: %w", err)
+			}
+		}
+		if len(limitParts) > 2 && limitParts[2] != "" {
+			streamQuantity, err = strconv.ParseUint(limitParts[2], 10, 64)
+			if err != nil {
+				return SwapMemo{}, fmt.Errorf("invalid streaming swap quantity: %w", err)
+			}
 		}
 	}
 
+	// several affiliates can be given, in the form of AFF1/AFF2:BPS1/BPS2
+	var affiliates []types.Affiliate
 	affAddrStr := GetPart(parts, 4)
 	affPtsStr := GetPart(parts, 5)
 	if affAddrStr != "" && affPtsStr != "" {
-		if keeper == nil {
-			affAddr, err = common.NewAddress(affAddrStr)
-		} else {
-			affAddr, err = FetchAddress(ctx, keeper, affAddrStr, common.THORChain)
-		}
-		if err != nil {
-			return SwapMemo{}, err
-		}
-
-		affPts, err = ParseAffiliateBasisPoints(ctx, keeper, affPtsStr)
+		affiliates, err = ParseAffiliates(ctx, keeper, affAddrStr, affPtsStr)
 		if err != nil {
 			return SwapMemo{}, err
 		}
+		affAddr = affiliates[0].Address
+		affPts = types.Affiliates(affiliates).TotalBasisPoints()
 	}
 
 	dexAgg = GetPart(parts, 6)
@@ -176,5 +231,12 @@ func ParseSwapMemoV112(ctx cosmos.Context, keeper keeper.Keeper, asset common.As
 		}
 	}
 
-	return NewSwapMemo(asset, destination, slip, affAddr, affPts, dexAgg, dexTargetAddress, dexTargetLimit, order), nil
+	m := NewSwapMemo(asset, destination, slip, affAddr, affPts, dexAgg, dexTargetAddress, dexTargetLimit, order)
+	m.StreamInterval = streamInterval
+	m.StreamQuantity = streamQuantity
+	m.OrderTTL = orderTTL
+	if len(affiliates) > 1 || types.Affiliates(affiliates).HasTHORName() {
+		m.Affiliates = affiliates
+	}
+	return m, nil
 }
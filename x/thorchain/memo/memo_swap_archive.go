@@ -272,3 +272,70 @@ func ParseSwapMemoV98(ctx cosmos.Context, keeper keeper.Keeper, asset common.Ass
 
 	return NewSwapMemo(asset, destination, slip, affAddr, affPts, dexAgg, dexTargetAddress, dexTargetLimit, order), nil
 }
+
+func ParseSwapMemoV112(ctx cosmos.Context, keeper keeper.Keeper, asset common.Asset, parts []string) (SwapMemo, error) {
+	var err error
+	var order types.OrderType
+	dexAgg := ""
+	dexTargetAddress := ""
+	dexTargetLimit := cosmos.ZeroUint()
+	if len(parts) < 2 {
+		return SwapMemo{}, fmt.Errorf("not enough parameters")
+	}
+	// DESTADDR can be empty , if it is empty , it will swap to the sender address
+	destination := common.NoAddress
+	affAddr := common.NoAddress
+	affPts := cosmos.ZeroUint()
+	if strings.EqualFold(parts[0], "limito") || strings.EqualFold(parts[0], "lo") {
+		order = types.OrderType_limit
+	}
+	if destStr := GetPart(parts, 2); destStr != "" {
+		if keeper == nil {
+			destination, err = common.NewAddress(destStr)
+		} else {
+			destination, err = FetchAddress(ctx, keeper, destStr, asset.Chain)
+		}
+		if err != nil {
+			return SwapMemo{}, err
+		}
+	}
+	// price limit can be empty , when it is empty , there is no price protection
+	slip := cosmos.ZeroUint()
+	if limitStr := GetPart(parts, 3); limitStr != "" {
+		slip, err = parseTradeTarget(limitStr)
+		if err != nil {
+			return SwapMemo{}, err
+		}
+	}
+
+	affAddrStr := GetPart(parts, 4)
+	affPtsStr := GetPart(parts, 5)
+	if affAddrStr != "" && affPtsStr != "" {
+		if keeper == nil {
+			affAddr, err = common.NewAddress(affAddrStr)
+		} else {
+			affAddr, err = FetchAddress(ctx, keeper, affAddrStr, common.THORChain)
+		}
+		if err != nil {
+			return SwapMemo{}, err
+		}
+
+		affPts, err = ParseAffiliateBasisPoints(ctx, keeper, affPtsStr)
+		if err != nil {
+			return SwapMemo{}, err
+		}
+	}
+
+	dexAgg = GetPart(parts, 6)
+	dexTargetAddress = GetPart(parts, 7)
+
+	if x := GetPart(parts, 8); x != "" {
+		dexTargetLimit, err = cosmos.ParseUint(x)
+		if err != nil {
+			ctx.Logger().Error("invalid dex target limit, ignore it", "limit", x)
+			dexTargetLimit = cosmos.ZeroUint()
+		}
+	}
+
+	return NewSwapMemo(asset, destination, slip, affAddr, affPts, dexAgg, dexTargetAddress, dexTargetLimit, order), nil
+}
@@ -20,6 +20,7 @@ import (
 
 	"gitlab.com/thorchain/thornode/common"
 	"gitlab.com/thorchain/thornode/common/cosmos"
+	"gitlab.com/thorchain/thornode/constants"
 	"gitlab.com/thorchain/thornode/x/thorchain/keeper"
 	kv1 "gitlab.com/thorchain/thornode/x/thorchain/keeper/v1"
 	"gitlab.com/thorchain/thornode/x/thorchain/types"
@@ -60,11 +61,12 @@ func (s *MemoSuite) SetUpSuite(c *C) {
 
 	pk := paramskeeper.NewKeeper(marshaler, legacyCodec, keyParams, tkeyParams)
 	ak := authkeeper.NewAccountKeeper(marshaler, keyAcc, pk.Subspace(authtypes.ModuleName), authtypes.ProtoBaseAccount, map[string][]string{
-		types.ModuleName:  {authtypes.Minter, authtypes.Burner},
-		types.AsgardName:  {},
-		types.BondName:    {},
-		types.ReserveName: {},
-		types.LendingName: {},
+		types.ModuleName:             {authtypes.Minter, authtypes.Burner},
+		types.AsgardName:             {},
+		types.BondName:               {},
+		types.ReserveName:            {},
+		types.LendingName:            {},
+		types.AffiliateCollectorName: {},
 	})
 
 	bk := bankkeeper.NewBaseKeeper(marshaler, keyBank, ak, pk.Subspace(banktypes.ModuleName), nil)
@@ -333,6 +335,60 @@ func (s *MemoSuite) TestParse(c *C) {
 	c.Check(memo.GetDestination().String(), Equals, "bnb1lejrrtta9cgr49fuh7ktu3sddhe0ff7wenlpn6")
 	c.Check(memo.GetSlipLimit().Uint64(), Equals, uint64(0))
 
+	// streaming swaps
+	memo, err = ParseMemoWithTHORNames(ctx, k, "=:"+common.RuneAsset().String()+":bnb1lejrrtta9cgr49fuh7ktu3sddhe0ff7wenlpn6:1200/10/20")
+	c.Assert(err, IsNil)
+	c.Check(memo.IsType(TxSwap), Equals, true, Commentf("MEMO: %+v", memo))
+	swapMemo, ok := memo.(SwapMemo)
+	c.Assert(ok, Equals, true)
+	c.Check(swapMemo.GetSlipLimit().Uint64(), Equals, uint64(1200))
+	c.Check(swapMemo.GetStreamInterval(), Equals, uint64(10))
+	c.Check(swapMemo.GetStreamQuantity(), Equals, uint64(20))
+	c.Check(swapMemo.String(), Equals, "=:THOR.RUNE:bnb1lejrrtta9cgr49fuh7ktu3sddhe0ff7wenlpn6:1200/10/20")
+
+	memo, err = ParseMemoWithTHORNames(ctx, k, "=:"+common.RuneAsset().String()+":bnb1lejrrtta9cgr49fuh7ktu3sddhe0ff7wenlpn6:/1")
+	c.Assert(err, IsNil)
+	swapMemo, ok = memo.(SwapMemo)
+	c.Assert(ok, Equals, true)
+	c.Check(swapMemo.GetSlipLimit().IsZero(), Equals, true)
+	c.Check(swapMemo.GetStreamInterval(), Equals, uint64(1))
+	c.Check(swapMemo.GetStreamQuantity(), Equals, uint64(0))
+	c.Check(swapMemo.String(), Equals, "=:THOR.RUNE:bnb1lejrrtta9cgr49fuh7ktu3sddhe0ff7wenlpn6:0/1/0")
+
+	_, err = ParseMemoWithTHORNames(ctx, k, "=:"+common.RuneAsset().String()+":bnb1lejrrtta9cgr49fuh7ktu3sddhe0ff7wenlpn6:1200/a/20")
+	c.Assert(err, NotNil)
+	_, err = ParseMemoWithTHORNames(ctx, k, "=:"+common.RuneAsset().String()+":bnb1lejrrtta9cgr49fuh7ktu3sddhe0ff7wenlpn6:1200/1/20/5")
+	c.Assert(err, NotNil)
+	// limit order ttl
+	memo, err = ParseMemoWithTHORNames(ctx, k, "lo:"+common.RuneAsset().String()+":bnb1lejrrtta9cgr49fuh7ktu3sddhe0ff7wenlpn6:1200/300")
+	c.Assert(err, IsNil)
+	swapMemo, ok = memo.(SwapMemo)
+	c.Assert(ok, Equals, true)
+	c.Check(swapMemo.GetOrderType(), Equals, types.OrderType_limit)
+	c.Check(swapMemo.GetSlipLimit().Uint64(), Equals, uint64(1200))
+	c.Check(swapMemo.GetOrderTTL(), Equals, int64(300))
+	c.Check(swapMemo.GetStreamInterval(), Equals, uint64(0))
+	c.Check(swapMemo.String(), Equals, "lo:THOR.RUNE:bnb1lejrrtta9cgr49fuh7ktu3sddhe0ff7wenlpn6:1200/300")
+	// the generated memo parses back to the same limit order
+	memo, err = ParseMemoWithTHORNames(ctx, k, swapMemo.String())
+	c.Assert(err, IsNil)
+	swapMemo, ok = memo.(SwapMemo)
+	c.Assert(ok, Equals, true)
+	c.Check(swapMemo.GetOrderType(), Equals, types.OrderType_limit)
+	c.Check(swapMemo.GetSlipLimit().Uint64(), Equals, uint64(1200))
+	c.Check(swapMemo.GetOrderTTL(), Equals, int64(300))
+	c.Check(swapMemo.GetStreamInterval(), Equals, uint64(0))
+	_, err = ParseMemoWithTHORNames(ctx, k, "lo:"+common.RuneAsset().String()+":bnb1lejrrtta9cgr49fuh7ktu3sddhe0ff7wenlpn6:1200/5256001")
+	c.Assert(err, NotNil)
+	_, err = ParseMemoWithTHORNames(ctx, k, "lo:"+common.RuneAsset().String()+":bnb1lejrrtta9cgr49fuh7ktu3sddhe0ff7wenlpn6:1200/9223372036854775807")
+	c.Assert(err, NotNil)
+	_, err = ParseMemoWithTHORNames(ctx, k, "lo:"+common.RuneAsset().String()+":bnb1lejrrtta9cgr49fuh7ktu3sddhe0ff7wenlpn6:1200/-5")
+	c.Assert(err, NotNil)
+	_, err = ParseMemoWithTHORNames(ctx, k, "lo:"+common.RuneAsset().String()+":bnb1lejrrtta9cgr49fuh7ktu3sddhe0ff7wenlpn6:1200/abc")
+	c.Assert(err, NotNil)
+	_, err = ParseMemoWithTHORNames(ctx, k, "lo:"+common.RuneAsset().String()+":bnb1lejrrtta9cgr49fuh7ktu3sddhe0ff7wenlpn6:1200/1/20")
+	c.Assert(err, NotNil)
+
 	whiteListAddr := types.GetRandomBech32Addr()
 	bondProvider := types.GetRandomBech32Addr()
 	memo, err = ParseMemoWithTHORNames(ctx, k, fmt.Sprintf("BOND:%s:%s", whiteListAddr, bondProvider))
@@ -384,6 +440,21 @@ func (s *MemoSuite) TestParse(c *C) {
 	c.Check(memo.GetTxID(), Equals, txID)
 	c.Check(memo.String(), Equals, refundMemo)
 
+	modifyMemo := "MODIFY:" + txID.String() + ":1000"
+	memo, err = ParseMemoWithTHORNames(ctx, k, modifyMemo)
+	c.Assert(err, IsNil)
+	c.Check(memo.IsType(TxModifyLimitOrder), Equals, true)
+	c.Check(memo.IsInbound(), Equals, true)
+	c.Check(memo.GetTxID(), Equals, txID)
+	c.Check(memo.GetAmount().Uint64(), Equals, uint64(1000))
+	c.Check(memo.(ModifyLimitOrderMemo).IsCancel(), Equals, false)
+	c.Check(memo.String(), Equals, modifyMemo)
+
+	memo, err = ParseMemoWithTHORNames(ctx, k, "m:"+txID.String()+":0")
+	c.Assert(err, IsNil)
+	c.Check(memo.(ModifyLimitOrderMemo).IsCancel(), Equals, true)
+	c.Check(memo.String(), Equals, "MODIFY:"+txID.String()+":0")
+
 	yggFundMemo := "YGGDRASIL+:100"
 	memo, err = ParseMemoWithTHORNames(ctx, k, yggFundMemo)
 	c.Check(err, IsNil)
@@ -494,6 +565,12 @@ func (s *MemoSuite) TestParse(c *C) {
 	c.Assert(err, NotNil)
 	_, err = ParseMemoWithTHORNames(ctx, k, "refund") // not enough parameter
 	c.Assert(err, NotNil)
+	_, err = ParseMemoWithTHORNames(ctx, k, "modify:"+txID.String()) // not enough parameter
+	c.Assert(err, NotNil)
+	_, err = ParseMemoWithTHORNames(ctx, k, "modify:bogus:100") // invalid tx id
+	c.Assert(err, NotNil)
+	_, err = ParseMemoWithTHORNames(ctx, k, "modify:"+txID.String()+":abc") // invalid target amount
+	c.Assert(err, NotNil)
 	_, err = ParseMemoWithTHORNames(ctx, k, "yggdrasil+") // not enough parameter
 	c.Assert(err, NotNil)
 	_, err = ParseMemoWithTHORNames(ctx, k, "yggdrasil+:A") // invalid block height
@@ -513,3 +590,143 @@ func (s *MemoSuite) TestParse(c *C) {
 	_, err = ParseMemoWithTHORNames(ctx, k, "whatever") // not support
 	c.Assert(err, NotNil)
 }
+
+func (s *MemoSuite) TestParseManageTHORNameMemo(c *C) {
+	ctx := s.ctx
+	k := s.k
+
+	owner := types.GetRandomBech32Addr()
+	manager1 := types.GetRandomBech32Addr()
+	manager2 := types.GetRandomBech32Addr()
+	addr := types.GetRandomTHORAddress()
+
+	memo, err := ParseMemoWithTHORNames(ctx, k, fmt.Sprintf("~:alice.wallet:THOR:%s", addr))
+	c.Assert(err, IsNil)
+	mem, ok := memo.(ManageTHORNameMemo)
+	c.Assert(ok, Equals, true)
+	c.Check(mem.GetName(), Equals, "alice.wallet")
+	c.Check(mem.Managers, HasLen, 0)
+
+	memo, err = ParseMemoWithTHORNames(ctx, k, fmt.Sprintf("~:alice.wallet:THOR:%s:%s:THOR.RUNE:0:%s/%s", addr, owner, manager1, manager2))
+	c.Assert(err, IsNil)
+	mem, ok = memo.(ManageTHORNameMemo)
+	c.Assert(ok, Equals, true)
+	c.Check(mem.Owner.Equals(owner), Equals, true)
+	c.Assert(mem.Managers, HasLen, 2)
+	c.Check(mem.Managers[0].Equals(manager1), Equals, true)
+	c.Check(mem.Managers[1].Equals(manager2), Equals, true)
+
+	_, err = ParseMemoWithTHORNames(ctx, k, fmt.Sprintf("~:alice.wallet:THOR:%s:%s:THOR.RUNE:0:bogus", addr, owner))
+	c.Assert(err, NotNil)
+}
+
+func (s *MemoSuite) TestFetchAddressSubName(c *C) {
+	ctx := s.ctx
+	k := s.k
+
+	thorAddr := types.GetRandomTHORAddress()
+	btcAddr := types.GetRandomBTCAddress()
+	name := types.NewTHORName("alice.wallet", ctx.BlockHeight()+100, []types.THORNameAlias{
+		{Chain: common.THORChain, Address: thorAddr},
+		{Chain: common.BTCChain, Address: btcAddr},
+	})
+	k.SetTHORName(ctx, name)
+
+	addr, err := FetchAddress(ctx, k, "alice.wallet", common.THORChain)
+	c.Assert(err, IsNil)
+	c.Check(addr.Equals(thorAddr), Equals, true)
+
+	// chain suffix
+	addr, err = FetchAddress(ctx, k, "alice.wallet.btc", common.THORChain)
+	c.Assert(err, IsNil)
+	c.Check(addr.Equals(btcAddr), Equals, true)
+
+	_, err = FetchAddress(ctx, k, "bob.wallet", common.THORChain)
+	c.Assert(err, NotNil)
+}
+
+func (s *MemoSuite) TestParseMultipleAffiliates(c *C) {
+	ctx := s.ctx
+	k := s.k
+
+	aff1 := types.GetRandomTHORAddress()
+	aff2 := types.GetRandomTHORAddress()
+	dest := types.GetRandomBTCAddress()
+
+	memo, err := ParseMemoWithTHORNames(ctx, k, fmt.Sprintf("=:BTC.BTC:%s::%s/%s:10/5", dest, aff1, aff2))
+	c.Assert(err, IsNil)
+	swap, ok := memo.(SwapMemo)
+	c.Assert(ok, Equals, true)
+	c.Check(swap.AffiliateAddress.Equals(aff1), Equals, true)
+	c.Check(swap.AffiliateBasisPoints.Uint64(), Equals, uint64(15))
+	c.Assert(swap.Affiliates, HasLen, 2)
+	c.Check(swap.Affiliates[0].Address.Equals(aff1), Equals, true)
+	c.Check(swap.Affiliates[0].BasisPoints.Uint64(), Equals, uint64(10))
+	c.Check(swap.Affiliates[1].Address.Equals(aff2), Equals, true)
+	c.Check(swap.Affiliates[1].BasisPoints.Uint64(), Equals, uint64(5))
+	c.Check(swap.String(), Equals, fmt.Sprintf("=:BTC.BTC:%s::%s/%s:10/5", dest, aff1, aff2))
+
+	// a single affiliate is carried as before
+	memo, err = ParseMemoWithTHORNames(ctx, k, fmt.Sprintf("=:BTC.BTC:%s::%s:10", dest, aff1))
+	c.Assert(err, IsNil)
+	swap, ok = memo.(SwapMemo)
+	c.Assert(ok, Equals, true)
+	c.Check(swap.AffiliateBasisPoints.Uint64(), Equals, uint64(10))
+	c.Check(swap.Affiliates, HasLen, 0)
+
+	memo, err = ParseMemoWithTHORNames(ctx, k, fmt.Sprintf("+:BTC.BTC:%s:%s/%s:10/5", dest, aff1, aff2))
+	c.Assert(err, IsNil)
+	add, ok := memo.(AddLiquidityMemo)
+	c.Assert(ok, Equals, true)
+	c.Check(add.AffiliateBasisPoints.Uint64(), Equals, uint64(15))
+	c.Assert(add.Affiliates, HasLen, 2)
+	c.Check(add.String(), Equals, fmt.Sprintf("+:BTC.BTC:%s:%s/%s:10/5", dest, aff1, aff2))
+
+	// addresses and basis points must match up
+	_, err = ParseMemoWithTHORNames(ctx, k, fmt.Sprintf("=:BTC.BTC:%s::%s/%s:10", dest, aff1, aff2))
+	c.Assert(err, NotNil)
+
+	// the total is capped by mimir
+	k.SetMimir(ctx, constants.MaxAffiliateFeeBasisPoints.String(), 12)
+	_, err = ParseMemoWithTHORNames(ctx, k, fmt.Sprintf("=:BTC.BTC:%s::%s/%s:10/5", dest, aff1, aff2))
+	c.Assert(err, NotNil)
+	memo, err = ParseMemoWithTHORNames(ctx, k, fmt.Sprintf("=:BTC.BTC:%s::%s/%s:10/2", dest, aff1, aff2))
+	c.Assert(err, IsNil)
+	swap, ok = memo.(SwapMemo)
+	c.Assert(ok, Equals, true)
+	c.Check(swap.AffiliateBasisPoints.Uint64(), Equals, uint64(12))
+	c.Assert(k.DeleteMimir(ctx, constants.MaxAffiliateFeeBasisPoints.String()), IsNil)
+}
+
+func (s *MemoSuite) TestParseTHORNameAffiliate(c *C) {
+	ctx := s.ctx
+	k := s.k
+
+	thorAddr := types.GetRandomTHORAddress()
+	dest := types.GetRandomBTCAddress()
+	name := types.NewTHORName("bob", ctx.BlockHeight()+100, []types.THORNameAlias{
+		{Chain: common.THORChain, Address: thorAddr},
+	})
+	k.SetTHORName(ctx, name)
+
+	// affiliates given as a THORName are carried along, so their fees can be
+	// accrued to the name
+	memo, err := ParseMemoWithTHORNames(ctx, k, fmt.Sprintf("=:BTC.BTC:%s::bob:10", dest))
+	c.Assert(err, IsNil)
+	swap, ok := memo.(SwapMemo)
+	c.Assert(ok, Equals, true)
+	c.Check(swap.AffiliateAddress.Equals(thorAddr), Equals, true)
+	c.Assert(swap.Affiliates, HasLen, 1)
+	c.Check(swap.Affiliates[0].THORName, Equals, "bob")
+	c.Check(swap.Affiliates[0].Address.Equals(thorAddr), Equals, true)
+	c.Check(swap.String(), Equals, fmt.Sprintf("=:BTC.BTC:%s::bob:10", dest))
+
+	aff := types.GetRandomTHORAddress()
+	memo, err = ParseMemoWithTHORNames(ctx, k, fmt.Sprintf("=:BTC.BTC:%s::%s/bob:10/5", dest, aff))
+	c.Assert(err, IsNil)
+	swap, ok = memo.(SwapMemo)
+	c.Assert(ok, Equals, true)
+	c.Assert(swap.Affiliates, HasLen, 2)
+	c.Check(swap.Affiliates[0].THORName, Equals, "")
+	c.Check(swap.Affiliates[1].THORName, Equals, "bob")
+}
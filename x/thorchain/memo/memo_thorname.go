@@ -3,6 +3,7 @@ package thorchain
 import (
 	"fmt"
 	"strconv"
+	"strings"
 
 	"gitlab.com/thorchain/thornode/common"
 	"gitlab.com/thorchain/thornode/common/cosmos"
@@ -16,6 +17,7 @@ type ManageTHORNameMemo struct {
 	PreferredAsset common.Asset
 	Expire         int64
 	Owner          cosmos.AccAddress
+	Managers       []cosmos.AccAddress
 }
 
 func (m ManageTHORNameMemo) GetName() string            { return m.Name }
@@ -23,7 +25,7 @@ func (m ManageTHORNameMemo) GetChain() common.Chain     { return m.Chain }
 func (m ManageTHORNameMemo) GetAddress() common.Address { return m.Address }
 func (m ManageTHORNameMemo) GetBlockExpire() int64      { return m.Expire }
 
-func NewManageTHORNameMemo(name string, chain common.Chain, addr common.Address, expire int64, asset common.Asset, owner cosmos.AccAddress) ManageTHORNameMemo {
+func NewManageTHORNameMemo(name string, chain common.Chain, addr common.Address, expire int64, asset common.Asset, owner cosmos.AccAddress, managers []cosmos.AccAddress) ManageTHORNameMemo {
 	return ManageTHORNameMemo{
 		MemoBase:       MemoBase{TxType: TxTHORName},
 		Name:           name,
@@ -32,6 +34,7 @@ func NewManageTHORNameMemo(name string, chain common.Chain, addr common.Address,
 		PreferredAsset: asset,
 		Expire:         expire,
 		Owner:          owner,
+		Managers:       managers,
 	}
 }
 
@@ -39,6 +42,7 @@ func ParseManageTHORNameMemo(parts []string) (ManageTHORNameMemo, error) {
 	var err error
 	var name string
 	var owner cosmos.AccAddress
+	var managers []cosmos.AccAddress
 	preferredAsset := common.EmptyAsset
 	expire := int64(0)
 
@@ -78,5 +82,16 @@ func ParseManageTHORNameMemo(parts []string) (ManageTHORNameMemo, error) {
 		}
 	}
 
-	return NewManageTHORNameMemo(name, chain, addr, expire, preferredAsset, owner), nil
+	// managers are separated by a slash
+	if len(parts) >= 8 && len(parts[7]) > 0 {
+		for _, m := range strings.Split(parts[7], "/") {
+			manager, err := cosmos.AccAddressFromBech32(m)
+			if err != nil {
+				return ManageTHORNameMemo{}, err
+			}
+			managers = append(managers, manager)
+		}
+	}
+
+	return NewManageTHORNameMemo(name, chain, addr, expire, preferredAsset, owner, managers), nil
 }
@@ -1,6 +1,7 @@
 package thorchain
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"os"
@@ -25,6 +26,7 @@ import (
 	"gitlab.com/thorchain/thornode/x/thorchain/client/cli"
 	"gitlab.com/thorchain/thornode/x/thorchain/client/rest"
 	"gitlab.com/thorchain/thornode/x/thorchain/keeper"
+	"gitlab.com/thorchain/thornode/x/thorchain/types"
 )
 
 // type check to ensure the interface is properly implemented
@@ -73,10 +75,11 @@ func (AppModuleBasic) RegisterRESTRoutes(ctx client.Context, rtr *mux.Router) {
 	sdkRest.RegisterRoutes(ctx, rtr, StoreKey)
 }
 
-// RegisterGRPCGatewayRoutes registers the gRPC Gateway routes for the mint module.
-// thornode current doesn't have grpc enpoint yet
+// RegisterGRPCGatewayRoutes registers the gRPC Gateway routes for the thorchain module.
 func (AppModuleBasic) RegisterGRPCGatewayRoutes(clientCtx client.Context, mux *runtime.ServeMux) {
-	// types.RegisterQueryHandlerClient(context.Background(), mux, types.NewQueryClient(clientCtx))
+	if err := types.RegisterQueryHandlerClient(context.Background(), mux, types.NewQueryClient(clientCtx)); err != nil {
+		panic(err)
+	}
 }
 
 // GetQueryCmd get the root query command of this module
@@ -143,7 +146,7 @@ func (am AppModule) LegacyQuerierHandler(legacyQuerierCdc *codec.LegacyAmino) sd
 // RegisterServices registers module services.
 func (am AppModule) RegisterServices(cfg module.Configurator) {
 	// types.RegisterMsgServer(cfg.MsgServer(), keeper.NewMsgServerImpl(am.keeper))
-	// types.RegisterQueryServer(cfg.QueryServer(), am.keeper)
+	types.RegisterQueryServer(cfg.QueryServer(), NewQueryServerImpl(am.mgr))
 }
 
 func (am AppModule) NewQuerierHandler() sdk.Querier {
@@ -228,6 +231,12 @@ func (am AppModule) EndBlock(ctx sdk.Context, req abci.RequestEndBlock) []abci.V
 		}
 	}
 
+	if am.mgr.GetVersion().GTE(semver.MustParse("1.114.0")) {
+		if err := am.mgr.LendingMgr().EndBlock(ctx, am.mgr); err != nil {
+			ctx.Logger().Error("fail to process loan liquidations", "error", err)
+		}
+	}
+
 	// slash node accounts for not observing any accepted inbound tx
 	if err := am.mgr.Slasher().LackObserving(ctx, am.mgr.GetConstants()); err != nil {
 		ctx.Logger().Error("Unable to slash for lack of observing:", "error", err)
@@ -5,6 +5,8 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"net/url"
+	"sort"
 	"strconv"
 	"strings"
 	"time"
@@ -87,6 +89,8 @@ func NewQuerier(mgr *Mgrs, kbs cosmos.KeybaseStore) cosmos.Querier {
 			return queryNetwork(ctx, mgr)
 		case q.QueryPOL.Key:
 			return queryPOL(ctx, mgr)
+		case q.QueryPOLPools.Key:
+			return queryPOLPools(ctx, mgr)
 		case q.QueryBalanceModule.Key:
 			return queryBalanceModule(ctx, path[1:], mgr)
 		case q.QueryVaultsAsgard.Key:
@@ -118,27 +122,43 @@ func NewQuerier(mgr *Mgrs, kbs cosmos.KeybaseStore) cosmos.Querier {
 		case q.QueryRagnarok.Key:
 			return queryRagnarok(ctx, mgr)
 		case q.QueryPendingOutbound.Key:
-			return queryPendingOutbound(ctx, mgr)
+			return queryPendingOutbound(ctx, req, mgr)
 		case q.QueryScheduledOutbound.Key:
 			return queryScheduledOutbound(ctx, mgr)
 		case q.QuerySwapQueue.Key:
-			return querySwapQueue(ctx, mgr)
+			return querySwapQueue(ctx, req, mgr)
+		case q.QueryOrderBooks.Key:
+			return queryOrderBooks(ctx, mgr)
+		case q.QueryOrderBook.Key:
+			return queryOrderBook(ctx, req, mgr)
+		case q.QueryOrderBookOrder.Key:
+			return queryOrderBookOrder(ctx, path[1:], mgr)
 		case q.QueryTssKeygenMetrics.Key:
 			return queryTssKeygenMetric(ctx, path[1:], req, mgr)
 		case q.QueryTssMetrics.Key:
 			return queryTssMetric(ctx, path[1:], req, mgr)
 		case q.QueryTHORName.Key:
 			return queryTHORName(ctx, path[1:], req, mgr)
+		case q.QueryTHORNameLookup.Key:
+			return queryTHORNameLookup(ctx, path[1:], mgr)
 		case q.QueryQuoteSwap.Key:
 			return queryQuoteSwap(ctx, path[1:], req, mgr)
 		case q.QueryQuoteSaverDeposit.Key:
 			return queryQuoteSaverDeposit(ctx, path[1:], req, mgr)
 		case q.QueryQuoteSaverWithdraw.Key:
 			return queryQuoteSaverWithdraw(ctx, path[1:], req, mgr)
+		case q.QueryQuoteLPAdd.Key:
+			return queryQuoteLiquidityAdd(ctx, path[1:], req, mgr)
+		case q.QueryQuoteLPWithdraw.Key:
+			return queryQuoteLiquidityWithdraw(ctx, path[1:], req, mgr)
 		case q.QueryQuoteLoanOpen.Key:
 			return queryQuoteLoanOpen(ctx, path[1:], req, mgr)
 		case q.QueryQuoteLoanClose.Key:
 			return queryQuoteLoanClose(ctx, path[1:], req, mgr)
+		case q.QuerySimulateDeposit.Key:
+			return querySimulateDeposit(ctx, path[1:], req, mgr)
+		case q.QuerySimulateObserved.Key:
+			return querySimulateObserved(ctx, path[1:], req, mgr)
 		case q.QueryInvariants.Key:
 			return queryInvariants(ctx, mgr)
 		case q.QueryInvariant.Key:
@@ -234,12 +254,62 @@ func queryTHORName(ctx cosmos.Context, path []string, req abci.RequestQuery, mgr
 			Address: wrapString(alias.Address.String()),
 		})
 	}
+	managers := []string{}
+	for _, manager := range name.Managers {
+		managers = append(managers, manager.String())
+	}
+	affRune, err := mgr.Keeper().GetAffiliateCollectorRune(ctx, name.Name)
+	if err != nil {
+		return nil, ErrInternal(err, "fail to fetch affiliate collector rune")
+	}
 	resp := openapi.Thorname{
-		Name:              wrapString(name.Name),
-		ExpireBlockHeight: wrapInt64(name.ExpireBlockHeight),
-		Owner:             wrapString(name.Owner.String()),
-		PreferredAsset:    name.PreferredAsset.String(),
-		Aliases:           aliases,
+		Name:                   wrapString(name.Name),
+		ExpireBlockHeight:      wrapInt64(name.ExpireBlockHeight),
+		Owner:                  wrapString(name.Owner.String()),
+		PreferredAsset:         name.PreferredAsset.String(),
+		Aliases:                aliases,
+		Managers:               managers,
+		AffiliateCollectorRune: wrapString(affRune.String()),
+	}
+
+	return jsonify(ctx, resp)
+}
+
+func queryTHORNameLookup(ctx cosmos.Context, path []string, mgr *Mgrs) ([]byte, error) {
+	if len(path) == 0 {
+		return nil, errors.New("address not provided")
+	}
+	addr, err := common.NewAddress(path[0])
+	if err != nil {
+		return nil, fmt.Errorf("fail to parse address: %w", err)
+	}
+
+	names, err := mgr.Keeper().GetTHORNamesByAddress(ctx, addr)
+	if err != nil {
+		return nil, ErrInternal(err, "fail to fetch THORNames")
+	}
+
+	resp := []openapi.ThornameLookup{}
+	for _, n := range names {
+		if !mgr.Keeper().THORNameExists(ctx, n) {
+			continue // expired
+		}
+		name, err := mgr.Keeper().GetTHORName(ctx, n)
+		if err != nil {
+			return nil, ErrInternal(err, "fail to fetch THORName")
+		}
+		// an address can be the alias of several chains (ie evm chains)
+		for _, alias := range name.Aliases {
+			if !alias.Address.Equals(addr) {
+				continue
+			}
+			resp = append(resp, openapi.ThornameLookup{
+				Name:              name.Name,
+				Chain:             alias.Chain.String(),
+				Owner:             wrapString(name.Owner.String()),
+				ExpireBlockHeight: name.ExpireBlockHeight,
+			})
+		}
 	}
 
 	return jsonify(ctx, resp)
@@ -525,6 +595,60 @@ func queryPOL(ctx cosmos.Context, mgr *Mgrs) ([]byte, error) {
 	return jsonify(ctx, result)
 }
 
+func queryPOLPools(ctx cosmos.Context, mgr *Mgrs) ([]byte, error) {
+	polAddress, err := mgr.Keeper().GetModuleAddress(ReserveName)
+	if err != nil {
+		return nil, fmt.Errorf("fail to get POL address: %w", err)
+	}
+	pools, err := mgr.Keeper().GetPools(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("fail to get pools: %w", err)
+	}
+
+	result := make([]openapi.POLPool, 0)
+	for _, pool := range pools {
+		if pool.Asset.IsNative() {
+			continue
+		}
+		lp, err := mgr.Keeper().GetLiquidityProvider(ctx, pool.Asset, polAddress)
+		if err != nil {
+			return nil, fmt.Errorf("fail to get POL liquidity provider: %w", err)
+		}
+		data, err := mgr.Keeper().GetPOLPool(ctx, pool.Asset)
+		if err != nil {
+			return nil, fmt.Errorf("fail to get POL of pool: %w", err)
+		}
+		// skip pools POL has never been in
+		if lp.Units.IsZero() && data.RuneDeposited.IsZero() && data.RuneWithdrawn.IsZero() {
+			continue
+		}
+
+		synthSupply := mgr.Keeper().GetTotalSupply(ctx, pool.Asset.GetSyntheticAsset())
+		pool.CalcUnits(mgr.GetVersion(), synthSupply)
+		value := common.GetSafeShare(lp.Units, pool.GetPoolUnits(), pool.BalanceRune).MulUint64(2)
+		synthPerPoolDepth := common.GetUncappedShare(pool.SynthUnits, pool.GetPoolUnits(), cosmos.NewUint(10_000))
+		target, err := polTargetSynthPerPoolDepth(ctx, mgr, pool)
+		if err != nil {
+			return nil, fmt.Errorf("fail to get POL target synth per pool depth: %w", err)
+		}
+
+		result = append(result, openapi.POLPool{
+			Asset:                      pool.Asset.String(),
+			Units:                      lp.Units.String(),
+			PoolShareBps:               common.GetSafeShare(lp.Units, pool.GetPoolUnits(), cosmos.NewUint(10_000)).BigInt().Int64(),
+			Value:                      value.String(),
+			RuneDeposited:              data.RuneDeposited.String(),
+			RuneWithdrawn:              data.RuneWithdrawn.String(),
+			CurrentDeposit:             data.CurrentDeposit().String(),
+			Pnl:                        data.PnL(value).String(),
+			SynthPerPoolDepthBps:       synthPerPoolDepth.BigInt().Int64(),
+			TargetSynthPerPoolDepthBps: target.BigInt().Int64(),
+		})
+	}
+
+	return jsonify(ctx, result)
+}
+
 func queryInboundAddresses(ctx cosmos.Context, path []string, req abci.RequestQuery, mgr *Mgrs) ([]byte, error) {
 	active, err := mgr.Keeper().GetAsgardVaultsByStatus(ctx, ActiveVault)
 	if err != nil {
@@ -784,9 +908,19 @@ func getTotalEffectiveBond(ctx cosmos.Context, mgr *Mgrs, bondHardCap cosmos.Uin
 // queryNodes return all the nodes that has bond
 // /thorchain/nodes
 func queryNodes(ctx cosmos.Context, path []string, req abci.RequestQuery, mgr *Mgrs) ([]byte, error) {
-	nodeAccounts, err := mgr.Keeper().ListValidatorsWithBond(ctx)
+	params, err := parseQueryParams(req.Data)
 	if err != nil {
-		return nil, fmt.Errorf("fail to get node accounts: %w", err)
+		return nil, err
+	}
+	page, err := newQueryPage(params)
+	if err != nil {
+		return nil, err
+	}
+	status := params.Get("status")
+	if status != "" {
+		if _, ok := types.NodeStatus_value[strings.Title(strings.ToLower(status))]; !ok { // nolint SA1019
+			return nil, fmt.Errorf("invalid node status: %s", status)
+		}
 	}
 
 	active, err := mgr.Keeper().ListActiveValidators(ctx)
@@ -815,12 +949,29 @@ func queryNodes(ctx cosmos.Context, path []string, req abci.RequestQuery, mgr *M
 
 	lastChurnHeight := vaults[0].BlockHeight
 	version := mgr.GetVersion()
-	result := make([]QueryNodeAccount, len(nodeAccounts))
-	for i, na := range nodeAccounts {
+	result := make([]QueryNodeAccount, 0)
+	iterator := mgr.Keeper().GetNodeAccountIteratorAfter(ctx, page.cursor)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var na NodeAccount
+		if err := mgr.Keeper().Cdc().Unmarshal(iterator.Value(), &na); err != nil {
+			return nil, fmt.Errorf("fail to unmarshal node account: %w", err)
+		}
+		if na.Type != NodeTypeValidator || na.Bond.IsZero() {
+			continue
+		}
 		if na.RequestedToLeave && na.Bond.LTE(cosmos.NewUint(common.One)) {
 			// ignore the node , it left and also has very little bond
 			continue
 		}
+		if status != "" && !strings.EqualFold(na.Status.String(), status) {
+			continue
+		}
+		if page.full(len(result)) {
+			break
+		}
+		i := len(result)
+		result = append(result, QueryNodeAccount{})
 
 		slashPts, err := mgr.Keeper().GetNodeAccountSlashPoints(ctx, na.NodeAddress)
 		if err != nil {
@@ -893,9 +1044,17 @@ func queryBorrowers(ctx cosmos.Context, path []string, req abci.RequestQuery, mg
 		ctx.Logger().Error("fail to get parse asset", "error", err)
 		return nil, fmt.Errorf("fail to parse asset: %w", err)
 	}
+	params, err := parseQueryParams(req.Data)
+	if err != nil {
+		return nil, err
+	}
+	page, err := newQueryPage(params)
+	if err != nil {
+		return nil, err
+	}
 
 	var loans Loans
-	iterator := mgr.Keeper().GetLoanIterator(ctx, asset)
+	iterator := mgr.Keeper().GetLoanIteratorAfter(ctx, asset, page.cursor)
 	defer iterator.Close()
 	for ; iterator.Valid(); iterator.Next() {
 		var loan Loan
@@ -903,6 +1062,15 @@ func queryBorrowers(ctx cosmos.Context, path []string, req abci.RequestQuery, mg
 		if loan.CollateralUp.Equal(loan.CollateralDown) && loan.DebtUp.Equal(loan.DebtDown) {
 			continue
 		}
+		if page.full(len(loans)) {
+			break
+		}
+		interest, err := mgr.LendingMgr().AccruedInterest(ctx, loan)
+		if err != nil {
+			ctx.Logger().Error("fail to get accrued interest", "error", err)
+			return nil, fmt.Errorf("fail to get accrued interest: %w", err)
+		}
+		loan.DebtUp = loan.DebtUp.Add(interest)
 		loans = append(loans, loan)
 	}
 	var res []byte
@@ -937,6 +1105,14 @@ func queryBorrower(ctx cosmos.Context, path []string, req abci.RequestQuery, mgr
 		return nil, fmt.Errorf("fail to borrower: %w", err)
 	}
 
+	// include the interest owed since the loan was last touched
+	interest, err := mgr.LendingMgr().AccruedInterest(ctx, loan)
+	if err != nil {
+		ctx.Logger().Error("fail to get accrued interest", "error", err)
+		return nil, fmt.Errorf("fail to get accrued interest: %w", err)
+	}
+	loan.DebtUp = loan.DebtUp.Add(interest)
+
 	var res []byte
 	res, err = json.MarshalIndent(loan, "", "	")
 	if err != nil {
@@ -980,13 +1156,35 @@ func queryLiquidityProviders(ctx cosmos.Context, path []string, req abci.Request
 		return nil, fmt.Errorf("fail to get pool: %w", err)
 	}
 
+	params, err := parseQueryParams(req.Data)
+	if err != nil {
+		return nil, err
+	}
+	page, err := newQueryPage(params)
+	if err != nil {
+		return nil, err
+	}
+	minUnits := cosmos.ZeroUint()
+	if params.Get("min_units") != "" {
+		minUnits, err = cosmos.ParseUint(params.Get("min_units"))
+		if err != nil {
+			return nil, fmt.Errorf("fail to parse min_units: %w", err)
+		}
+	}
+
 	var lps LiquidityProviders
 	var savers []QuerySaver
-	iterator := mgr.Keeper().GetLiquidityProviderIterator(ctx, asset)
+	iterator := mgr.Keeper().GetLiquidityProviderIteratorAfter(ctx, asset, page.cursor)
 	defer iterator.Close()
 	for ; iterator.Valid(); iterator.Next() {
 		var lp LiquidityProvider
 		mgr.Keeper().Cdc().MustUnmarshal(iterator.Value(), &lp)
+		if lp.Units.LT(minUnits) {
+			continue
+		}
+		if page.full(len(lps) + len(savers)) {
+			break
+		}
 		if !isSavers {
 			lps = append(lps, lp)
 		} else {
@@ -1085,6 +1283,10 @@ func queryPool(ctx cosmos.Context, path []string, req abci.RequestQuery, mgr *Mg
 
 	saversDepth := saversPool.BalanceAsset
 	saversUnits := saversPool.LPUnits
+	saversGrowth, err := mgr.Keeper().GetSaversGrowth(ctx, saversAsset)
+	if err != nil {
+		return nil, fmt.Errorf("fail to get savers growth: %w", err)
+	}
 
 	synthSupply := mgr.Keeper().GetTotalSupply(ctx, pool.Asset.GetSyntheticAsset())
 	pool.CalcUnits(mgr.GetVersion(), synthSupply)
@@ -1101,6 +1303,7 @@ func queryPool(ctx cosmos.Context, path []string, req abci.RequestQuery, mgr *Mg
 	p.SynthSupply = synthSupply.String()
 	p.SaversDepth = saversDepth.String()
 	p.SaversUnits = saversUnits.String()
+	p.SaversAPRBps = saversGrowth.AprBps()
 	p.SynthMintPaused = (synthMintPausedErr != nil)
 	p.SynthSupplyRemaining = synthSupplyRemaining.String()
 	p.LoanCollateral = totalCollateral.String()
@@ -1135,6 +1338,10 @@ func queryPools(ctx cosmos.Context, req abci.RequestQuery, mgr *Mgrs) ([]byte, e
 
 		saversDepth := saversPool.BalanceAsset
 		saversUnits := saversPool.LPUnits
+		saversGrowth, err := mgr.Keeper().GetSaversGrowth(ctx, saversAsset)
+		if err != nil {
+			return nil, fmt.Errorf("fail to get savers growth: %w", err)
+		}
 
 		synthSupply := mgr.Keeper().GetTotalSupply(ctx, pool.Asset.GetSyntheticAsset())
 		pool.CalcUnits(mgr.GetVersion(), synthSupply)
@@ -1151,6 +1358,7 @@ func queryPools(ctx cosmos.Context, req abci.RequestQuery, mgr *Mgrs) ([]byte, e
 		p.SynthSupply = synthSupply.String()
 		p.SaversDepth = saversDepth.String()
 		p.SaversUnits = saversUnits.String()
+		p.SaversAPRBps = saversGrowth.AprBps()
 		p.SynthMintPaused = (synthMintPausedErr != nil)
 		p.SynthSupplyRemaining = synthSupplyRemaining.String()
 		p.LoanCollateral = totalCollateral.String()
@@ -1699,43 +1907,259 @@ func queryScheduledOutbound(ctx cosmos.Context, mgr *Mgrs) ([]byte, error) {
 	return jsonify(ctx, result)
 }
 
-func queryPendingOutbound(ctx cosmos.Context, mgr *Mgrs) ([]byte, error) {
+// queryPendingOutbound returns the outbounds waiting to be signed. The cursor
+// is a height, and a page never splits the outbounds of a block.
+func queryPendingOutbound(ctx cosmos.Context, req abci.RequestQuery, mgr *Mgrs) ([]byte, error) {
+	params, err := parseQueryParams(req.Data)
+	if err != nil {
+		return nil, err
+	}
+	page, err := newQueryPage(params)
+	if err != nil {
+		return nil, err
+	}
+	var chain common.Chain
+	if params.Get("chain") != "" {
+		chain, err = common.NewChain(params.Get("chain"))
+		if err != nil {
+			return nil, fmt.Errorf("fail to parse chain: %w", err)
+		}
+	}
+
 	constAccessor := mgr.GetConstants()
 	signingTransactionPeriod := constAccessor.GetInt64Value(constants.SigningTransactionPeriod)
 	startHeight := ctx.BlockHeight() - signingTransactionPeriod
-	var result []TxOutItem
-	for height := startHeight; height <= ctx.BlockHeight(); height++ {
+	if page.cursor != "" {
+		cursor, err := strconv.ParseInt(page.cursor, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("fail to parse cursor: %w", err)
+		}
+		if cursor >= startHeight {
+			startHeight = cursor + 1
+		}
+	}
+	var result []QueryTxOutItem
+	for height := startHeight; height <= ctx.BlockHeight() && !page.full(len(result)); height++ {
 		txs, err := mgr.Keeper().GetTxOut(ctx, height)
 		if err != nil {
 			ctx.Logger().Error("fail to get tx out array from key value store", "error", err)
 			return nil, fmt.Errorf("fail to get tx out array from key value store: %w", err)
 		}
 		for _, tx := range txs.TxArray {
-			if tx.OutHash.IsEmpty() {
-				result = append(result, tx)
+			if !tx.OutHash.IsEmpty() {
+				continue
 			}
+			if !chain.IsEmpty() && !tx.Chain.Equals(chain) {
+				continue
+			}
+			result = append(result, NewQueryTxOutItem(tx, height))
 		}
 	}
 
 	return jsonify(ctx, result)
 }
 
-func querySwapQueue(ctx cosmos.Context, mgr *Mgrs) ([]byte, error) {
+// querySwapQueue returns the queued swaps. The cursor is a tx id, and a page
+// never splits the swaps of a tx.
+func querySwapQueue(ctx cosmos.Context, req abci.RequestQuery, mgr *Mgrs) ([]byte, error) {
+	params, err := parseQueryParams(req.Data)
+	if err != nil {
+		return nil, err
+	}
+	page, err := newQueryPage(params)
+	if err != nil {
+		return nil, err
+	}
+
 	var result []MsgSwap
 
-	iterator := mgr.Keeper().GetSwapQueueIterator(ctx)
+	iterator := mgr.Keeper().GetSwapQueueIteratorAfter(ctx, page.cursor)
 	defer iterator.Close()
 	for ; iterator.Valid(); iterator.Next() {
 		var msg MsgSwap
 		if err := mgr.Keeper().Cdc().Unmarshal(iterator.Value(), &msg); err != nil {
 			continue
 		}
+		if page.full(len(result)) && !result[len(result)-1].Tx.ID.Equals(msg.Tx.ID) {
+			break
+		}
 		result = append(result, msg)
 	}
 
 	return jsonify(ctx, result)
 }
 
+// queryOrderBooks returns the limit orders of every trade pair in the order
+// book, grouped by price ratio
+func queryOrderBooks(ctx cosmos.Context, mgr *Mgrs) ([]byte, error) {
+	pairs := make(map[string]tradePair)
+	iterator := mgr.Keeper().GetOrderBookItemIterator(ctx)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var msg MsgSwap
+		if err := mgr.Keeper().Cdc().Unmarshal(iterator.Value(), &msg); err != nil {
+			continue
+		}
+		if msg.OrderType != LimitOrder || len(msg.Tx.Coins) == 0 {
+			continue
+		}
+		pair := genTradePair(msg.Tx.Coins[0].Asset, msg.TargetAsset)
+		pairs[pair.String()] = pair
+	}
+
+	keys := make([]string, 0, len(pairs))
+	for key := range pairs {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	result := make([]openapi.OrderBookPair, 0, len(keys))
+	for _, key := range keys {
+		book, err := getOrderBookPair(ctx, mgr, pairs[key])
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, book)
+	}
+	return jsonify(ctx, result)
+}
+
+// queryOrderBook returns the limit orders of a single trade pair, given by the
+// source and target query parameters (ie ?source=BTC.BTC&target=ETH/ETH). The
+// assets aren't given in the path, as synth assets contain a slash.
+func queryOrderBook(ctx cosmos.Context, req abci.RequestQuery, mgr *Mgrs) ([]byte, error) {
+	params, err := parseQueryParams(req.Data)
+	if err != nil {
+		return nil, err
+	}
+	for _, p := range []string{"source", "target"} {
+		if params.Get(p) == "" {
+			return nil, fmt.Errorf("missing required parameter %s", p)
+		}
+	}
+	source, err := common.NewAsset(params.Get("source"))
+	if err != nil {
+		return nil, fmt.Errorf("fail to parse source asset: %w", err)
+	}
+	target, err := common.NewAsset(params.Get("target"))
+	if err != nil {
+		return nil, fmt.Errorf("fail to parse target asset: %w", err)
+	}
+
+	book, err := getOrderBookPair(ctx, mgr, genTradePair(source, target))
+	if err != nil {
+		return nil, err
+	}
+	return jsonify(ctx, book)
+}
+
+// queryOrderBookOrder returns a single order book item
+func queryOrderBookOrder(ctx cosmos.Context, path []string, mgr *Mgrs) ([]byte, error) {
+	if len(path) == 0 {
+		return nil, errors.New("tx id not provided")
+	}
+	hash, err := common.NewTxID(path[0])
+	if err != nil {
+		return nil, fmt.Errorf("fail to parse tx id: %w", err)
+	}
+	if !mgr.Keeper().HasOrderBookItem(ctx, hash) {
+		return nil, fmt.Errorf("order not found: %s", hash)
+	}
+	msg, err := mgr.Keeper().GetOrderBookItem(ctx, hash)
+	if err != nil {
+		return nil, fmt.Errorf("fail to get order book item: %w", err)
+	}
+	return jsonify(ctx, newOrderBookOrder(msg))
+}
+
+// getOrderBookPair walks the limit order index of the given trade pair, from
+// the highest price ratio to the lowest, and aggregates the depth of each
+// price level and of the whole pair
+func getOrderBookPair(ctx cosmos.Context, mgr *Mgrs, pair tradePair) (openapi.OrderBookPair, error) {
+	book := openapi.OrderBookPair{
+		SourceAsset: pair.source.String(),
+		TargetAsset: pair.target.String(),
+		Levels:      make([]openapi.OrderBookLevel, 0),
+	}
+	sourceDepth := cosmos.ZeroUint()
+	targetDepth := cosmos.ZeroUint()
+
+	iter := mgr.Keeper().GetOrderBookIndexIterator(ctx, LimitOrder, pair.source, pair.target)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		// index keys end with the zero padded ratio, followed by a slash
+		parts := strings.Split(string(iter.Key()), "/")
+		if len(parts) < 2 {
+			continue
+		}
+		ratio, err := strconv.ParseUint(parts[len(parts)-2], 10, 64)
+		if err != nil {
+			ctx.Logger().Error("fail to parse ratio", "key", string(iter.Key()), "error", err)
+			continue
+		}
+
+		value := ProtoStrings{Value: make([]string, 0)}
+		if err := mgr.Keeper().Cdc().Unmarshal(iter.Value(), &value); err != nil {
+			return book, fmt.Errorf("fail to unmarshal order book index: %w", err)
+		}
+
+		level := openapi.OrderBookLevel{
+			Ratio:  strconv.FormatUint(ratio, 10),
+			Orders: make([]openapi.OrderBookOrder, 0, len(value.Value)),
+		}
+		levelSource := cosmos.ZeroUint()
+		levelTarget := cosmos.ZeroUint()
+		for _, rec := range value.Value {
+			hash, err := common.NewTxID(rec)
+			if err != nil {
+				ctx.Logger().Error("fail to parse tx hash", "error", err)
+				continue
+			}
+			msg, err := mgr.Keeper().GetOrderBookItem(ctx, hash)
+			if err != nil {
+				ctx.Logger().Error("fail to get order book item", "hash", hash, "error", err)
+				continue
+			}
+			level.Orders = append(level.Orders, newOrderBookOrder(msg))
+			levelSource = levelSource.Add(msg.Tx.Coins[0].Amount)
+			levelTarget = levelTarget.Add(msg.TradeTarget)
+		}
+		if len(level.Orders) == 0 {
+			continue
+		}
+		level.SourceAmount = levelSource.String()
+		level.TargetAmount = levelTarget.String()
+		book.Levels = append(book.Levels, level)
+		book.OrderCount += int64(len(level.Orders))
+		sourceDepth = sourceDepth.Add(levelSource)
+		targetDepth = targetDepth.Add(levelTarget)
+	}
+
+	book.SourceDepth = sourceDepth.String()
+	book.TargetDepth = targetDepth.String()
+	return book, nil
+}
+
+func newOrderBookOrder(msg MsgSwap) openapi.OrderBookOrder {
+	order := openapi.OrderBookOrder{
+		TxId:         msg.Tx.ID.String(),
+		FromAddress:  msg.Tx.FromAddress.String(),
+		Destination:  msg.Destination.String(),
+		TargetAsset:  msg.TargetAsset.String(),
+		TargetAmount: msg.TradeTarget.String(),
+		Ratio:        "0",
+		ExpiryHeight: wrapInt64(msg.ExpiryHeight),
+	}
+	if len(msg.Tx.Coins) > 0 {
+		order.SourceAsset = msg.Tx.Coins[0].Asset.String()
+		order.SourceAmount = msg.Tx.Coins[0].Amount.String()
+		if !msg.TradeTarget.IsZero() {
+			order.Ratio = msg.Tx.Coins[0].Amount.MulUint64(1e8).Quo(msg.TradeTarget).String()
+		}
+	}
+	return order
+}
+
 func queryTssKeygenMetric(ctx cosmos.Context, path []string, req abci.RequestQuery, mgr *Mgrs) ([]byte, error) {
 	var pubKeys common.PubKeys
 	if len(path) > 0 {
@@ -1821,6 +2245,45 @@ func queryInvariant(ctx cosmos.Context, path []string, mgr *Mgrs) ([]byte, error
 // Generic Helpers
 // -------------------------------------------------------------------------------------
 
+// parseQueryParams returns the query parameters of a list query, which are all
+// optional
+func parseQueryParams(data []byte) (url.Values, error) {
+	if len(data) == 0 {
+		return url.Values{}, nil
+	}
+	params, err := quoteParseParams(data)
+	if errors.Is(err, errQuoteNoParams) {
+		return url.Values{}, nil
+	}
+	return params, err
+}
+
+// queryPage is the cursor based pagination of a list query. Items are listed in
+// store order, starting after the item identified by the cursor, which is the
+// identifier of the last item of the previous page. The store iterator seeks to
+// the cursor, so a page only walks the items it lists.
+type queryPage struct {
+	cursor string
+	limit  int
+}
+
+func newQueryPage(params url.Values) (queryPage, error) {
+	page := queryPage{cursor: params.Get("cursor")}
+	if params.Get("limit") != "" {
+		limit, err := strconv.Atoi(params.Get("limit"))
+		if err != nil || limit < 0 {
+			return page, fmt.Errorf("invalid limit: %s", params.Get("limit"))
+		}
+		page.limit = limit
+	}
+	return page, nil
+}
+
+// full returns true when the page can't take any more items
+func (p queryPage) full(count int) bool {
+	return p.limit > 0 && count >= p.limit
+}
+
 func wrapString(s string) *string {
 	if s == "" {
 		return nil
@@ -1899,27 +2362,7 @@ func simulate(ctx cosmos.Context, mgr *Mgrs, msg sdk.Msg) (sdk.Events, error) {
 	// ctx = ctx.WithLogger(nullLogger)
 
 	// reset the swap queue
-	iter := mgr.Keeper().GetSwapQueueIterator(ctx)
-	defer iter.Close()
-	for ; iter.Valid(); iter.Next() {
-
-		// unmarshal message
-		var qm MsgSwap
-		err := mgr.cdc.Unmarshal(iter.Value(), &qm)
-		if err != nil {
-			continue
-		}
-
-		// split txid and index
-		ss := strings.Split(string(iter.Key()), "-")
-		i, err := strconv.Atoi(ss[len(ss)-1])
-		if err != nil {
-			continue
-		}
-
-		// remove from queue
-		mgr.Keeper().RemoveSwapQueueItem(ctx, common.TxID(ss[0]), i)
-	}
+	resetSwapQueue(ctx, mgr)
 
 	// simulate the loan open
 	_, err = NewInternalHandler(mgr)(ctx, msg)
@@ -2,9 +2,11 @@ package thorchain
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"net/url"
+	"strconv"
 	"strings"
 	"time"
 
@@ -35,12 +37,20 @@ const (
 	addressParam              = "address"
 	loanOwnerParam            = "loan_owner"
 	withdrawBasisPointsParam  = "withdraw_bps"
+	withdrawAssetParam        = "withdraw_asset"
 	amountParam               = "amount"
+	runeAmountParam           = "rune_amount"
+	assetAmountParam          = "asset_amount"
+	runeAddressParam          = "rune_address"
+	assetAddressParam         = "asset_address"
 	destinationParam          = "destination"
 	toleranceBasisPointsParam = "tolerance_bps"
 	affiliateParam            = "affiliate"
 	affiliateBpsParam         = "affiliate_bps"
 	minOutParam               = "min_out"
+	streamingIntervalParam    = "streaming_interval"
+	streamingQuantityParam    = "streaming_quantity"
+	compareSynthParam         = "compare_synth"
 
 	quoteWarning    = "Do not cache this response. Do not send funds after the expiry."
 	quoteExpiration = 15 * time.Minute
@@ -52,6 +62,8 @@ var nullLogger = &log.TendermintLogWrapper{Logger: zerolog.New(io.Discard)}
 // Helpers
 // -------------------------------------------------------------------------------------
 
+var errQuoteNoParams = errors.New("no parameters provided")
+
 func quoteErrorResponse(err error) ([]byte, error) {
 	return json.Marshal(map[string]string{"error": err.Error()})
 }
@@ -65,7 +77,7 @@ func quoteParseParams(data []byte) (params url.Values, err error) {
 
 	// error if parameters were not provided
 	if len(u.Query()) == 0 {
-		return nil, fmt.Errorf("no parameters provided")
+		return nil, errQuoteNoParams
 	}
 
 	return u.Query(), nil
@@ -98,26 +110,30 @@ func quoteParseAddress(ctx cosmos.Context, mgr *Mgrs, addrString string, chain c
 	return common.NoAddress, fmt.Errorf("no thorname alias for chain %s", chain)
 }
 
-func quoteHandleAffiliate(ctx cosmos.Context, mgr *Mgrs, params url.Values, amount sdk.Uint) (affiliate common.Address, memo string, bps, newAmount sdk.Uint, err error) {
-	// parse affiliate
-	memo = "" // do not resolve thorname for the memo
+func quoteHandleAffiliate(ctx cosmos.Context, mgr *Mgrs, params url.Values, amount sdk.Uint) (affiliates, memoAffiliates []types.Affiliate, bps, newAmount sdk.Uint, err error) {
+	// several affiliates can be given, separated by a "/"
+	var affiliateStrs, bpsStrs []string
 	if len(params[affiliateParam]) > 0 {
-		affiliate, err = quoteParseAddress(ctx, mgr, params[affiliateParam][0], common.THORChain)
-		if err != nil {
-			err = fmt.Errorf("bad affiliate address: %w", err)
-			return
-		}
-		memo = params[affiliateParam][0]
+		affiliateStrs = strings.Split(params[affiliateParam][0], "/")
+	}
+	if len(params[affiliateBpsParam]) > 0 {
+		bpsStrs = strings.Split(params[affiliateBpsParam][0], "/")
+	}
+	if len(affiliateStrs) > 1 && len(affiliateStrs) != len(bpsStrs) {
+		err = fmt.Errorf("affiliate and affiliate fee must have the same number of values")
+		return
 	}
 
 	// parse affiliate fee
 	bps = sdk.NewUint(0)
-	if len(params[affiliateBpsParam]) > 0 {
-		bps, err = sdk.ParseUint(params[affiliateBpsParam][0])
+	bpsList := make([]sdk.Uint, len(bpsStrs))
+	for i, bpsStr := range bpsStrs {
+		bpsList[i], err = sdk.ParseUint(bpsStr)
 		if err != nil {
 			err = fmt.Errorf("bad affiliate fee: %w", err)
 			return
 		}
+		bps = bps.Add(bpsList[i])
 	}
 
 	// verify affiliate fee
@@ -126,8 +142,25 @@ func quoteHandleAffiliate(ctx cosmos.Context, mgr *Mgrs, params url.Values, amou
 		return
 	}
 
+	// parse affiliate
+	for i, affiliateStr := range affiliateStrs {
+		var affiliate common.Address
+		affiliate, err = quoteParseAddress(ctx, mgr, affiliateStr, common.THORChain)
+		if err != nil {
+			err = fmt.Errorf("bad affiliate address: %w", err)
+			return
+		}
+		affBps := sdk.ZeroUint()
+		if i < len(bpsList) {
+			affBps = bpsList[i]
+		}
+		affiliates = append(affiliates, types.NewAffiliate(affiliate, affBps))
+		// do not resolve thorname for the memo
+		memoAffiliates = append(memoAffiliates, types.NewAffiliate(common.Address(affiliateStr), affBps))
+	}
+
 	// compute the new swap amount if an affiliate fee will be taken first
-	if affiliate != common.NoAddress && !bps.IsZero() {
+	if len(affiliates) > 0 && !bps.IsZero() {
 		// affiliate fee modifies amount at observation before the swap
 		amount = common.GetSafeShare(
 			cosmos.NewUint(10000).Sub(bps),
@@ -136,7 +169,16 @@ func quoteHandleAffiliate(ctx cosmos.Context, mgr *Mgrs, params url.Values, amou
 		)
 	}
 
-	return affiliate, memo, bps, amount, nil
+	return affiliates, memoAffiliates, bps, amount, nil
+}
+
+// quoteAffiliateAddress returns the address of the first of the given
+// affiliates, which memos and messages carry along with the total affiliate fee
+func quoteAffiliateAddress(affiliates []types.Affiliate) common.Address {
+	if len(affiliates) == 0 {
+		return common.NoAddress
+	}
+	return affiliates[0].Address
 }
 
 func hasPrefixMatch(prefix string, values []string) bool {
@@ -235,10 +277,44 @@ func quoteSimulateSwap(ctx cosmos.Context, mgr *Mgrs, amount sdk.Uint, msg *MsgS
 		affiliateFee = affiliateFee.Mul(factor).Quo(sdk.NewUint(10_000))
 	}
 
-	// sum the slip fees
+	// the liquidity fees of hops emitting rune are converted to the target asset
+	// at the target pool price
+	var targetPool Pool
+	if !msg.TargetAsset.IsRune() {
+		targetPool, err = mgr.Keeper().GetPool(ctx, msg.TargetAsset.GetLayer1Asset())
+		if err != nil {
+			return nil, sdk.ZeroUint(), sdk.ZeroUint(), fmt.Errorf("failed to get pool: %w", err)
+		}
+	}
+
+	// sum the slip and liquidity fees of each hop
 	slippageBps := sdk.ZeroUint()
+	liquidityFee := sdk.ZeroUint()
+	hops := make([]openapi.QuoteSwapHop, 0, len(swaps))
 	for _, s := range swaps {
-		slippageBps = slippageBps.Add(sdk.NewUintFromString(s["swap_slip"]))
+		hopEmit, err := common.ParseCoin(s["emit_asset"])
+		if err != nil {
+			return nil, sdk.ZeroUint(), sdk.ZeroUint(), fmt.Errorf("unable to parse emit coin: %w", err)
+		}
+		hopSlip := sdk.NewUintFromString(s["swap_slip"])
+		hopFee := sdk.NewUintFromString(s["liquidity_fee"])
+		hopFeeInRune := sdk.NewUintFromString(s["liquidity_fee_in_rune"])
+
+		slippageBps = slippageBps.Add(hopSlip)
+		if hopEmit.Asset.Equals(msg.TargetAsset) {
+			liquidityFee = liquidityFee.Add(hopFee)
+		} else {
+			liquidityFee = liquidityFee.Add(targetPool.RuneValueInAsset(hopFeeInRune))
+		}
+
+		hops = append(hops, openapi.QuoteSwapHop{
+			Pool:               s["pool"],
+			SlippageBps:        hopSlip.BigInt().Int64(),
+			EmitAsset:          hopEmit.Asset.String(),
+			EmitAmount:         hopEmit.Amount.String(),
+			LiquidityFee:       hopFee.String(),
+			LiquidityFeeInRune: hopFeeInRune.String(),
+		})
 	}
 
 	// build response from simulation result events
@@ -248,8 +324,10 @@ func quoteSimulateSwap(ctx cosmos.Context, mgr *Mgrs, amount sdk.Uint, msg *MsgS
 			Asset:     msg.TargetAsset.String(),
 			Affiliate: wrapString(affiliateFee.String()),
 			Outbound:  "0", // set by the caller if non-zero
+			Liquidity: wrapString(liquidityFee.String()),
 		},
 		SlippageBps: slippageBps.BigInt().Int64(),
+		Hops:        hops,
 	}, emitAmount, outboundFeeAmount, nil
 }
 
@@ -352,6 +430,132 @@ func calculateMinSwapAmount(ctx cosmos.Context, mgr *Mgrs, fromAsset, toAsset co
 	return minSwapAmount.Mul(cosmos.NewUint(4)), nil
 }
 
+// quoteFeelessEmit returns the amount of the target asset the swap would emit
+// assuming zero fees and slip, at the current pool prices
+func quoteFeelessEmit(ctx cosmos.Context, mgr *Mgrs, fromAsset, toAsset common.Asset, amount sdk.Uint) (sdk.Uint, error) {
+	feelessEmit := amount
+
+	// When one asset is RUNE, no conversion is necessary,
+	// and empty fields would cause a divide-by-zero error; skip it.
+	if !fromAsset.IsRune() {
+		// get from asset pool
+		fromPool, err := mgr.Keeper().GetPool(ctx, fromAsset.GetLayer1Asset())
+		if err != nil {
+			return sdk.ZeroUint(), fmt.Errorf("failed to get pool: %w", err)
+		}
+
+		// ensure pool exists
+		if fromPool.IsEmpty() {
+			return sdk.ZeroUint(), fmt.Errorf("pool does not exist")
+		}
+
+		feelessEmit = feelessEmit.Mul(fromPool.BalanceRune).Quo(fromPool.BalanceAsset)
+	}
+	if !toAsset.IsRune() {
+		// get to asset pool
+		toPool, err := mgr.Keeper().GetPool(ctx, toAsset.GetLayer1Asset())
+		if err != nil {
+			return sdk.ZeroUint(), fmt.Errorf("failed to get pool: %w", err)
+		}
+
+		// ensure pool exists
+		if toPool.IsEmpty() {
+			return sdk.ZeroUint(), fmt.Errorf("pool does not exist")
+		}
+
+		feelessEmit = feelessEmit.Mul(toPool.BalanceAsset).Quo(toPool.BalanceRune)
+	}
+
+	return feelessEmit, nil
+}
+
+// quoteSetTotalFeeBps sets the total of the fees in basis points of the amount
+// out before fees
+func quoteSetTotalFeeBps(fees *openapi.QuoteFees, amountOut sdk.Uint) {
+	total := sdk.ZeroUint()
+	for _, fee := range []*string{fees.Affiliate, fees.Liquidity, &fees.Outbound} {
+		if fee == nil {
+			continue
+		}
+		if amount, err := cosmos.ParseUint(*fee); err == nil {
+			total = total.Add(amount)
+		}
+	}
+	if total.Add(amountOut).IsZero() {
+		return
+	}
+	fees.TotalBps = wrapString(total.MulUint64(10_000).Quo(total.Add(amountOut)).String())
+}
+
+// quoteStreamingEmit estimates the emit amount of the streaming swap by simulating a
+// single sub-swap
+func quoteStreamingEmit(ctx cosmos.Context, mgr *Mgrs, amount sdk.Uint, msg MsgSwap, quantity uint64, emitAmount, outboundFeeAmount sdk.Uint) (sdk.Uint, error) {
+	streamEmitAmount := emitAmount
+	if quantity > 1 {
+		coin := msg.Tx.Coins[0]
+		msg.Tx.Coins = common.NewCoins(common.NewCoin(coin.Asset, coin.Amount.QuoUint64(quantity)))
+		msg.TradeTarget = sdk.ZeroUint()
+		_, subEmitAmount, _, err := quoteSimulateSwap(ctx, mgr, amount.QuoUint64(quantity), &msg)
+		if err != nil {
+			return sdk.ZeroUint(), fmt.Errorf("failed to simulate streaming swap: %w", err)
+		}
+		streamEmitAmount = subEmitAmount.MulUint64(quantity)
+	}
+	if streamEmitAmount.LT(outboundFeeAmount) {
+		return sdk.ZeroUint(), fmt.Errorf("invariant broken: streaming emit %s less than outbound fee %s", streamEmitAmount, outboundFeeAmount)
+	}
+	return streamEmitAmount, nil
+}
+
+// quoteSynthComparison simulates the swap to the synth of an L1 target asset,
+// or the L1 of a synth target asset, and compares the amount out
+func quoteSynthComparison(ctx cosmos.Context, mgr *Mgrs, amount sdk.Uint, msg MsgSwap, memo SwapMemo, asset common.Asset, amountOut sdk.Uint) (*openapi.QuoteSynthComparison, error) {
+	chain := common.THORChain
+	if !asset.IsSyntheticAsset() {
+		chain = asset.GetChain()
+	}
+	destination, err := types.GetRandomPubKey().GetAddress(chain)
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate address: %w", err)
+	}
+
+	memo.Asset = asset
+	memo.Destination = destination
+	memo.SlipLimit = sdk.ZeroUint()
+	memo.StreamInterval = 0
+	memo.StreamQuantity = 0
+	msg.Tx.Memo = memo.String()
+	msg.TargetAsset = asset
+	msg.Destination = destination
+	msg.TradeTarget = sdk.ZeroUint()
+
+	res, emitAmount, outboundFeeAmount, err := quoteSimulateSwap(ctx, mgr, amount, &msg)
+	if err != nil {
+		return nil, err
+	}
+	if emitAmount.LT(outboundFeeAmount) {
+		return nil, fmt.Errorf("invariant broken: emit %s less than outbound fee %s", emitAmount, outboundFeeAmount)
+	}
+	altAmountOut := emitAmount.Sub(outboundFeeAmount)
+	res.Fees.Outbound = outboundFeeAmount.String()
+	quoteSetTotalFeeBps(&res.Fees, altAmountOut)
+
+	// synths and their L1 asset share the same decimals
+	differenceBps := int64(0)
+	if !amountOut.IsZero() {
+		diff := cosmos.NewIntFromBigInt(altAmountOut.BigInt()).Sub(cosmos.NewIntFromBigInt(amountOut.BigInt()))
+		differenceBps = diff.MulRaw(10_000).Quo(cosmos.NewIntFromBigInt(amountOut.BigInt())).Int64()
+	}
+
+	return &openapi.QuoteSynthComparison{
+		Asset:             asset.String(),
+		ExpectedAmountOut: altAmountOut.String(),
+		Fees:              res.Fees,
+		SlippageBps:       res.SlippageBps,
+		DifferenceBps:     differenceBps,
+	}, nil
+}
+
 func queryQuoteSwap(ctx cosmos.Context, path []string, req abci.RequestQuery, mgr *Mgrs) ([]byte, error) {
 	// extract parameters
 	params, err := quoteParseParams(req.Data)
@@ -420,7 +624,7 @@ func queryQuoteSwap(ctx cosmos.Context, path []string, req abci.RequestQuery, mg
 	}
 
 	// parse affiliate
-	affiliate, affiliateMemo, affiliateBps, swapAmount, err := quoteHandleAffiliate(ctx, mgr, params, amount)
+	affiliates, memoAffiliates, affiliateBps, swapAmount, err := quoteHandleAffiliate(ctx, mgr, params, amount)
 	if err != nil {
 		return quoteErrorResponse(err)
 	}
@@ -459,39 +663,57 @@ func queryQuoteSwap(ctx cosmos.Context, path []string, req abci.RequestQuery, mg
 		}
 
 		// convert to a limit of target asset amount assuming zero fees and slip
-		feelessEmit := swapAmount
-
-		// When one asset is RUNE, no conversion is necessary,
-		// and empty fields would cause a divide-by-zero error; skip it.
-		if !fromAsset.IsRune() {
-			// get from asset pool
-			fromPool, err := mgr.Keeper().GetPool(ctx, fromAsset.GetLayer1Asset())
-			if err != nil {
-				return quoteErrorResponse(fmt.Errorf("failed to get pool: %w", err))
-			}
-
-			// ensure pool exists
-			if fromPool.IsEmpty() {
-				return quoteErrorResponse(fmt.Errorf("pool does not exist"))
-			}
+		feelessEmit, err := quoteFeelessEmit(ctx, mgr, fromAsset, toAsset, swapAmount)
+		if err != nil {
+			return quoteErrorResponse(err)
+		}
+		limit = feelessEmit.MulUint64(10000 - toleranceBasisPoints.Uint64()).QuoUint64(10000)
+	}
 
-			feelessEmit = feelessEmit.Mul(fromPool.BalanceRune).Quo(fromPool.BalanceAsset)
+	// parse streaming interval and quantity
+	streamInterval := uint64(0)
+	streamQuantity := uint64(0)
+	if len(params[streamingIntervalParam]) > 0 {
+		interval, err := sdk.ParseUint(params[streamingIntervalParam][0])
+		if err != nil {
+			return quoteErrorResponse(fmt.Errorf("bad streaming interval: %w", err))
 		}
-		if !toAsset.IsRune() {
-			// get to asset pool
-			toPool, err := mgr.Keeper().GetPool(ctx, toAsset.GetLayer1Asset())
-			if err != nil {
-				return quoteErrorResponse(fmt.Errorf("failed to get pool: %w", err))
-			}
+		streamInterval = interval.Uint64()
+	}
+	if len(params[streamingQuantityParam]) > 0 {
+		quantity, err := sdk.ParseUint(params[streamingQuantityParam][0])
+		if err != nil {
+			return quoteErrorResponse(fmt.Errorf("bad streaming quantity: %w", err))
+		}
+		streamQuantity = quantity.Uint64()
+	}
 
-			// ensure pool exists
-			if toPool.IsEmpty() {
-				return quoteErrorResponse(fmt.Errorf("pool does not exist"))
-			}
+	// parse synth comparison
+	compareSynth := false
+	if len(params[compareSynthParam]) > 0 {
+		compareSynth, err = strconv.ParseBool(params[compareSynthParam][0])
+		if err != nil {
+			return quoteErrorResponse(fmt.Errorf("bad compare synth: %w", err))
+		}
+	}
 
-			feelessEmit = feelessEmit.Mul(toPool.BalanceAsset).Quo(toPool.BalanceRune)
+	// determine the max and recommended streaming quantity, the streaming estimate is
+	// best effort and left unset on failure unless streaming was requested
+	streamingRequested := streamInterval > 0 || streamQuantity > 0
+	interval := streamInterval
+	if interval == 0 {
+		interval = 1
+	}
+	swp := NewStreamingSwap(common.BlankTxID, 0, interval, limit, swapAmount)
+	maxQuantity, streamingErr := getMaxSwapQuantity(ctx, mgr, fromAsset, toAsset, swp)
+	if streamingErr != nil {
+		if streamingRequested {
+			return quoteErrorResponse(fmt.Errorf("failed to calculate max streaming quantity: %w", streamingErr))
 		}
-		limit = feelessEmit.MulUint64(10000 - toleranceBasisPoints.Uint64()).QuoUint64(10000)
+		maxQuantity = 0
+	}
+	if streamQuantity == 0 || streamQuantity > maxQuantity {
+		streamQuantity = maxQuantity
 	}
 
 	// create the memo
@@ -502,9 +724,16 @@ func queryQuoteSwap(ctx cosmos.Context, path []string, req abci.RequestQuery, mg
 		},
 		Destination:          destination,
 		SlipLimit:            limit,
-		AffiliateAddress:     common.Address(affiliateMemo),
+		AffiliateAddress:     quoteAffiliateAddress(memoAffiliates),
 		AffiliateBasisPoints: affiliateBps,
 	}
+	if len(memoAffiliates) > 1 {
+		memo.Affiliates = memoAffiliates
+	}
+	if streamInterval > 0 {
+		memo.StreamInterval = streamInterval
+		memo.StreamQuantity = streamQuantity
+	}
 
 	// if from asset chain has memo length restrictions use a prefix
 	if fromAsset.Chain.MaxMemoLength() > 0 && len(memo.String()) > fromAsset.Chain.MaxMemoLength() {
@@ -536,9 +765,18 @@ func queryQuoteSwap(ctx cosmos.Context, path []string, req abci.RequestQuery, mg
 		TargetAsset:          toAsset,
 		TradeTarget:          limit,
 		Destination:          destination,
-		AffiliateAddress:     affiliate,
+		AffiliateAddress:     quoteAffiliateAddress(affiliates),
 		AffiliateBasisPoints: affiliateBps,
 	}
+	if len(affiliates) > 1 {
+		msg.Affiliates = affiliates
+	}
+
+	// the trade target of a streaming swap is checked against the total output,
+	// so the single swap simulation cannot use it
+	if streamInterval > 0 {
+		msg.TradeTarget = sdk.ZeroUint()
+	}
 
 	// simulate the swap
 	res, emitAmount, outboundFeeAmount, err := quoteSimulateSwap(ctx, mgr, amount, msg)
@@ -555,6 +793,68 @@ func queryQuoteSwap(ctx cosmos.Context, path []string, req abci.RequestQuery, mg
 	res.ExpectedAmountOut = emitAmount.Sub(outboundFeeAmount).String()
 	res.Fees.Outbound = outboundFeeAmount.String()
 
+	// estimate the streaming swap
+	singleAmountOut := emitAmount.Sub(outboundFeeAmount)
+	streamEmitAmount := emitAmount
+	if streamingErr == nil {
+		streamEmitAmount, streamingErr = quoteStreamingEmit(ctx, mgr, amount, *msg, streamQuantity, emitAmount, outboundFeeAmount)
+	}
+	if streamingErr != nil && streamingRequested {
+		return quoteErrorResponse(streamingErr)
+	}
+	if streamingErr == nil {
+		streamAmountOut := streamEmitAmount.Sub(outboundFeeAmount)
+		savingsBps := int64(0)
+		if !singleAmountOut.IsZero() && streamAmountOut.GT(singleAmountOut) {
+			savingsBps = int64(streamAmountOut.Sub(singleAmountOut).MulUint64(10_000).Quo(singleAmountOut).Uint64())
+		}
+		streamBlocks := int64((streamQuantity - 1) * interval)
+		res.MaxStreamingQuantity = wrapInt64(int64(maxQuantity))
+		res.StreamingInterval = wrapInt64(int64(interval))
+		res.StreamingQuantity = wrapInt64(int64(streamQuantity))
+		res.StreamingSwapBlocks = wrapInt64(streamBlocks)
+		res.StreamingSwapSeconds = wrapInt64(streamBlocks * common.THORChain.ApproximateBlockMilliseconds() / 1000)
+		res.ExpectedAmountOutStreaming = wrapString(streamAmountOut.String())
+		res.StreamingSwapSavingsBps = wrapInt64(savingsBps)
+	}
+
+	// the quoted amount out of a streaming swap is the streaming estimate
+	quotedEmitAmount := emitAmount
+	if streamInterval > 0 {
+		res.ExpectedAmountOut = streamEmitAmount.Sub(outboundFeeAmount).String()
+		quotedEmitAmount = streamEmitAmount
+	}
+	quoteSetTotalFeeBps(&res.Fees, quotedEmitAmount.Sub(outboundFeeAmount))
+
+	// recommend the lowest tolerance that passes the price limit, which is
+	// checked against the emitted amount before the outbound fee
+	feelessEmit, err := quoteFeelessEmit(ctx, mgr, fromAsset, toAsset, swapAmount)
+	if err != nil {
+		return quoteErrorResponse(err)
+	}
+	if !feelessEmit.IsZero() && feelessEmit.GT(quotedEmitAmount) {
+		diff := feelessEmit.Sub(quotedEmitAmount).MulUint64(10_000)
+		toleranceBps := diff.Quo(feelessEmit)
+		if !diff.Mod(feelessEmit).IsZero() {
+			toleranceBps = toleranceBps.AddUint64(1)
+		}
+		res.RecommendedToleranceBps = wrapInt64(toleranceBps.BigInt().Int64())
+	}
+
+	// compare the amount out against the synth or L1 equivalent of the target
+	if compareSynth && !toAsset.IsRune() && !toAsset.IsDerivedAsset() {
+		altAsset := toAsset.GetSyntheticAsset()
+		if toAsset.IsSyntheticAsset() {
+			altAsset = toAsset.GetLayer1Asset()
+		}
+		if !altAsset.Equals(fromAsset) {
+			res.SynthComparison, err = quoteSynthComparison(ctx, mgr, amount, *msg, *memo, altAsset, singleAmountOut)
+			if err != nil {
+				return quoteErrorResponse(fmt.Errorf("failed to simulate synth comparison: %w", err))
+			}
+		}
+	}
+
 	// estimate the inbound info
 	inboundAddress, routerAddress, inboundConfirmations, err := quoteInboundInfo(ctx, mgr, amount, fromAsset.GetChain())
 	if err != nil {
@@ -630,7 +930,7 @@ func queryQuoteSaverDeposit(ctx cosmos.Context, path []string, req abci.RequestQ
 	}
 
 	// parse affiliate
-	affiliate, affiliateMemo, affiliateBps, depositAmount, err := quoteHandleAffiliate(ctx, mgr, params, amount)
+	affiliates, memoAffiliates, affiliateBps, depositAmount, err := quoteHandleAffiliate(ctx, mgr, params, amount)
 	if err != nil {
 		return quoteErrorResponse(err)
 	}
@@ -642,9 +942,12 @@ func queryQuoteSaverDeposit(ctx cosmos.Context, path []string, req abci.RequestQ
 			Asset:  asset.GetSyntheticAsset(),
 		},
 		SlipLimit:            sdk.ZeroUint(),
-		AffiliateAddress:     common.Address(affiliateMemo),
+		AffiliateAddress:     quoteAffiliateAddress(memoAffiliates),
 		AffiliateBasisPoints: affiliateBps,
 	}
+	if len(memoAffiliates) > 1 {
+		memo.Affiliates = memoAffiliates
+	}
 
 	// use random destination address
 	destination, err := types.GetRandomPubKey().GetAddress(common.THORChain)
@@ -675,10 +978,13 @@ func queryQuoteSaverDeposit(ctx cosmos.Context, path []string, req abci.RequestQ
 		},
 		TargetAsset:          asset.GetSyntheticAsset(),
 		TradeTarget:          sdk.ZeroUint(),
-		AffiliateAddress:     affiliate,
+		AffiliateAddress:     quoteAffiliateAddress(affiliates),
 		AffiliateBasisPoints: affiliateBps,
 		Destination:          destination,
 	}
+	if len(affiliates) > 1 {
+		msg.Affiliates = affiliates
+	}
 
 	// get the swap result
 	swapRes, _, _, err := quoteSimulateSwap(ctx, mgr, amount, msg)
@@ -687,16 +993,13 @@ func queryQuoteSaverDeposit(ctx cosmos.Context, path []string, req abci.RequestQ
 	}
 
 	// generate deposit memo
-	depositMemoComponents := []string{
-		"+",
-		asset.GetSyntheticAsset().String(),
-		"",
-		affiliateMemo,
-		affiliateBps.String(),
-	}
-	depositMemo := strings.Join(depositMemoComponents[:2], ":")
-	if affiliate != common.NoAddress && !affiliateBps.IsZero() {
-		depositMemo = strings.Join(depositMemoComponents, ":")
+	depositMemo := mem.NewAddLiquidityMemo(asset.GetSyntheticAsset(), common.NoAddress, common.NoAddress, sdk.ZeroUint())
+	if len(affiliates) > 0 && !affiliateBps.IsZero() {
+		depositMemo.AffiliateAddress = quoteAffiliateAddress(memoAffiliates)
+		depositMemo.AffiliateBasisPoints = affiliateBps
+		if len(memoAffiliates) > 1 {
+			depositMemo.Affiliates = memoAffiliates
+		}
 	}
 
 	// use the swap result info to generate the deposit quote
@@ -708,7 +1011,7 @@ func queryQuoteSaverDeposit(ctx cosmos.Context, path []string, req abci.RequestQ
 		SlippageBps:                swapRes.SlippageBps,
 		InboundConfirmationBlocks:  swapRes.InboundConfirmationBlocks,
 		InboundConfirmationSeconds: swapRes.InboundConfirmationSeconds,
-		Memo:                       depositMemo,
+		Memo:                       depositMemo.String(),
 	}
 
 	// estimate the inbound info
@@ -719,6 +1022,13 @@ func queryQuoteSaverDeposit(ctx cosmos.Context, path []string, req abci.RequestQ
 	res.InboundAddress = inboundAddress.String()
 	res.InboundConfirmationBlocks = wrapInt64(inboundConfirmations)
 
+	// the expected yield is the moving average of the recent savers yield
+	growth, err := mgr.Keeper().GetSaversGrowth(ctx, asset.GetSyntheticAsset())
+	if err != nil {
+		return quoteErrorResponse(fmt.Errorf("failed to get savers growth: %w", err))
+	}
+	res.ExpectedAprBps = wrapInt64(growth.AprBps())
+
 	// set info fields
 	chain := asset.GetLayer1Asset().Chain
 	if !chain.DustThreshold().IsZero() {
@@ -880,6 +1190,370 @@ func queryQuoteSaverWithdraw(ctx cosmos.Context, path []string, req abci.Request
 	return json.MarshalIndent(res, "", "  ")
 }
 
+// -------------------------------------------------------------------------------------
+// Liquidity Add
+// -------------------------------------------------------------------------------------
+
+// quoteILPProtection returns the blocks to full impermanent loss protection and
+// whether a position last added at the given height is covered, following the
+// conditions applied on withdraw
+func quoteILPProtection(ctx cosmos.Context, mgr *Mgrs, pool Pool, lastAddHeight int64) (int64, bool) {
+	fullProtectionLine, err := mgr.Keeper().GetMimir(ctx, constants.FullImpLossProtectionBlocks.String())
+	if fullProtectionLine < 0 || err != nil {
+		fullProtectionLine = mgr.GetConstants().GetInt64Value(constants.FullImpLossProtectionBlocks)
+	}
+	ilpDisabled, err := mgr.Keeper().GetMimir(ctx, fmt.Sprintf("ILP-DISABLED-%s", pool.Asset))
+	if err != nil {
+		ilpDisabled = 0
+	}
+	ilpCutoff := mgr.Keeper().GetConfigInt64(ctx, constants.ILPCutoff)
+
+	covered := (ilpCutoff <= 0 || ilpCutoff > lastAddHeight) &&
+		fullProtectionLine > 0 &&
+		pool.Status == PoolAvailable &&
+		!(ilpDisabled > 0 && !pool.Asset.IsVaultAsset())
+	return fullProtectionLine, covered
+}
+
+func queryQuoteLiquidityAdd(ctx cosmos.Context, path []string, req abci.RequestQuery, mgr *Mgrs) ([]byte, error) {
+	// extract parameters
+	params, err := quoteParseParams(req.Data)
+	if err != nil {
+		return quoteErrorResponse(err)
+	}
+
+	// validate required parameters
+	if len(params[assetParam]) == 0 {
+		return quoteErrorResponse(fmt.Errorf("missing required parameter %s", assetParam))
+	}
+	if len(params[runeAmountParam]) == 0 && len(params[assetAmountParam]) == 0 {
+		return quoteErrorResponse(fmt.Errorf("missing required parameter %s or %s", runeAmountParam, assetAmountParam))
+	}
+
+	// parse asset
+	asset, err := common.NewAsset(params[assetParam][0])
+	if err != nil {
+		return quoteErrorResponse(fmt.Errorf("bad asset: %w", err))
+	}
+	if asset.IsSyntheticAsset() {
+		return quoteErrorResponse(fmt.Errorf("use the saver deposit quote for savers"))
+	}
+
+	// parse amounts
+	runeAmount := sdk.ZeroUint()
+	if len(params[runeAmountParam]) > 0 {
+		runeAmount, err = cosmos.ParseUint(params[runeAmountParam][0])
+		if err != nil {
+			return quoteErrorResponse(fmt.Errorf("bad rune amount: %w", err))
+		}
+	}
+	assetAmount := sdk.ZeroUint()
+	if len(params[assetAmountParam]) > 0 {
+		assetAmount, err = cosmos.ParseUint(params[assetAmountParam][0])
+		if err != nil {
+			return quoteErrorResponse(fmt.Errorf("bad asset amount: %w", err))
+		}
+	}
+	if runeAmount.IsZero() && assetAmount.IsZero() {
+		return quoteErrorResponse(fmt.Errorf("rune amount and asset amount cannot both be zero"))
+	}
+
+	// parse addresses
+	runeAddress := common.NoAddress
+	if len(params[runeAddressParam]) > 0 {
+		runeAddress, err = quoteParseAddress(ctx, mgr, params[runeAddressParam][0], common.THORChain)
+		if err != nil {
+			return quoteErrorResponse(fmt.Errorf("bad rune address: %w", err))
+		}
+		if !runeAddress.IsChain(common.THORChain) {
+			return quoteErrorResponse(fmt.Errorf("rune address is not a %s address", common.THORChain))
+		}
+	}
+	assetAddress := common.NoAddress
+	if len(params[assetAddressParam]) > 0 {
+		assetAddress, err = quoteParseAddress(ctx, mgr, params[assetAddressParam][0], asset.GetChain())
+		if err != nil {
+			return quoteErrorResponse(fmt.Errorf("bad asset address: %w", err))
+		}
+		if !assetAddress.IsChain(asset.GetChain()) {
+			return quoteErrorResponse(fmt.Errorf("asset address is not a %s address", asset.GetChain()))
+		}
+	}
+
+	// a symmetric add is matched on the paired address of each side
+	symmetric := !runeAmount.IsZero() && !assetAmount.IsZero()
+	if symmetric && (runeAddress.IsEmpty() || assetAddress.IsEmpty()) {
+		return quoteErrorResponse(fmt.Errorf("rune address and asset address are required for a symmetric add"))
+	}
+
+	// get the pool
+	pool, err := mgr.Keeper().GetPool(ctx, asset)
+	if err != nil {
+		return quoteErrorResponse(fmt.Errorf("failed to get pool: %w", err))
+	}
+	if pool.IsEmpty() {
+		return quoteErrorResponse(fmt.Errorf("pool %s does not exist", asset))
+	}
+	if pool.Status == PoolSuspended {
+		return quoteErrorResponse(fmt.Errorf("pool %s is suspended", asset))
+	}
+	if pool.Status == PoolStaged && !symmetric {
+		return quoteErrorResponse(fmt.Errorf("cannot add single sided liquidity while a pool is staged"))
+	}
+
+	// calculate the liquidity units of the add
+	synthSupply := mgr.Keeper().GetTotalSupply(ctx, pool.Asset.GetSyntheticAsset())
+	pool.CalcUnits(mgr.GetVersion(), synthSupply)
+	poolUnits := pool.GetPoolUnits()
+	newPoolUnits, units, err := NewAddLiquidityHandler(mgr).calculatePoolUnits(poolUnits, pool.BalanceRune, pool.BalanceAsset, runeAmount, assetAmount)
+	if err != nil {
+		return quoteErrorResponse(fmt.Errorf("failed to calculate pool units: %w", err))
+	}
+	if units.IsZero() {
+		return quoteErrorResponse(fmt.Errorf("amount too small to receive liquidity units"))
+	}
+
+	// the slip is the value lost by the deposit against the value the units
+	// redeem after the add, both valued in rune at the pool price before the add
+	redeemRune := common.GetSafeShare(units, newPoolUnits, pool.BalanceRune.Add(runeAmount))
+	redeemAsset := common.GetSafeShare(units, newPoolUnits, pool.BalanceAsset.Add(assetAmount))
+	depositValue := runeAmount.Add(common.GetSafeShare(assetAmount, pool.BalanceAsset, pool.BalanceRune))
+	redeemValue := redeemRune.Add(common.GetSafeShare(redeemAsset, pool.BalanceAsset, pool.BalanceRune))
+	slippageBps := sdk.ZeroUint()
+	if !depositValue.IsZero() {
+		slippageBps = common.SafeSub(depositValue, redeemValue).MulUint64(10_000).Quo(depositValue)
+	}
+
+	res := &openapi.QuoteLiquidityAddResponse{
+		ExpectedUnits:        units.String(),
+		ExpectedPoolShareBps: common.GetSafeShare(units, newPoolUnits, sdk.NewUint(10_000)).BigInt().Int64(),
+		SlippageBps:          slippageBps.BigInt().Int64(),
+	}
+
+	// an add resets the impermanent loss protection period of the position
+	fullProtectionLine, covered := quoteILPProtection(ctx, mgr, pool, ctx.BlockHeight())
+	if covered {
+		res.IlpFullProtectionBlocks = wrapInt64(fullProtectionLine)
+	}
+
+	// generate the memos, the asset side is sent to the inbound address and the
+	// rune side is deposited on thorchain
+	chain := asset.GetChain()
+	if !assetAmount.IsZero() {
+		res.Memo = mem.NewAddLiquidityMemo(asset, runeAddress, common.NoAddress, sdk.ZeroUint()).String()
+		if symmetric {
+			res.RuneMemo = wrapString(mem.NewAddLiquidityMemo(asset, assetAddress, common.NoAddress, sdk.ZeroUint()).String())
+		}
+
+		// estimate the inbound info
+		inboundAddress, routerAddress, inboundConfirmations, err := quoteInboundInfo(ctx, mgr, assetAmount, chain)
+		if err != nil {
+			return quoteErrorResponse(err)
+		}
+		res.InboundAddress = wrapString(inboundAddress.String())
+		if inboundConfirmations > 0 {
+			res.InboundConfirmationBlocks = wrapInt64(inboundConfirmations)
+			res.InboundConfirmationSeconds = wrapInt64(inboundConfirmations * chain.ApproximateBlockMilliseconds() / 1000)
+		}
+		if chain.IsEVM() {
+			res.Router = wrapString(routerAddress.String())
+		}
+		if !chain.DustThreshold().IsZero() {
+			res.DustThreshold = wrapString(chain.DustThreshold().String())
+			res.RecommendedMinAmountIn = res.DustThreshold
+		}
+	} else {
+		res.Memo = mem.NewAddLiquidityMemo(asset, assetAddress, common.NoAddress, sdk.ZeroUint()).String()
+	}
+
+	// set info fields
+	res.Notes = chain.InboundNotes()
+	res.Warning = quoteWarning
+	res.Expiry = time.Now().Add(quoteExpiration).Unix()
+
+	return json.MarshalIndent(res, "", "  ")
+}
+
+// -------------------------------------------------------------------------------------
+// Liquidity Withdraw
+// -------------------------------------------------------------------------------------
+
+func queryQuoteLiquidityWithdraw(ctx cosmos.Context, path []string, req abci.RequestQuery, mgr *Mgrs) ([]byte, error) {
+	// extract parameters
+	params, err := quoteParseParams(req.Data)
+	if err != nil {
+		return quoteErrorResponse(err)
+	}
+
+	// validate required parameters
+	for _, p := range []string{assetParam, addressParam, withdrawBasisPointsParam} {
+		if len(params[p]) == 0 {
+			return quoteErrorResponse(fmt.Errorf("missing required parameter %s", p))
+		}
+	}
+
+	// parse asset
+	asset, err := common.NewAsset(params[assetParam][0])
+	if err != nil {
+		return quoteErrorResponse(fmt.Errorf("bad asset: %w", err))
+	}
+	if asset.IsSyntheticAsset() {
+		return quoteErrorResponse(fmt.Errorf("use the saver withdraw quote for savers"))
+	}
+
+	// parse address
+	address, err := common.NewAddress(params[addressParam][0])
+	if err != nil {
+		return quoteErrorResponse(fmt.Errorf("bad address: %w", err))
+	}
+
+	// parse basis points
+	basisPoints, err := cosmos.ParseUint(params[withdrawBasisPointsParam][0])
+	if err != nil {
+		return quoteErrorResponse(fmt.Errorf("bad basis points: %w", err))
+	}
+	if basisPoints.IsZero() || basisPoints.GT(sdk.NewUint(MaxWithdrawBasisPoints)) {
+		return quoteErrorResponse(fmt.Errorf("basis points must be between 1 and %d", MaxWithdrawBasisPoints))
+	}
+
+	// parse withdraw asset
+	withdrawAsset := common.EmptyAsset
+	if len(params[withdrawAssetParam]) > 0 {
+		withdrawAsset, err = common.NewAsset(params[withdrawAssetParam][0])
+		if err != nil {
+			return quoteErrorResponse(fmt.Errorf("bad withdraw asset: %w", err))
+		}
+		if !withdrawAsset.IsRune() && !withdrawAsset.Equals(asset) {
+			return quoteErrorResponse(fmt.Errorf("withdraw asset must be %s or %s", common.RuneAsset(), asset))
+		}
+	}
+
+	// get the liquidity provider
+	lp, err := mgr.Keeper().GetLiquidityProvider(ctx, asset, address)
+	if err != nil {
+		return quoteErrorResponse(fmt.Errorf("failed to get liquidity provider: %w", err))
+	}
+	if lp.Units.IsZero() {
+		return quoteErrorResponse(fmt.Errorf("liquidity provider has no units"))
+	}
+
+	// get the pool
+	pool, err := mgr.Keeper().GetPool(ctx, asset)
+	if err != nil {
+		return quoteErrorResponse(fmt.Errorf("failed to get pool: %w", err))
+	}
+	synthSupply := mgr.Keeper().GetTotalSupply(ctx, pool.Asset.GetSyntheticAsset())
+	pool.CalcUnits(mgr.GetVersion(), synthSupply)
+
+	// check the lockup period
+	lockupBlocks := mgr.Keeper().GetConfigInt64(ctx, constants.LiquidityLockUpBlocks)
+	if ctx.BlockHeight() < lp.LastAddHeight+lockupBlocks {
+		return quoteErrorResponse(fmt.Errorf("liquidity is locked until block %d", lp.LastAddHeight+lockupBlocks))
+	}
+
+	// run the withdraw on a branch of the state, which is never committed
+	msg := MsgWithdrawLiquidity{
+		Tx:              common.Tx{ID: common.BlankTxID},
+		WithdrawAddress: address,
+		BasisPoints:     basisPoints,
+		Asset:           asset,
+		WithdrawalAsset: withdrawAsset,
+	}
+	cacheCtx, _ := ctx.CacheContext()
+	runeOut, assetOut, protectionRune, units, _, err := withdraw(cacheCtx.WithLogger(nullLogger), msg, mgr)
+	if err != nil {
+		return quoteErrorResponse(fmt.Errorf("failed to simulate withdraw: %w", err))
+	}
+
+	// the protection granted, in basis points of the impermanent loss
+	protectionBps := int64(0)
+	if fullProtectionLine, covered := quoteILPProtection(ctx, mgr, pool, lp.LastAddHeight); covered {
+		lastAddHeight := lp.LastAddHeight
+		if lastAddHeight < pool.StatusSince {
+			lastAddHeight = pool.StatusSince
+		}
+		protectionBps = calcImpLossProtectionAmtV1(ctx, lastAddHeight, fullProtectionLine)
+	}
+
+	// the slip of an asymmetric withdraw is the value lost against a symmetric
+	// withdraw of the same units, both valued in rune at the pool price
+	slippageBps := sdk.ZeroUint()
+	if runeOut.IsZero() != assetOut.IsZero() && !pool.BalanceAsset.IsZero() {
+		symValue := common.GetSafeShare(units, pool.GetPoolUnits(), pool.BalanceRune).MulUint64(2).Add(protectionRune)
+		value := runeOut.Add(common.GetSafeShare(assetOut, pool.BalanceAsset, pool.BalanceRune))
+		if !symValue.IsZero() {
+			slippageBps = common.SafeSub(symValue, value).MulUint64(10_000).Quo(symValue)
+		}
+	}
+
+	// the outbound fees are deducted from each side
+	fees := openapi.QuoteFees{Asset: asset.String(), Outbound: "0"}
+	if !runeOut.IsZero() {
+		runeFee := mgr.GasMgr().GetFee(ctx, common.THORChain, common.RuneAsset())
+		runeOut = common.SafeSub(runeOut, runeFee)
+		if assetOut.IsZero() {
+			fees = openapi.QuoteFees{Asset: common.RuneAsset().String(), Outbound: runeFee.String()}
+		}
+	}
+	if !assetOut.IsZero() {
+		assetFee := mgr.GasMgr().GetFee(ctx, asset.GetChain(), asset)
+		assetOut = common.SafeSub(assetOut, assetFee)
+		fees.Outbound = assetFee.String()
+	}
+
+	// generate the memo
+	memo := fmt.Sprintf("-:%s:%s", asset, basisPoints)
+	if !withdrawAsset.IsEmpty() {
+		memo = fmt.Sprintf("%s:%s", memo, withdrawAsset)
+	}
+
+	res := &openapi.QuoteLiquidityWithdrawResponse{
+		Memo:             memo,
+		ExpectedUnits:    units.String(),
+		ExpectedRuneOut:  runeOut.String(),
+		ExpectedAssetOut: assetOut.String(),
+		IlpProtectionBps: protectionBps,
+		IlpRune:          protectionRune.String(),
+		Fees:             fees,
+		SlippageBps:      slippageBps.BigInt().Int64(),
+	}
+
+	// positions with an asset address withdraw from the asset chain, others
+	// deposit the memo on thorchain
+	chain := asset.GetChain()
+	if address.IsChain(chain) {
+		inboundAddress, routerAddress, _, err := quoteInboundInfo(ctx, mgr, chain.DustThreshold(), chain)
+		if err != nil {
+			return quoteErrorResponse(err)
+		}
+		res.InboundAddress = wrapString(inboundAddress.String())
+		if chain.IsEVM() {
+			res.Router = wrapString(routerAddress.String())
+		}
+		if !chain.DustThreshold().IsZero() {
+			res.DustThreshold = wrapString(chain.DustThreshold().String())
+			res.RecommendedMinAmountIn = res.DustThreshold
+		}
+	}
+
+	// estimate the outbound info
+	if !assetOut.IsZero() {
+		outboundDelay, err := quoteOutboundInfo(ctx, mgr, common.NewCoin(asset, assetOut))
+		if err != nil {
+			return quoteErrorResponse(err)
+		}
+		res.OutboundDelayBlocks = outboundDelay
+		res.OutboundDelaySeconds = outboundDelay * common.THORChain.ApproximateBlockMilliseconds() / 1000
+	}
+
+	// set info fields
+	res.Notes = chain.InboundNotes()
+	res.Warning = quoteWarning
+	res.Expiry = time.Now().Add(quoteExpiration).Unix()
+
+	return json.MarshalIndent(res, "", "  ")
+}
+
 // -------------------------------------------------------------------------------------
 // Loan Open
 // -------------------------------------------------------------------------------------
@@ -936,10 +1610,11 @@ func queryQuoteLoanOpen(ctx cosmos.Context, path []string, req abci.RequestQuery
 	}
 
 	// parse affiliate
-	affiliate, affiliateMemo, affiliateBps, _, err := quoteHandleAffiliate(ctx, mgr, params, amount)
+	affiliates, memoAffiliates, affiliateBps, _, err := quoteHandleAffiliate(ctx, mgr, params, amount)
 	if err != nil {
 		return quoteErrorResponse(err)
 	}
+	affiliate := quoteAffiliateAddress(affiliates)
 
 	// TODO: remove after affiliates work
 	if !affiliate.IsEmpty() || len(params[affiliateBpsParam]) > 0 {
@@ -1180,7 +1855,7 @@ func queryQuoteLoanOpen(ctx cosmos.Context, path []string, req abci.RequestQuery
 			TargetAsset:          targetAsset,
 			TargetAddress:        destination,
 			MinOut:               minOut,
-			AffiliateAddress:     common.Address(affiliateMemo),
+			AffiliateAddress:     quoteAffiliateAddress(memoAffiliates),
 			AffiliateBasisPoints: affiliateBps,
 			DexTargetLimit:       sdk.ZeroUint(),
 		}
@@ -1394,6 +2069,14 @@ func queryQuoteLoanClose(ctx cosmos.Context, path []string, req abci.RequestQuer
 				}
 			}
 
+		// extract the interest added to the debt before the repayment
+		case "loan_interest":
+			for _, attr := range e.Attributes {
+				if string(attr.Key) == "interest" {
+					res.ExpectedInterest = wrapString(string(attr.Value))
+				}
+			}
+
 		// catch refund if there was an issue
 		case "refund":
 			for _, attr := range e.Attributes {
@@ -0,0 +1,276 @@
+package thorchain
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"gitlab.com/thorchain/thornode/common"
+	"gitlab.com/thorchain/thornode/common/cosmos"
+	"gitlab.com/thorchain/thornode/x/thorchain/types"
+)
+
+const (
+	memoParam = "memo"
+)
+
+// emitResultEvents emits the events of a handler result, the external handler
+// runs with its own event manager
+func emitResultEvents(ctx cosmos.Context, res *cosmos.Result) {
+	if res == nil {
+		return
+	}
+	for _, evt := range res.Events {
+		ctx.EventManager().EmitEvent(cosmos.Event(evt))
+	}
+}
+
+// resetSwapQueue removes every swap from the queue. Items are keyed by the tx id
+// and index of the swap, the index is the last part of the key.
+func resetSwapQueue(ctx cosmos.Context, mgr *Mgrs) {
+	type queueItem struct {
+		txID  common.TxID
+		index int
+	}
+	var items []queueItem
+	iter := mgr.Keeper().GetSwapQueueIterator(ctx)
+	for ; iter.Valid(); iter.Next() {
+		var msg MsgSwap
+		if err := mgr.Keeper().Cdc().Unmarshal(iter.Value(), &msg); err != nil {
+			continue
+		}
+		key := string(iter.Key())
+		i, err := strconv.Atoi(key[strings.LastIndex(key, "-")+1:])
+		if err != nil {
+			continue
+		}
+		items = append(items, queueItem{txID: msg.Tx.ID, index: i})
+	}
+	iter.Close()
+
+	for _, item := range items {
+		mgr.Keeper().RemoveSwapQueueItem(ctx, item.txID, item.index)
+	}
+}
+
+// simulateInbound runs the handler of an inbound tx on a branch of the state,
+// followed by the swap queue, and collects the resulting events, outbounds and
+// fees. Swaps already in the queue are dropped so only the simulated tx is
+// processed. An error of the handler is reported in the response.
+func simulateInbound(ctx cosmos.Context, mgr *Mgrs, run func(ctx cosmos.Context) error) (QuerySimulateResponse, error) {
+	resp := QuerySimulateResponse{
+		Events:    make([]map[string]string, 0),
+		Outbounds: make([]QueryTxOutItem, 0),
+		Fees: QuerySimulateFees{
+			Liquidity: cosmos.ZeroUint(),
+			Outbound:  common.NewCoins(),
+		},
+	}
+
+	// intercept events and avoid modifying state
+	cms := ctx.MultiStore().CacheMultiStore() // never call cms.Write()
+	em := cosmos.NewEventManager()
+	ctx = ctx.WithMultiStore(cms).WithEventManager(em).WithLogger(nullLogger)
+
+	// outbounds already scheduled in this block are not part of the result
+	txOut, err := mgr.Keeper().GetTxOut(ctx, ctx.BlockHeight())
+	if err != nil {
+		return resp, fmt.Errorf("fail to get tx out: %w", err)
+	}
+	existing := len(txOut.TxArray)
+
+	resetSwapQueue(ctx, mgr)
+
+	if err := run(ctx); err != nil {
+		resp.Error = err.Error()
+	} else if err := mgr.SwapQ().EndBlock(ctx, mgr); err != nil {
+		resp.Error = fmt.Sprintf("fail to process swap queue: %s", err)
+	}
+
+	for _, evt := range em.Events() {
+		m := eventMap(evt)
+		resp.Events = append(resp.Events, m)
+		switch evt.Type {
+		case types.SwapEventType:
+			if fee, err := cosmos.ParseUint(m["liquidity_fee_in_rune"]); err == nil {
+				resp.Fees.Liquidity = resp.Fees.Liquidity.Add(fee)
+			}
+		case types.FeeEventType:
+			for _, c := range strings.Split(m["coins"], ", ") {
+				if coin, err := common.ParseCoin(c); err == nil {
+					resp.Fees.Outbound = resp.Fees.Outbound.Add(coin)
+				}
+			}
+		case types.RefundEventType:
+			if resp.Error == "" {
+				resp.Error = m["reason"]
+			}
+		}
+	}
+
+	txOut, err = mgr.Keeper().GetTxOut(ctx, ctx.BlockHeight())
+	if err != nil {
+		return resp, fmt.Errorf("fail to get tx out: %w", err)
+	}
+	if len(txOut.TxArray) > existing {
+		for _, item := range txOut.TxArray[existing:] {
+			resp.Outbounds = append(resp.Outbounds, NewQueryTxOutItem(item, ctx.BlockHeight()))
+		}
+	}
+
+	return resp, nil
+}
+
+// querySimulateDeposit dry-runs a MsgDeposit with the given memo, coin and
+// sender, as if broadcast in the next block.
+func querySimulateDeposit(ctx cosmos.Context, path []string, req abci.RequestQuery, mgr *Mgrs) ([]byte, error) {
+	params, err := quoteParseParams(req.Data)
+	if err != nil {
+		return quoteErrorResponse(err)
+	}
+	for _, p := range []string{memoParam, amountParam, fromAddressParam} {
+		if len(params[p]) == 0 {
+			return quoteErrorResponse(fmt.Errorf("missing required parameter %s", p))
+		}
+	}
+
+	asset := common.RuneNative
+	if len(params[assetParam]) > 0 {
+		asset, err = common.NewAsset(params[assetParam][0])
+		if err != nil {
+			return quoteErrorResponse(fmt.Errorf("bad asset: %w", err))
+		}
+	}
+	amount, err := cosmos.ParseUint(params[amountParam][0])
+	if err != nil {
+		return quoteErrorResponse(fmt.Errorf("bad amount: %w", err))
+	}
+	signer, err := cosmos.AccAddressFromBech32(params[fromAddressParam][0])
+	if err != nil {
+		return quoteErrorResponse(fmt.Errorf("bad from address: %w", err))
+	}
+	memo := params[memoParam][0]
+
+	// reject unparsable memos up front, rather than as a handler error
+	if _, err := ParseMemoWithTHORNames(ctx, mgr.Keeper(), memo); err != nil {
+		return quoteErrorResponse(fmt.Errorf("bad memo: %w", err))
+	}
+
+	msg := NewMsgDeposit(common.NewCoins(common.NewCoin(asset, amount)), memo, signer)
+	if err := msg.ValidateBasic(); err != nil {
+		return quoteErrorResponse(fmt.Errorf("failed to validate message: %w", err))
+	}
+
+	resp, err := simulateInbound(ctx, mgr, func(ctx cosmos.Context) error {
+		// the tx id of a deposit is the hash of the tx bytes
+		ctx = ctx.WithTxBytes([]byte(common.RandStringBytesMask(64)))
+		res, err := NewExternalHandler(mgr)(ctx, msg)
+		emitResultEvents(ctx, res)
+		return err
+	})
+	if err != nil {
+		return quoteErrorResponse(err)
+	}
+	resp.Fees.Native = mgr.Keeper().GetNativeTxFee(ctx)
+
+	return jsonify(ctx, resp)
+}
+
+// querySimulateObserved dry-runs an inbound tx to an asgard vault with the
+// given memo, coin and sender, as if observed and finalised by every active node.
+func querySimulateObserved(ctx cosmos.Context, path []string, req abci.RequestQuery, mgr *Mgrs) ([]byte, error) {
+	params, err := quoteParseParams(req.Data)
+	if err != nil {
+		return quoteErrorResponse(err)
+	}
+	for _, p := range []string{memoParam, assetParam, amountParam, fromAddressParam} {
+		if len(params[p]) == 0 {
+			return quoteErrorResponse(fmt.Errorf("missing required parameter %s", p))
+		}
+	}
+
+	asset, err := common.NewAsset(params[assetParam][0])
+	if err != nil {
+		return quoteErrorResponse(fmt.Errorf("bad asset: %w", err))
+	}
+	if asset.Chain.IsTHORChain() || asset.IsSyntheticAsset() {
+		return quoteErrorResponse(fmt.Errorf("asset must be a layer 1 asset, use the deposit simulation for native assets"))
+	}
+	amount, err := cosmos.ParseUint(params[amountParam][0])
+	if err != nil {
+		return quoteErrorResponse(fmt.Errorf("bad amount: %w", err))
+	}
+	from, err := common.NewAddress(params[fromAddressParam][0])
+	if err != nil {
+		return quoteErrorResponse(fmt.Errorf("bad from address: %w", err))
+	}
+	if !from.IsChain(asset.Chain) {
+		return quoteErrorResponse(fmt.Errorf("from address is not a %s address", asset.Chain))
+	}
+	memo := params[memoParam][0]
+	if _, err := ParseMemoWithTHORNames(ctx, mgr.Keeper(), memo); err != nil {
+		return quoteErrorResponse(fmt.Errorf("bad memo: %w", err))
+	}
+
+	// send to the inbound address of the first active asgard
+	vaults, err := mgr.Keeper().GetAsgardVaultsByStatus(ctx, ActiveVault)
+	if err != nil || len(vaults) == 0 {
+		return quoteErrorResponse(fmt.Errorf("no active vault"))
+	}
+	vault := vaults[0]
+	to, err := vault.PubKey.GetAddress(asset.Chain)
+	if err != nil {
+		return quoteErrorResponse(fmt.Errorf("fail to get vault address: %w", err))
+	}
+
+	nodeAccounts, err := mgr.Keeper().ListActiveValidators(ctx)
+	if err != nil || len(nodeAccounts) == 0 {
+		return quoteErrorResponse(fmt.Errorf("no active node accounts"))
+	}
+
+	// pay the gas of a regular inbound
+	gas := common.Gas{common.NewCoin(asset.Chain.GetGasAsset(), cosmos.OneUint())}
+	networkFee, err := mgr.Keeper().GetNetworkFee(ctx, asset.Chain)
+	if err == nil && networkFee.Valid() == nil {
+		gas = common.Gas{common.NewCoin(asset.Chain.GetGasAsset(), cosmos.NewUint(networkFee.TransactionSize*networkFee.TransactionFeeRate))}
+	}
+
+	chainHeight, err := mgr.Keeper().GetLastChainHeight(ctx, asset.Chain)
+	if err != nil {
+		return quoteErrorResponse(fmt.Errorf("fail to get last chain height: %w", err))
+	}
+	chainHeight++
+
+	tx := common.NewTx(
+		common.TxID(common.RandStringBytesMask(64)),
+		from,
+		to,
+		common.NewCoins(common.NewCoin(asset, amount)),
+		gas,
+		memo,
+	)
+	obTx := NewObservedTx(tx, chainHeight, vault.PubKey, chainHeight)
+
+	resp, err := simulateInbound(ctx, mgr, func(ctx cosmos.Context) error {
+		handler := NewExternalHandler(mgr)
+		for _, na := range nodeAccounts {
+			msg := NewMsgObservedTxIn(ObservedTxs{obTx}, na.NodeAddress)
+			if err := msg.ValidateBasic(); err != nil {
+				return fmt.Errorf("failed to validate message: %w", err)
+			}
+			res, err := handler(ctx, msg)
+			emitResultEvents(ctx, res)
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return quoteErrorResponse(err)
+	}
+
+	return jsonify(ctx, resp)
+}
@@ -16,6 +16,7 @@ import (
 	"gitlab.com/thorchain/thornode/cmd"
 	"gitlab.com/thorchain/thornode/common"
 	"gitlab.com/thorchain/thornode/common/cosmos"
+	"gitlab.com/thorchain/thornode/constants"
 	openapi "gitlab.com/thorchain/thornode/openapi/gen"
 	"gitlab.com/thorchain/thornode/x/thorchain/keeper"
 	"gitlab.com/thorchain/thornode/x/thorchain/query"
@@ -288,6 +289,50 @@ func (s *QuerierSuite) TestQueryNodeAccounts(c *C) {
 	c.Assert(len(out), Equals, 1)
 }
 
+func (s *QuerierSuite) TestQueryNodeAccountsPagination(c *C) {
+	ctx, mgr := setupManagerForTest(c)
+	querier := NewQuerier(mgr, s.kb)
+	path := []string{query.QueryNodes.Key}
+
+	vault := GetRandomVault()
+	vault.Status = ActiveVault
+	vault.BlockHeight = 1
+	c.Assert(mgr.Keeper().SetVault(ctx, vault), IsNil)
+	for _, status := range []NodeStatus{NodeActive, NodeActive, NodeStandby} {
+		c.Assert(mgr.Keeper().SetNodeAccount(ctx, GetRandomValidatorNode(status)), IsNil)
+	}
+	// nodes without bond are never listed, nor counted in a page
+	na := GetRandomValidatorNode(NodeStandby)
+	na.Bond = cosmos.ZeroUint()
+	c.Assert(mgr.Keeper().SetNodeAccount(ctx, na), IsNil)
+
+	queryNodes := func(params string) []QueryNodeAccount {
+		res, err := querier(ctx, path, abci.RequestQuery{Data: []byte("/thorchain/nodes?" + params)})
+		c.Assert(err, IsNil)
+		var nodes []QueryNodeAccount
+		c.Assert(json.Unmarshal(res, &nodes), IsNil)
+		return nodes
+	}
+
+	all := queryNodes("")
+	c.Assert(all, HasLen, 3)
+
+	page := queryNodes("limit=2")
+	c.Assert(page, HasLen, 2)
+	c.Check(page[0].NodeAddress.Equals(all[0].NodeAddress), Equals, true)
+	c.Check(page[1].NodeAddress.Equals(all[1].NodeAddress), Equals, true)
+	page = queryNodes("limit=2&cursor=" + page[1].NodeAddress.String())
+	c.Assert(page, HasLen, 1)
+	c.Check(page[0].NodeAddress.Equals(all[2].NodeAddress), Equals, true)
+
+	page = queryNodes("status=standby")
+	c.Assert(page, HasLen, 1)
+	c.Check(page[0].Status, Equals, NodeStandby)
+
+	_, err := querier(ctx, path, abci.RequestQuery{Data: []byte("/thorchain/nodes?status=bogus")})
+	c.Assert(err, NotNil)
+}
+
 func (s *QuerierSuite) TestQuerierRagnarokInProgress(c *C) {
 	req := abci.RequestQuery{
 		Data:   nil,
@@ -353,6 +398,298 @@ func (s *QuerierSuite) TestQueryLiquidityProviders(c *C) {
 	c.Assert(lps, HasLen, 1)
 }
 
+func (s *QuerierSuite) TestQueryLiquidityProvidersPagination(c *C) {
+	ctx, mgr := setupManagerForTest(c)
+	querier := NewQuerier(mgr, s.kb)
+	path := []string{query.QueryLiquidityProviders.Key, "BTC.BTC"}
+
+	for _, units := range []uint64{10, 20, 30} {
+		mgr.Keeper().SetLiquidityProvider(ctx, LiquidityProvider{
+			Asset:        common.BTCAsset,
+			RuneAddress:  GetRandomRUNEAddress(),
+			AssetAddress: GetRandomBTCAddress(),
+			Units:        cosmos.NewUint(units),
+		})
+	}
+
+	queryLPs := func(params string) LiquidityProviders {
+		req := abci.RequestQuery{Data: []byte("/thorchain/pool/BTC.BTC/liquidity_providers?" + params)}
+		res, err := querier(ctx, path, req)
+		c.Assert(err, IsNil)
+		var lps LiquidityProviders
+		c.Assert(json.Unmarshal(res, &lps), IsNil)
+		return lps
+	}
+
+	all := queryLPs("")
+	c.Assert(all, HasLen, 3)
+
+	// pages follow on from the last item of the previous page
+	page := queryLPs("limit=2")
+	c.Assert(page, HasLen, 2)
+	c.Check(page[0].RuneAddress.Equals(all[0].RuneAddress), Equals, true)
+	c.Check(page[1].RuneAddress.Equals(all[1].RuneAddress), Equals, true)
+	page = queryLPs("limit=2&cursor=" + page[1].RuneAddress.String())
+	c.Assert(page, HasLen, 1)
+	c.Check(page[0].RuneAddress.Equals(all[2].RuneAddress), Equals, true)
+
+	// filter out small liquidity providers
+	page = queryLPs("min_units=20")
+	c.Assert(page, HasLen, 2)
+	for _, lp := range page {
+		c.Check(lp.Units.GTE(cosmos.NewUint(20)), Equals, true)
+	}
+
+	_, err := querier(ctx, path, abci.RequestQuery{Data: []byte("/thorchain/pool/BTC.BTC/liquidity_providers?limit=-1")})
+	c.Assert(err, NotNil)
+}
+
+func (s *QuerierSuite) TestQueryPendingOutboundFilter(c *C) {
+	ctx, mgr := setupManagerForTest(c)
+	querier := NewQuerier(mgr, s.kb)
+	path := []string{query.QueryPendingOutbound.Key}
+
+	txOut := NewTxOut(ctx.BlockHeight())
+	txOut.TxArray = append(txOut.TxArray, TxOutItem{
+		Chain:     common.BTCChain,
+		ToAddress: GetRandomBTCAddress(),
+		InHash:    GetRandomTxHash(),
+		Coin:      common.NewCoin(common.BTCAsset, cosmos.NewUint(common.One)),
+	}, TxOutItem{
+		Chain:     common.ETHChain,
+		ToAddress: types.GetRandomETHAddress(),
+		InHash:    GetRandomTxHash(),
+		Coin:      common.NewCoin(common.ETHAsset, cosmos.NewUint(common.One)),
+	})
+	c.Assert(mgr.Keeper().SetTxOut(ctx, txOut), IsNil)
+
+	res, err := querier(ctx, path, abci.RequestQuery{Data: []byte("/thorchain/queue/outbound?chain=ETH")})
+	c.Assert(err, IsNil)
+	var items []QueryTxOutItem
+	c.Assert(json.Unmarshal(res, &items), IsNil)
+	c.Assert(items, HasLen, 1)
+	c.Check(items[0].Chain.Equals(common.ETHChain), Equals, true)
+	c.Check(items[0].Height, Equals, ctx.BlockHeight())
+
+	// nothing after the last height, an empty queue is still null
+	res, err = querier(ctx, path, abci.RequestQuery{Data: []byte("/thorchain/queue/outbound?cursor=" + strconv.FormatInt(ctx.BlockHeight(), 10))})
+	c.Assert(err, IsNil)
+	c.Assert(string(res), Equals, "null")
+}
+
+func (s *QuerierSuite) TestQuerySimulateDeposit(c *C) {
+	ctx, mgr := setupManagerForTest(c)
+	querier := NewQuerier(mgr, s.kb)
+	path := []string{query.QuerySimulateDeposit.Key}
+	addr := GetRandomBech32Addr()
+
+	// missing parameters
+	res, err := querier(ctx, path, abci.RequestQuery{Data: []byte("/thorchain/simulate/deposit?amount=100")})
+	c.Assert(err, IsNil)
+	var errResp map[string]string
+	c.Assert(json.Unmarshal(res, &errResp), IsNil)
+	c.Check(errResp["error"], Equals, "missing required parameter memo")
+
+	// the handler error is reported, and the state is left untouched
+	data := "/thorchain/simulate/deposit?amount=100000000&memo=BOND:" + addr.String() + "&from_address=" + addr.String()
+	res, err = querier(ctx, path, abci.RequestQuery{Data: []byte(data)})
+	c.Assert(err, IsNil)
+	var resp QuerySimulateResponse
+	c.Assert(json.Unmarshal(res, &resp), IsNil)
+	c.Check(resp.Error, Matches, ".*insufficient funds.*")
+	c.Check(resp.Outbounds, HasLen, 0)
+	c.Check(resp.Fees.Native.Equal(mgr.Keeper().GetNativeTxFee(ctx)), Equals, true)
+
+	FundAccount(c, ctx, mgr.Keeper(), addr, 10)
+	res, err = querier(ctx, path, abci.RequestQuery{Data: []byte(data)})
+	c.Assert(err, IsNil)
+	resp = QuerySimulateResponse{}
+	c.Assert(json.Unmarshal(res, &resp), IsNil)
+	c.Check(resp.Error, Not(Matches), ".*insufficient funds.*")
+	c.Check(mgr.Keeper().GetBalance(ctx, addr).AmountOf(common.RuneNative.Native()).Equal(cosmos.NewInt(10*common.One)), Equals, true)
+}
+
+func (s *QuerierSuite) TestQuerySimulateObserved(c *C) {
+	ctx, mgr := setupManagerForTest(c)
+	querier := NewQuerier(mgr, s.kb)
+	path := []string{query.QuerySimulateObserved.Key}
+
+	pool := NewPool()
+	pool.Asset = common.BTCAsset
+	pool.BalanceRune = cosmos.NewUint(1000 * common.One)
+	pool.BalanceAsset = cosmos.NewUint(10 * common.One)
+	pool.LPUnits = cosmos.NewUint(1000 * common.One)
+	pool.Status = PoolAvailable
+	c.Assert(mgr.Keeper().SetPool(ctx, pool), IsNil)
+	vault := GetRandomVault()
+	vault.Chains = append(vault.Chains, common.BTCChain.String())
+	c.Assert(mgr.Keeper().SetVault(ctx, vault), IsNil)
+	c.Assert(mgr.Keeper().SetNodeAccount(ctx, GetRandomValidatorNode(NodeActive)), IsNil)
+
+	// a swap already queued is not part of the simulation, and stays queued
+	queued := NewMsgSwap(GetRandomTx(), common.BTCAsset, GetRandomBTCAddress(), cosmos.ZeroUint(), common.NoAddress, cosmos.ZeroUint(), "", "", nil, MarketOrder, GetRandomBech32Addr())
+	c.Assert(mgr.Keeper().SetSwapQueueItem(ctx, *queued, 0), IsNil)
+
+	// bad parameters
+	res, err := querier(ctx, path, abci.RequestQuery{Data: []byte("/thorchain/simulate/observed?asset=BTC.BTC&amount=100")})
+	c.Assert(err, IsNil)
+	var errResp map[string]string
+	c.Assert(json.Unmarshal(res, &errResp), IsNil)
+	c.Check(errResp["error"], Equals, "missing required parameter memo")
+
+	from := GetRandomBTCAddress().String()
+	res, err = querier(ctx, path, abci.RequestQuery{Data: []byte("/thorchain/simulate/observed?asset=BTC/BTC&amount=100&memo=noop&from_address=" + from)})
+	c.Assert(err, IsNil)
+	c.Assert(json.Unmarshal(res, &errResp), IsNil)
+	c.Check(errResp["error"], Matches, "asset must be a layer 1 asset.*")
+
+	// swap to rune
+	data := "/thorchain/simulate/observed?asset=BTC.BTC&amount=10000000&memo==:THOR.RUNE:" + GetRandomRUNEAddress().String() + "&from_address=" + from
+	res, err = querier(ctx, path, abci.RequestQuery{Data: []byte(data)})
+	c.Assert(err, IsNil)
+	var resp QuerySimulateResponse
+	c.Assert(json.Unmarshal(res, &resp), IsNil)
+	c.Check(resp.Error, Equals, "")
+	swaps := 0
+	for _, evt := range resp.Events {
+		if evt["type"] == types.SwapEventType {
+			swaps++
+			c.Check(evt["id"], Not(Equals), queued.Tx.ID.String())
+		}
+	}
+	c.Check(swaps, Equals, 1)
+	c.Check(resp.Fees.Liquidity.IsZero(), Equals, false)
+
+	// the state is left untouched
+	c.Check(mgr.Keeper().HasSwapQueueItem(ctx, queued.Tx.ID, 0), Equals, true)
+	after, err := mgr.Keeper().GetPool(ctx, common.BTCAsset)
+	c.Assert(err, IsNil)
+	c.Check(after.BalanceAsset.Equal(pool.BalanceAsset), Equals, true)
+}
+
+func (s *QuerierSuite) TestResetSwapQueue(c *C) {
+	ctx, mgr := setupManagerForTest(c)
+
+	swaps := []MsgSwap{
+		*NewMsgSwap(GetRandomTx(), common.BTCAsset, GetRandomBTCAddress(), cosmos.ZeroUint(), common.NoAddress, cosmos.ZeroUint(), "", "", nil, MarketOrder, GetRandomBech32Addr()),
+		*NewMsgSwap(GetRandomTx(), common.BTCAsset, GetRandomBTCAddress(), cosmos.ZeroUint(), common.NoAddress, cosmos.ZeroUint(), "", "", nil, MarketOrder, GetRandomBech32Addr()),
+	}
+	c.Assert(mgr.Keeper().SetSwapQueueItem(ctx, swaps[0], 0), IsNil)
+	c.Assert(mgr.Keeper().SetSwapQueueItem(ctx, swaps[1], 0), IsNil)
+	c.Assert(mgr.Keeper().SetSwapQueueItem(ctx, swaps[1], 1), IsNil)
+
+	resetSwapQueue(ctx, mgr)
+
+	iter := mgr.Keeper().GetSwapQueueIterator(ctx)
+	defer iter.Close()
+	c.Check(iter.Valid(), Equals, false)
+}
+
+func (s *QuerierSuite) TestQueryQuoteLiquidityAdd(c *C) {
+	ctx, mgr := setupManagerForTest(c)
+	querier := NewQuerier(mgr, s.kb)
+	path := []string{query.QueryQuoteLPAdd.Key}
+
+	pool := NewPool()
+	pool.Asset = common.BTCAsset
+	pool.BalanceRune = cosmos.NewUint(1000 * common.One)
+	pool.BalanceAsset = cosmos.NewUint(10 * common.One)
+	pool.LPUnits = cosmos.NewUint(1000 * common.One)
+	pool.Status = PoolAvailable
+	c.Assert(mgr.Keeper().SetPool(ctx, pool), IsNil)
+
+	quote := func(params string) map[string]any {
+		res, err := querier(ctx, path, abci.RequestQuery{Data: []byte("/thorchain/quote/liquidity/add?" + params)})
+		c.Assert(err, IsNil)
+		var resp map[string]any
+		c.Assert(json.Unmarshal(res, &resp), IsNil)
+		return resp
+	}
+
+	resp := quote("asset=BTC/BTC&rune_amount=100")
+	c.Check(resp["error"], Equals, "use the saver deposit quote for savers")
+	resp = quote("asset=BTC.BTC&rune_amount=100&asset_amount=100")
+	c.Check(resp["error"], Equals, "rune address and asset address are required for a symmetric add")
+
+	// single sided rune add
+	resp = quote("asset=BTC.BTC&rune_amount=10000000000")
+	c.Assert(resp["error"], IsNil)
+	_, units, err := NewAddLiquidityHandler(mgr).calculatePoolUnits(pool.GetPoolUnits(), pool.BalanceRune, pool.BalanceAsset, cosmos.NewUint(100*common.One), cosmos.ZeroUint())
+	c.Assert(err, IsNil)
+	c.Check(resp["expected_units"], Equals, units.String())
+	c.Check(resp["memo"], Equals, "+:BTC.BTC")
+	c.Check(resp["slippage_bps"].(float64) > 0, Equals, true)
+}
+
+func (s *QuerierSuite) TestQueryQuoteLiquidityWithdraw(c *C) {
+	ctx, mgr := setupManagerForTest(c)
+	querier := NewQuerier(mgr, s.kb)
+	path := []string{query.QueryQuoteLPWithdraw.Key}
+
+	pool := NewPool()
+	pool.Asset = common.BTCAsset
+	pool.BalanceRune = cosmos.NewUint(1000 * common.One)
+	pool.BalanceAsset = cosmos.NewUint(10 * common.One)
+	pool.LPUnits = cosmos.NewUint(1000 * common.One)
+	pool.Status = PoolAvailable
+	pool.Decimals = 8
+	c.Assert(mgr.Keeper().SetPool(ctx, pool), IsNil)
+	lp := LiquidityProvider{
+		Asset:        common.BTCAsset,
+		RuneAddress:  GetRandomRUNEAddress(),
+		AssetAddress: GetRandomBTCAddress(),
+		Units:        cosmos.NewUint(100 * common.One),
+	}
+	mgr.Keeper().SetLiquidityProvider(ctx, lp)
+
+	quote := func(params string) map[string]any {
+		res, err := querier(ctx, path, abci.RequestQuery{Data: []byte("/thorchain/quote/liquidity/withdraw?" + params)})
+		c.Assert(err, IsNil)
+		var resp map[string]any
+		c.Assert(json.Unmarshal(res, &resp), IsNil)
+		return resp
+	}
+
+	resp := quote("asset=BTC.BTC&address=" + lp.RuneAddress.String() + "&withdraw_bps=0")
+	c.Check(resp["error"], Equals, "basis points must be between 1 and 10000")
+	resp = quote("asset=BTC.BTC&address=" + lp.RuneAddress.String() + "&withdraw_bps=5000&withdraw_asset=ETH.ETH")
+	c.Check(resp["error"], Equals, "withdraw asset must be THOR.RUNE or BTC.BTC")
+	resp = quote("asset=BTC.BTC&address=" + GetRandomRUNEAddress().String() + "&withdraw_bps=5000")
+	c.Check(resp["error"], Equals, "liquidity provider has no units")
+
+	// symmetric withdraw of half the position
+	resp = quote("asset=BTC.BTC&address=" + lp.RuneAddress.String() + "&withdraw_bps=5000")
+	c.Assert(resp["error"], IsNil)
+	c.Check(resp["expected_units"], Equals, cosmos.NewUint(50*common.One).String())
+	runeFee := mgr.GasMgr().GetFee(ctx, common.THORChain, common.RuneAsset())
+	c.Check(resp["expected_rune_out"], Equals, common.SafeSub(cosmos.NewUint(50*common.One), runeFee).String())
+	c.Check(resp["memo"], Equals, "-:BTC.BTC:5000")
+	c.Check(resp["slippage_bps"], Equals, float64(0))
+
+	// asymmetric withdraw to rune slips against a symmetric one
+	resp = quote("asset=BTC.BTC&address=" + lp.RuneAddress.String() + "&withdraw_bps=5000&withdraw_asset=THOR.RUNE")
+	c.Assert(resp["error"], IsNil)
+	c.Check(resp["expected_asset_out"], Equals, "0")
+	c.Check(resp["memo"], Equals, "-:BTC.BTC:5000:THOR.RUNE")
+	c.Check(resp["slippage_bps"].(float64) > 0, Equals, true)
+
+	// the quote runs the actual withdraw, which refuses to empty one side of
+	// the pool
+	lp.Units = pool.LPUnits
+	mgr.Keeper().SetLiquidityProvider(ctx, lp)
+	resp = quote("asset=BTC.BTC&address=" + lp.RuneAddress.String() + "&withdraw_bps=10000&withdraw_asset=THOR.RUNE")
+	c.Check(resp["error"], Matches, "failed to simulate withdraw.*")
+
+	// the state is left untouched
+	after, err := mgr.Keeper().GetPool(ctx, common.BTCAsset)
+	c.Assert(err, IsNil)
+	c.Check(after.BalanceRune.Equal(pool.BalanceRune), Equals, true)
+	c.Check(after.LPUnits.Equal(pool.LPUnits), Equals, true)
+	lp, err = mgr.Keeper().GetLiquidityProvider(ctx, common.BTCAsset, lp.RuneAddress)
+	c.Assert(err, IsNil)
+	c.Check(lp.Units.Equal(pool.LPUnits), Equals, true)
+}
+
 func (s *QuerierSuite) TestQueryTxInVoter(c *C) {
 	req := abci.RequestQuery{
 		Data:   nil,
@@ -694,6 +1031,68 @@ func (s *QuerierSuite) TestQueryNetwork(c *C) {
 	c.Assert(json.Unmarshal(result, &r), IsNil)
 }
 
+func (s *QuerierSuite) TestQueryPOLPools(c *C) {
+	ctx, mgr := setupManagerForTest(c)
+	querier := NewQuerier(mgr, s.kb)
+	mgr.Keeper().SetMimir(ctx, constants.POLTargetSynthPerPoolDepth.String(), 1000)
+
+	polAddress, err := mgr.Keeper().GetModuleAddress(ReserveName)
+	c.Assert(err, IsNil)
+
+	for _, asset := range []common.Asset{common.BTCAsset, common.BNBAsset, common.ETHAsset} {
+		pool := NewPool()
+		pool.Asset = asset
+		pool.Status = PoolAvailable
+		pool.BalanceRune = cosmos.NewUint(1000 * common.One)
+		pool.BalanceAsset = cosmos.NewUint(10 * common.One)
+		pool.LPUnits = cosmos.NewUint(1000)
+		if asset.Equals(common.BNBAsset) {
+			pool.Status = PoolStaged
+		}
+		c.Assert(mgr.Keeper().SetPool(ctx, pool), IsNil)
+
+		// POL isn't in the eth pool
+		if asset.Equals(common.ETHAsset) {
+			continue
+		}
+		mgr.Keeper().SetLiquidityProvider(ctx, LiquidityProvider{
+			Asset:             asset,
+			RuneAddress:       polAddress,
+			Units:             cosmos.NewUint(250),
+			PendingRune:       cosmos.ZeroUint(),
+			PendingAsset:      cosmos.ZeroUint(),
+			RuneDepositValue:  cosmos.ZeroUint(),
+			AssetDepositValue: cosmos.ZeroUint(),
+		})
+	}
+	pol := NewProtocolOwnedLiquidity()
+	pol.RuneDeposited = cosmos.NewUint(100 * common.One)
+	c.Assert(mgr.Keeper().SetPOLPool(ctx, common.BTCAsset, pol), IsNil)
+
+	res, err := querier(ctx, []string{query.QueryPOLPools.Key}, abci.RequestQuery{})
+	c.Assert(err, IsNil)
+	var out []openapi.POLPool
+	c.Assert(json.Unmarshal(res, &out), IsNil)
+	c.Assert(out, HasLen, 2)
+	for _, p := range out {
+		c.Check(p.Units, Equals, "250")
+		c.Check(p.PoolShareBps, Equals, int64(2500))
+		c.Check(p.Value, Equals, "50000000000")
+		switch p.Asset {
+		case common.BTCAsset.String():
+			c.Check(p.RuneDeposited, Equals, "10000000000")
+			c.Check(p.Pnl, Equals, "40000000000")
+			c.Check(p.TargetSynthPerPoolDepthBps, Equals, int64(1000))
+		case common.BNBAsset.String():
+			// POL is withdrawn from pools that aren't available
+			c.Check(p.RuneDeposited, Equals, "0")
+			c.Check(p.TargetSynthPerPoolDepthBps, Equals, int64(10_000))
+		default:
+			c.Errorf("unexpected pool %s", p.Asset)
+		}
+	}
+}
+
 func (s *QuerierSuite) TestQueryAsgardVault(c *C) {
 	c.Assert(s.k.SetVault(s.ctx, GetRandomVault()), IsNil)
 	result, err := s.querier(s.ctx, []string{
@@ -827,3 +1226,118 @@ func (s *QuerierSuite) TestPeerIDFromPubKey(c *C) {
 	expectedErrorString := "fail to parse account pub key(nonsense): decoding bech32 failed: invalid separator index -1"
 	c.Assert(getPeerIDFromPubKey("nonsense"), Equals, expectedErrorString)
 }
+
+func (s *QuerierSuite) TestQueryOrderBook(c *C) {
+	newLimitOrder := func(amount, target uint64) MsgSwap {
+		tx := GetRandomTx()
+		tx.Coins = common.NewCoins(common.NewCoin(common.BTCAsset, cosmos.NewUint(amount)))
+		return *NewMsgSwap(
+			tx, common.BNBAsset, GetRandomBNBAddress(), cosmos.NewUint(target),
+			common.NoAddress, cosmos.ZeroUint(),
+			"", "", nil,
+			LimitOrder,
+			GetRandomBech32Addr())
+	}
+	order1 := newLimitOrder(100*common.One, 200*common.One)
+	order1.ExpiryHeight = 500
+	order2 := newLimitOrder(50*common.One, 100*common.One)
+	order3 := newLimitOrder(100*common.One, 400*common.One)
+	for _, msg := range []MsgSwap{order1, order2, order3} {
+		c.Assert(s.k.SetOrderBookItem(s.ctx, msg), IsNil)
+	}
+
+	result, err := s.querier(s.ctx, []string{query.QueryOrderBooks.Key}, abci.RequestQuery{})
+	c.Assert(err, IsNil)
+	var books []openapi.OrderBookPair
+	c.Assert(json.Unmarshal(result, &books), IsNil)
+	c.Assert(books, HasLen, 1)
+	c.Check(books[0].SourceAsset, Equals, common.BTCAsset.String())
+	c.Check(books[0].TargetAsset, Equals, common.BNBAsset.String())
+
+	result, err = s.querier(s.ctx, []string{query.QueryOrderBook.Key}, abci.RequestQuery{
+		Data: []byte("/thorchain/orderbook/pair?source=BTC.BTC&target=BNB.BNB"),
+	})
+	c.Assert(err, IsNil)
+	var book openapi.OrderBookPair
+	c.Assert(json.Unmarshal(result, &book), IsNil)
+	c.Check(book.OrderCount, Equals, int64(3))
+	c.Check(book.SourceDepth, Equals, cosmos.NewUint(250*common.One).String())
+	c.Check(book.TargetDepth, Equals, cosmos.NewUint(700*common.One).String())
+	c.Assert(book.Levels, HasLen, 2)
+	// highest ratio first
+	c.Check(book.Levels[0].Ratio, Equals, "50000000")
+	c.Check(book.Levels[0].Orders, HasLen, 2)
+	c.Check(book.Levels[0].SourceAmount, Equals, cosmos.NewUint(150*common.One).String())
+	c.Check(book.Levels[1].Ratio, Equals, "25000000")
+	c.Check(book.Levels[1].Orders, HasLen, 1)
+
+	// synth pair
+	synthOrder := newLimitOrder(10*common.One, 20*common.One)
+	synthOrder.Tx.Coins = common.NewCoins(common.NewCoin(common.BTCAsset.GetSyntheticAsset(), cosmos.NewUint(10*common.One)))
+	synthOrder.TargetAsset = common.ETHAsset.GetSyntheticAsset()
+	c.Assert(s.k.SetOrderBookItem(s.ctx, synthOrder), IsNil)
+	result, err = s.querier(s.ctx, []string{query.QueryOrderBook.Key}, abci.RequestQuery{
+		Data: []byte("/thorchain/orderbook/pair?source=BTC/BTC&target=ETH/ETH"),
+	})
+	c.Assert(err, IsNil)
+	c.Assert(json.Unmarshal(result, &book), IsNil)
+	c.Check(book.SourceAsset, Equals, "BTC/BTC")
+	c.Check(book.TargetAsset, Equals, "ETH/ETH")
+	c.Check(book.OrderCount, Equals, int64(1))
+	c.Assert(book.Levels, HasLen, 1)
+	c.Check(book.Levels[0].Orders[0].TxId, Equals, synthOrder.Tx.ID.String())
+
+	// missing target
+	_, err = s.querier(s.ctx, []string{query.QueryOrderBook.Key}, abci.RequestQuery{
+		Data: []byte("/thorchain/orderbook/pair?source=BTC.BTC"),
+	})
+	c.Assert(err, NotNil)
+
+	result, err = s.querier(s.ctx, []string{
+		query.QueryOrderBookOrder.Key,
+		order1.Tx.ID.String(),
+	}, abci.RequestQuery{})
+	c.Assert(err, IsNil)
+	var order openapi.OrderBookOrder
+	c.Assert(json.Unmarshal(result, &order), IsNil)
+	c.Check(order.TxId, Equals, order1.Tx.ID.String())
+	c.Check(order.Ratio, Equals, "50000000")
+	c.Assert(order.ExpiryHeight, NotNil)
+	c.Check(*order.ExpiryHeight, Equals, int64(500))
+
+	_, err = s.querier(s.ctx, []string{
+		query.QueryOrderBookOrder.Key,
+		GetRandomTxHash().String(),
+	}, abci.RequestQuery{})
+	c.Assert(err, NotNil)
+}
+
+func (s *QuerierSuite) TestQueryTHORNameLookup(c *C) {
+	ethAddr := types.GetRandomETHAddress()
+	owner := GetRandomBech32Addr()
+	name := NewTHORName("alice", s.ctx.BlockHeight()+100, []THORNameAlias{
+		{Chain: common.ETHChain, Address: ethAddr},
+		{Chain: common.BSCChain, Address: ethAddr},
+		{Chain: common.THORChain, Address: GetRandomTHORAddress()},
+	})
+	name.Owner = owner
+	s.k.SetTHORName(s.ctx, name)
+	expired := NewTHORName("bob", s.ctx.BlockHeight()-1, []THORNameAlias{{Chain: common.ETHChain, Address: ethAddr}})
+	s.k.SetTHORName(s.ctx, expired)
+
+	result, err := s.querier(s.ctx, []string{query.QueryTHORNameLookup.Key, ethAddr.String()}, abci.RequestQuery{})
+	c.Assert(err, IsNil)
+	var resp []openapi.ThornameLookup
+	c.Assert(json.Unmarshal(result, &resp), IsNil)
+	c.Assert(resp, HasLen, 2)
+	c.Check(resp[0].Name, Equals, "alice")
+	c.Check(resp[0].Chain, Equals, common.ETHChain.String())
+	c.Check(resp[1].Chain, Equals, common.BSCChain.String())
+	c.Check(*resp[0].Owner, Equals, owner.String())
+	c.Check(resp[0].ExpireBlockHeight, Equals, s.ctx.BlockHeight()+100)
+
+	result, err = s.querier(s.ctx, []string{query.QueryTHORNameLookup.Key, GetRandomBNBAddress().String()}, abci.RequestQuery{})
+	c.Assert(err, IsNil)
+	c.Assert(json.Unmarshal(result, &resp), IsNil)
+	c.Check(resp, HasLen, 0)
+}
@@ -59,6 +59,7 @@ var (
 	QueryInboundAddresses    = Query{Key: "inboundaddresses", EndpointTemplate: "/%s/inbound_addresses"}
 	QueryNetwork             = Query{Key: "network", EndpointTemplate: "/%s/network"}
 	QueryPOL                 = Query{Key: "pol", EndpointTemplate: "/%s/pol"}
+	QueryPOLPools            = Query{Key: "polpools", EndpointTemplate: "/%s/pol/pools"}
 	QueryBalanceModule       = Query{Key: "balancemodule", EndpointTemplate: "/%s/balance/module/{%s}"}
 	QueryVaultsAsgard        = Query{Key: "vaultsasgard", EndpointTemplate: "/%s/vaults/asgard"}
 	QueryVaultsYggdrasil     = Query{Key: "vaultsyggdrasil", EndpointTemplate: "/%s/vaults/yggdrasil"}
@@ -77,14 +78,22 @@ var (
 	QueryPendingOutbound     = Query{Key: "pendingoutbound", EndpointTemplate: "/%s/queue/outbound"}
 	QueryScheduledOutbound   = Query{Key: "scheduledoutbound", EndpointTemplate: "/%s/queue/scheduled"}
 	QuerySwapQueue           = Query{Key: "swapqueue", EndpointTemplate: "/%s/queue/swap"}
+	QueryOrderBooks          = Query{Key: "orderbooks", EndpointTemplate: "/%s/orderbook"}
+	QueryOrderBook           = Query{Key: "orderbook", EndpointTemplate: "/%s/orderbook/pair"}
+	QueryOrderBookOrder      = Query{Key: "orderbookorder", EndpointTemplate: "/%s/orderbook/order/{%s}"}
 	QueryTssKeygenMetrics    = Query{Key: "tss_keygen_metric", EndpointTemplate: "/%s/metric/keygen/{%s}"}
 	QueryTssMetrics          = Query{Key: "tss_metric", EndpointTemplate: "/%s/metrics"}
 	QueryTHORName            = Query{Key: "thorname", EndpointTemplate: "/%s/thorname/{%s}"}
+	QueryTHORNameLookup      = Query{Key: "thornamelookup", EndpointTemplate: "/%s/thorname/lookup/{%s}"}
 	QueryQuoteSwap           = Query{Key: "quoteswap", EndpointTemplate: "/%s/quote/swap"}
 	QueryQuoteSaverDeposit   = Query{Key: "quotesaverdeposit", EndpointTemplate: "/%s/quote/saver/deposit"}
 	QueryQuoteSaverWithdraw  = Query{Key: "quotesaverwithdraw", EndpointTemplate: "/%s/quote/saver/withdraw"}
+	QueryQuoteLPAdd          = Query{Key: "quoteliquidityadd", EndpointTemplate: "/%s/quote/liquidity/add"}
+	QueryQuoteLPWithdraw     = Query{Key: "quoteliquiditywithdraw", EndpointTemplate: "/%s/quote/liquidity/withdraw"}
 	QueryQuoteLoanOpen       = Query{Key: "quoteloanopen", EndpointTemplate: "/%s/quote/loan/open"}
 	QueryQuoteLoanClose      = Query{Key: "quoteloanclose", EndpointTemplate: "/%s/quote/loan/close"}
+	QuerySimulateDeposit     = Query{Key: "simulatedeposit", EndpointTemplate: "/%s/simulate/deposit"}
+	QuerySimulateObserved    = Query{Key: "simulateobserved", EndpointTemplate: "/%s/simulate/observed"}
 	QueryInvariants          = Query{Key: "invariants", EndpointTemplate: "/%s/invariants"}
 	QueryInvariant           = Query{Key: "invariant", EndpointTemplate: "/%s/invariant/{%s}"}
 
@@ -120,6 +129,7 @@ var Queries = []Query{
 	QueryInboundAddresses,
 	QueryNetwork,
 	QueryPOL,
+	QueryPOLPools,
 	QueryBalanceModule,
 	QueryVaultsAsgard,
 	QueryVaultsYggdrasil,
@@ -139,14 +149,22 @@ var Queries = []Query{
 	QueryPendingOutbound,
 	QueryScheduledOutbound,
 	QuerySwapQueue,
+	QueryOrderBooks,
+	QueryOrderBook,
+	QueryOrderBookOrder,
 	QueryTssMetrics,
 	QueryTssKeygenMetrics,
 	QueryTHORName,
+	QueryTHORNameLookup,
 	QueryQuoteSwap,
 	QueryQuoteSaverDeposit,
 	QueryQuoteSaverWithdraw,
+	QueryQuoteLPAdd,
+	QueryQuoteLPWithdraw,
 	QueryQuoteLoanOpen,
 	QueryQuoteLoanClose,
+	QuerySimulateDeposit,
+	QuerySimulateObserved,
 	QueryInvariants,
 	QueryInvariant,
 	QueryExport,
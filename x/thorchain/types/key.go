@@ -13,6 +13,9 @@ const (
 	BondName = "bond"
 	// LendingName
 	LendingName = "lending"
+	// AffiliateCollectorName the module account name to keep the affiliate
+	// fees accrued by THORNames
+	AffiliateCollectorName = "affiliate_collector"
 
 	// StoreKey to be used when creating the KVStore
 	StoreKey = ModuleName
@@ -24,6 +24,12 @@ func NewMsgAddLiquidity(tx common.Tx, asset common.Asset, r, amount cosmos.Uint,
 	}
 }
 
+// GetAffiliates returns the affiliates of the liquidity provider, and the fee
+// basis points each of them is owed
+func (m *MsgAddLiquidity) GetAffiliates() Affiliates {
+	return newAffiliates(m.Affiliates, m.AffiliateAddress, m.AffiliateBasisPoints)
+}
+
 // Route should return the route key of the module
 func (m *MsgAddLiquidity) Route() string { return RouterKey }
 
@@ -61,6 +67,14 @@ func (m *MsgAddLiquidity) ValidateBasicV98() error {
 	if !m.AffiliateBasisPoints.IsZero() && m.AffiliateBasisPoints.GT(cosmos.NewUint(MaxAffiliateFeeBasisPoints)) {
 		return cosmos.ErrUnknownRequest(fmt.Sprintf("affiliate fee basis points can't be more than %d", MaxAffiliateFeeBasisPoints))
 	}
+	for _, aff := range m.Affiliates {
+		if err := aff.Valid(); err != nil {
+			return cosmos.ErrUnknownRequest(err.Error())
+		}
+	}
+	if len(m.Affiliates) > 0 && !Affiliates(m.Affiliates).TotalBasisPoints().Equal(m.AffiliateBasisPoints) {
+		return cosmos.ErrUnknownRequest("affiliate basis points don't match the affiliates")
+	}
 	return nil
 }
 
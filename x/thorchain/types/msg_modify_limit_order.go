@@ -0,0 +1,55 @@
+package types
+
+import (
+	"gitlab.com/thorchain/thornode/common"
+	"gitlab.com/thorchain/thornode/common/cosmos"
+)
+
+// NewMsgModifyLimitOrder is a constructor function for MsgModifyLimitOrder
+func NewMsgModifyLimitOrder(tx common.Tx, orderTxID common.TxID, target cosmos.Uint, signer cosmos.AccAddress) *MsgModifyLimitOrder {
+	return &MsgModifyLimitOrder{
+		Tx:                   tx,
+		OrderTxID:            orderTxID,
+		ModifiedTargetAmount: target,
+		Signer:               signer,
+	}
+}
+
+// Route should return the route key of the module
+func (m *MsgModifyLimitOrder) Route() string { return RouterKey }
+
+// Type should return the action
+func (m MsgModifyLimitOrder) Type() string { return "modify_limit_order" }
+
+// IsCancel returns true when the message cancels the limit order instead of
+// changing its target amount
+func (m *MsgModifyLimitOrder) IsCancel() bool {
+	return m.ModifiedTargetAmount.IsZero()
+}
+
+// ValidateBasic runs stateless checks on the message
+func (m *MsgModifyLimitOrder) ValidateBasic() error {
+	if m.Signer.Empty() {
+		return cosmos.ErrInvalidAddress(m.Signer.String())
+	}
+	if m.OrderTxID.IsEmpty() {
+		return cosmos.ErrUnknownRequest("order tx id cannot be empty")
+	}
+	if m.OrderTxID.Equals(m.Tx.ID) {
+		return cosmos.ErrUnknownRequest("order tx id cannot be the same as the modify tx id")
+	}
+	if err := m.Tx.Valid(); err != nil {
+		return cosmos.ErrUnknownRequest(err.Error())
+	}
+	return nil
+}
+
+// GetSignBytes encodes the message for signing
+func (m *MsgModifyLimitOrder) GetSignBytes() []byte {
+	return cosmos.MustSortJSON(ModuleCdc.MustMarshalJSON(m))
+}
+
+// GetSigners defines whose signature is required
+func (m *MsgModifyLimitOrder) GetSigners() []cosmos.AccAddress {
+	return []cosmos.AccAddress{m.Signer}
+}
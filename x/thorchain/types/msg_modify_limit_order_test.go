@@ -0,0 +1,60 @@
+package types
+
+import (
+	. "gopkg.in/check.v1"
+
+	"gitlab.com/thorchain/thornode/common"
+	"gitlab.com/thorchain/thornode/common/cosmos"
+)
+
+type MsgModifyLimitOrderSuite struct{}
+
+var _ = Suite(&MsgModifyLimitOrderSuite{})
+
+func (MsgModifyLimitOrderSuite) TestMsgModifyLimitOrder(c *C) {
+	tx := GetRandomTx()
+	orderTxID := GetRandomTxHash()
+	signer := GetRandomBech32Addr()
+
+	m := NewMsgModifyLimitOrder(tx, orderTxID, cosmos.NewUint(100), signer)
+	EnsureMsgBasicCorrect(m, c)
+	c.Check(m.Route(), Equals, RouterKey)
+	c.Check(m.Type(), Equals, "modify_limit_order")
+	c.Check(m.IsCancel(), Equals, false)
+	c.Check(len(m.GetSignBytes()) > 0, Equals, true)
+
+	m = NewMsgModifyLimitOrder(tx, orderTxID, cosmos.ZeroUint(), signer)
+	EnsureMsgBasicCorrect(m, c)
+	c.Check(m.IsCancel(), Equals, true)
+
+	inputs := []struct {
+		tx        common.Tx
+		orderTxID common.TxID
+		signer    cosmos.AccAddress
+	}{
+		{
+			tx:        tx,
+			orderTxID: common.TxID(""),
+			signer:    signer,
+		},
+		{
+			tx:        tx,
+			orderTxID: tx.ID,
+			signer:    signer,
+		},
+		{
+			tx:        common.Tx{},
+			orderTxID: orderTxID,
+			signer:    signer,
+		},
+		{
+			tx:        tx,
+			orderTxID: orderTxID,
+			signer:    cosmos.AccAddress{},
+		},
+	}
+	for _, item := range inputs {
+		m := NewMsgModifyLimitOrder(item.tx, item.orderTxID, cosmos.NewUint(100), item.signer)
+		c.Check(m.ValidateBasic(), NotNil)
+	}
+}
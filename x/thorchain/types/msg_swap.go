@@ -29,6 +29,18 @@ func NewMsgSwap(tx common.Tx, target common.Asset, destination common.Address, t
 	}
 }
 
+// IsStreaming returns true when the swap should be broken up into sub-swaps
+// executed across multiple blocks
+func (m *MsgSwap) IsStreaming() bool {
+	return m.StreamInterval > 0
+}
+
+// GetAffiliates returns the affiliates of the swap, and the fee basis points
+// each of them is owed
+func (m *MsgSwap) GetAffiliates() Affiliates {
+	return newAffiliates(m.Affiliates, m.AffiliateAddress, m.AffiliateBasisPoints)
+}
+
 // Route should return the route key of the module
 func (m *MsgSwap) Route() string { return RouterKey }
 
@@ -115,6 +127,14 @@ func (m *MsgSwap) ValidateBasicV63() error {
 	if !m.AffiliateAddress.IsEmpty() && !m.AffiliateAddress.IsChain(common.THORChain) {
 		return cosmos.ErrUnknownRequest("swap affiliate address must be a THOR address")
 	}
+	for _, aff := range m.Affiliates {
+		if err := aff.Valid(); err != nil {
+			return cosmos.ErrUnknownRequest(err.Error())
+		}
+	}
+	if len(m.Affiliates) > 0 && !Affiliates(m.Affiliates).TotalBasisPoints().Equal(m.AffiliateBasisPoints) {
+		return cosmos.ErrUnknownRequest("affiliate basis points don't match the affiliates")
+	}
 	if len(m.Aggregator) != 0 && len(m.AggregatorTargetAddress) == 0 {
 		return cosmos.ErrUnknownRequest("aggregator target asset address is empty")
 	}
@@ -277,6 +277,7 @@ type QueryPool struct {
 	SynthSupply          string `json:"synth_supply"`
 	SaversDepth          string `json:"savers_depth"`
 	SaversUnits          string `json:"savers_units"`
+	SaversAPRBps         int64  `json:"savers_apr_bps"`
 	SynthMintPaused      bool   `json:"synth_mint_paused"`
 	SynthSupplyRemaining string `json:"synth_supply_remaining"`
 	LoanCollateral       string `json:"loan_collateral"`
@@ -327,6 +328,24 @@ func NewQueryTxOutItem(toi TxOutItem, height int64) QueryTxOutItem {
 	}
 }
 
+// QuerySimulateResponse is the result of a dry-run of an inbound tx
+type QuerySimulateResponse struct {
+	Events    []map[string]string `json:"events"`
+	Outbounds []QueryTxOutItem    `json:"outbounds"`
+	Fees      QuerySimulateFees   `json:"fees"`
+	Error     string              `json:"error,omitempty"`
+}
+
+// QuerySimulateFees are the fees paid by a simulated inbound tx
+type QuerySimulateFees struct {
+	// Native is the native tx fee in RUNE, paid by deposits
+	Native cosmos.Uint `json:"native"`
+	// Liquidity is the total liquidity fee of the swaps in RUNE
+	Liquidity cosmos.Uint `json:"liquidity"`
+	// Outbound are the fees deducted from the outbounds
+	Outbound common.Coins `json:"outbound"`
+}
+
 type InboundObservedStage struct {
 	Started   *bool `json:"started,omitempty"`
 	Completed bool  `json:"completed"`
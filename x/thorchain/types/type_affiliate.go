@@ -0,0 +1,62 @@
+package types
+
+import (
+	"errors"
+
+	"gitlab.com/thorchain/thornode/common"
+	"gitlab.com/thorchain/thornode/common/cosmos"
+)
+
+// Affiliates a list of affiliates
+type Affiliates []Affiliate
+
+// NewAffiliate create a new instance of Affiliate
+func NewAffiliate(addr common.Address, bps cosmos.Uint) Affiliate {
+	return Affiliate{
+		Address:     addr,
+		BasisPoints: bps,
+	}
+}
+
+// Valid check whether the affiliate represent valid information
+func (m Affiliate) Valid() error {
+	if m.Address.IsEmpty() {
+		return errors.New("affiliate address cannot be empty")
+	}
+	if !m.Address.IsChain(common.THORChain) {
+		return errors.New("affiliate address must be a THOR address")
+	}
+	return nil
+}
+
+// TotalBasisPoints returns the sum of the basis points of all the affiliates
+func (as Affiliates) TotalBasisPoints() cosmos.Uint {
+	total := cosmos.ZeroUint()
+	for _, a := range as {
+		total = total.Add(a.BasisPoints)
+	}
+	return total
+}
+
+// HasTHORName returns true when any of the affiliates was given as a THORName
+func (as Affiliates) HasTHORName() bool {
+	for _, a := range as {
+		if a.THORName != "" {
+			return true
+		}
+	}
+	return false
+}
+
+// newAffiliates returns the given affiliates, or when there are none, the
+// single affiliate address and basis points messages carried before multiple
+// affiliates were supported
+func newAffiliates(affiliates []Affiliate, affAddr common.Address, affPts cosmos.Uint) Affiliates {
+	if len(affiliates) > 0 {
+		return affiliates
+	}
+	if affAddr.IsEmpty() || affPts.IsZero() {
+		return nil
+	}
+	return Affiliates{NewAffiliate(affAddr, affPts)}
+}
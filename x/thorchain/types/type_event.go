@@ -33,18 +33,27 @@ const (
 	SlashEventType             = "slash"
 	SlashPointEventType        = "slash_points"
 	SwapEventType              = "swap"
+	StreamingSwapEventType     = "streaming_swap"
 	LimitOrderEventType        = "limit_order"
+	LimitOrderModifyEventType  = "limit_order_modify"
+	LimitOrderCancelEventType  = "limit_order_cancel"
 	SwitchEventType            = "switch"
 	MintBurnType               = "mint_burn"
 	THORNameEventType          = "thorname"
 	LoanOpenEventType          = "loan_open"
 	LoanRepaymentEventType     = "loan_repayment"
+	LoanLiquidationEventType   = "loan_liquidation"
+	LoanInterestEventType      = "loan_interest"
+	AffiliateFeeEventType      = "affiliate_fee"
 	TSSKeygenMetricEventType   = "tss_keygen"
 	TSSKeysignMetricEventType  = "tss_keysign"
 	VersionEventType           = "version"
 	WithdrawEventType          = "withdraw"
 )
 
+// LimitOrderStatusExpired status of a limit order that has expired
+const LimitOrderStatusExpired = "expired"
+
 // PoolMods a list of pool modifications
 type PoolMods []PoolMod
 
@@ -68,6 +77,14 @@ func NewEventLimitOrder(source, target common.Coin, txid common.TxID) *EventLimi
 	}
 }
 
+// NewEventLimitOrderExpired create a new limit order event for an order that
+// has been removed from the order book (and refunded) as it expired
+func NewEventLimitOrderExpired(source, target common.Coin, txid common.TxID) *EventLimitOrder {
+	evt := NewEventLimitOrder(source, target, txid)
+	evt.Status = LimitOrderStatusExpired
+	return evt
+}
+
 // Type return a string that represent the type, it should not duplicated with other event
 func (m *EventLimitOrder) Type() string {
 	return LimitOrderEventType
@@ -75,6 +92,59 @@ func (m *EventLimitOrder) Type() string {
 
 // Events convert EventLimitOrder to key value pairs used in cosmos
 func (m *EventLimitOrder) Events() (cosmos.Events, error) {
+	evt := cosmos.NewEvent(m.Type(),
+		cosmos.NewAttribute("source", m.Source.String()),
+		cosmos.NewAttribute("target", m.Target.String()),
+		cosmos.NewAttribute("txid", m.TxID.String()),
+	)
+	if len(m.Status) > 0 {
+		evt = evt.AppendAttributes(cosmos.NewAttribute("status", m.Status))
+	}
+	return cosmos.Events{evt}, nil
+}
+
+// NewEventLimitOrderModify create a new limit order modify event
+func NewEventLimitOrderModify(source, target, modifiedTarget common.Coin, txid common.TxID) *EventLimitOrderModify {
+	return &EventLimitOrderModify{
+		Source:         source,
+		Target:         target,
+		ModifiedTarget: modifiedTarget,
+		TxID:           txid,
+	}
+}
+
+// Type return a string that represent the type, it should not duplicated with other event
+func (m *EventLimitOrderModify) Type() string {
+	return LimitOrderModifyEventType
+}
+
+// Events convert EventLimitOrderModify to key value pairs used in cosmos
+func (m *EventLimitOrderModify) Events() (cosmos.Events, error) {
+	evt := cosmos.NewEvent(m.Type(),
+		cosmos.NewAttribute("source", m.Source.String()),
+		cosmos.NewAttribute("target", m.Target.String()),
+		cosmos.NewAttribute("modified_target", m.ModifiedTarget.String()),
+		cosmos.NewAttribute("txid", m.TxID.String()),
+	)
+	return cosmos.Events{evt}, nil
+}
+
+// NewEventLimitOrderCancel create a new limit order cancel event
+func NewEventLimitOrderCancel(source, target common.Coin, txid common.TxID) *EventLimitOrderCancel {
+	return &EventLimitOrderCancel{
+		Source: source,
+		Target: target,
+		TxID:   txid,
+	}
+}
+
+// Type return a string that represent the type, it should not duplicated with other event
+func (m *EventLimitOrderCancel) Type() string {
+	return LimitOrderCancelEventType
+}
+
+// Events convert EventLimitOrderCancel to key value pairs used in cosmos
+func (m *EventLimitOrderCancel) Events() (cosmos.Events, error) {
 	evt := cosmos.NewEvent(m.Type(),
 		cosmos.NewAttribute("source", m.Source.String()),
 		cosmos.NewAttribute("target", m.Target.String()),
@@ -119,6 +189,50 @@ func (m *EventSwap) Events() (cosmos.Events, error) {
 	return cosmos.Events{evt}, nil
 }
 
+// NewEventStreamingSwap create a new streaming swap event
+func NewEventStreamingSwap(inAsset, outAsset common.Asset, swp StreamingSwap) *EventStreamingSwap {
+	return &EventStreamingSwap{
+		TxID:              swp.TxID,
+		Interval:          swp.Interval,
+		Quantity:          swp.Quantity,
+		Count:             swp.Count,
+		LastHeight:        swp.LastHeight,
+		TradeTarget:       swp.TradeTarget,
+		Deposit:           common.NewCoin(inAsset, swp.Deposit),
+		In:                common.NewCoin(inAsset, swp.In),
+		Out:               common.NewCoin(outAsset, swp.Out),
+		FailedSwaps:       swp.FailedSwaps,
+		FailedSwapReasons: swp.FailedSwapReasons,
+	}
+}
+
+// Type return a string that represent the type, it should not duplicated with other event
+func (m *EventStreamingSwap) Type() string {
+	return StreamingSwapEventType
+}
+
+// Events convert EventStreamingSwap to key value pairs used in cosmos
+func (m *EventStreamingSwap) Events() (cosmos.Events, error) {
+	failedSwaps := make([]string, len(m.FailedSwaps))
+	for i, idx := range m.FailedSwaps {
+		failedSwaps[i] = strconv.FormatUint(idx, 10)
+	}
+	evt := cosmos.NewEvent(m.Type(),
+		cosmos.NewAttribute("tx_id", m.TxID.String()),
+		cosmos.NewAttribute("interval", strconv.FormatUint(m.Interval, 10)),
+		cosmos.NewAttribute("quantity", strconv.FormatUint(m.Quantity, 10)),
+		cosmos.NewAttribute("count", strconv.FormatUint(m.Count, 10)),
+		cosmos.NewAttribute("last_height", strconv.FormatInt(m.LastHeight, 10)),
+		cosmos.NewAttribute("trade_target", m.TradeTarget.String()),
+		cosmos.NewAttribute("deposit", m.Deposit.String()),
+		cosmos.NewAttribute("in", m.In.String()),
+		cosmos.NewAttribute("out", m.Out.String()),
+		cosmos.NewAttribute("failed_swaps", strings.Join(failedSwaps, ",")),
+		cosmos.NewAttribute("failed_swap_reasons", strings.Join(m.FailedSwapReasons, "\n")),
+	)
+	return cosmos.Events{evt}, nil
+}
+
 // NewEventAddLiquidity create a new add liquidity event
 func NewEventAddLiquidity(pool common.Asset,
 	su cosmos.Uint,
@@ -814,6 +928,85 @@ func (m *EventLoanRepayment) Events() (cosmos.Events, error) {
 	return cosmos.Events{evt}, nil
 }
 
+// NewEventLoanLiquidation create a new instance of EventLoanLiquidation
+func NewEventLoanLiquidation(amt, debt, cr cosmos.Uint, ca common.Asset, owner common.Address) *EventLoanLiquidation {
+	return &EventLoanLiquidation{
+		CollateralDown:         amt,
+		DebtDown:               debt,
+		CollateralizationRatio: cr,
+		CollateralAsset:        ca,
+		Owner:                  owner,
+	}
+}
+
+// Type return a string which represent the type of this event
+func (m *EventLoanLiquidation) Type() string {
+	return LoanLiquidationEventType
+}
+
+// Events return cosmos sdk events
+func (m *EventLoanLiquidation) Events() (cosmos.Events, error) {
+	evt := cosmos.NewEvent(m.Type(),
+		cosmos.NewAttribute("collateral_down", m.CollateralDown.String()),
+		cosmos.NewAttribute("debt_down", m.DebtDown.String()),
+		cosmos.NewAttribute("collateralization_ratio", m.CollateralizationRatio.String()),
+		cosmos.NewAttribute("collateral_asset", m.CollateralAsset.String()),
+		cosmos.NewAttribute("owner", m.Owner.String()))
+	return cosmos.Events{evt}, nil
+}
+
+// NewEventLoanInterest create a new instance of EventLoanInterest
+func NewEventLoanInterest(interest, rate cosmos.Uint, blocks int64, ca common.Asset, owner common.Address) *EventLoanInterest {
+	return &EventLoanInterest{
+		Interest:        interest,
+		InterestRate:    rate,
+		Blocks:          blocks,
+		CollateralAsset: ca,
+		Owner:           owner,
+	}
+}
+
+// Type return a string which represent the type of this event
+func (m *EventLoanInterest) Type() string {
+	return LoanInterestEventType
+}
+
+// Events return cosmos sdk events
+func (m *EventLoanInterest) Events() (cosmos.Events, error) {
+	evt := cosmos.NewEvent(m.Type(),
+		cosmos.NewAttribute("interest", m.Interest.String()),
+		cosmos.NewAttribute("interest_rate", m.InterestRate.String()),
+		cosmos.NewAttribute("blocks", strconv.FormatInt(m.Blocks, 10)),
+		cosmos.NewAttribute("collateral_asset", m.CollateralAsset.String()),
+		cosmos.NewAttribute("owner", m.Owner.String()))
+	return cosmos.Events{evt}, nil
+}
+
+// NewEventAffiliateFee create a new instance of EventAffiliateFee
+func NewEventAffiliateFee(txID common.TxID, affiliate common.Address, bps cosmos.Uint, fee common.Coin) *EventAffiliateFee {
+	return &EventAffiliateFee{
+		TxID:        txID,
+		Affiliate:   affiliate,
+		BasisPoints: bps,
+		Fee:         fee,
+	}
+}
+
+// Type return a string which represent the type of this event
+func (m *EventAffiliateFee) Type() string {
+	return AffiliateFeeEventType
+}
+
+// Events return cosmos sdk events
+func (m *EventAffiliateFee) Events() (cosmos.Events, error) {
+	evt := cosmos.NewEvent(m.Type(),
+		cosmos.NewAttribute("txid", m.TxID.String()),
+		cosmos.NewAttribute("affiliate", m.Affiliate.String()),
+		cosmos.NewAttribute("basis_points", m.BasisPoints.String()),
+		cosmos.NewAttribute("fee", m.Fee.String()))
+	return cosmos.Events{evt}, nil
+}
+
 // NewEventSetMimir create a new instance of EventSetMimir
 func NewEventSetMimir(key, value string) *EventSetMimir {
 	return &EventSetMimir{
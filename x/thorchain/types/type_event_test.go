@@ -28,6 +28,59 @@ func (s EventSuite) TestSwapEvent(c *C) {
 	c.Check(events, NotNil)
 }
 
+func (s EventSuite) TestStreamingSwapEvent(c *C) {
+	swp := NewStreamingSwap(GetRandomTxHash(), 10, 5, cosmos.NewUint(100), cosmos.NewUint(1000))
+	swp.FailedSwaps = []uint64{1, 2}
+	swp.FailedSwapReasons = []string{"foo", "bar"}
+	evt := NewEventStreamingSwap(common.BNBAsset, common.BTCAsset, swp)
+	c.Check(evt.Type(), Equals, "streaming_swap")
+	events, err := evt.Events()
+	c.Check(err, IsNil)
+	c.Check(events, NotNil)
+}
+
+func (s EventSuite) TestLimitOrderEvent(c *C) {
+	source := common.NewCoin(common.BNBAsset, cosmos.NewUint(100))
+	target := common.NewCoin(common.RuneAsset(), cosmos.NewUint(200))
+	txID := GetRandomTxHash()
+
+	evt := NewEventLimitOrder(source, target, txID)
+	c.Check(evt.Type(), Equals, "limit_order")
+	events, err := evt.Events()
+	c.Check(err, IsNil)
+	c.Assert(events, HasLen, 1)
+	c.Check(events[0].Attributes, HasLen, 3)
+
+	evt = NewEventLimitOrderExpired(source, target, txID)
+	c.Check(evt.Type(), Equals, "limit_order")
+	c.Check(evt.Status, Equals, "expired")
+	events, err = evt.Events()
+	c.Check(err, IsNil)
+	c.Assert(events, HasLen, 1)
+	c.Check(events[0].Attributes, HasLen, 4)
+}
+
+func (s EventSuite) TestLimitOrderModifyAndCancelEvent(c *C) {
+	source := common.NewCoin(common.BNBAsset, cosmos.NewUint(100))
+	target := common.NewCoin(common.RuneAsset(), cosmos.NewUint(200))
+	modified := common.NewCoin(common.RuneAsset(), cosmos.NewUint(150))
+	txID := GetRandomTxHash()
+
+	modifyEvt := NewEventLimitOrderModify(source, target, modified, txID)
+	c.Check(modifyEvt.Type(), Equals, "limit_order_modify")
+	events, err := modifyEvt.Events()
+	c.Check(err, IsNil)
+	c.Assert(events, HasLen, 1)
+	c.Check(events[0].Attributes, HasLen, 4)
+
+	cancelEvt := NewEventLimitOrderCancel(source, target, txID)
+	c.Check(cancelEvt.Type(), Equals, "limit_order_cancel")
+	events, err = cancelEvt.Events()
+	c.Check(err, IsNil)
+	c.Assert(events, HasLen, 1)
+	c.Check(events[0].Attributes, HasLen, 3)
+}
+
 func (s EventSuite) TestAddLiqudityEvent(c *C) {
 	evt := NewEventAddLiquidity(
 		common.BNBAsset,
@@ -248,3 +301,30 @@ func (EventSuite) TestEventPoolStageCost(c *C) {
 	c.Check(err, IsNil)
 	c.Check(events, NotNil)
 }
+
+func (EventSuite) TestEventLoanLiquidation(c *C) {
+	e := NewEventLoanLiquidation(cosmos.NewUint(100), cosmos.NewUint(200), cosmos.NewUint(9000), common.BTCAsset, GetRandomBTCAddress())
+	c.Check(e.Type(), Equals, LoanLiquidationEventType)
+	events, err := e.Events()
+	c.Check(err, IsNil)
+	c.Check(events, HasLen, 1)
+	c.Check(events[0].Attributes, HasLen, 5)
+}
+
+func (EventSuite) TestEventLoanInterest(c *C) {
+	e := NewEventLoanInterest(cosmos.NewUint(100), cosmos.NewUint(500), 14400, common.BTCAsset, GetRandomBTCAddress())
+	c.Check(e.Type(), Equals, LoanInterestEventType)
+	events, err := e.Events()
+	c.Check(err, IsNil)
+	c.Check(events, HasLen, 1)
+	c.Check(events[0].Attributes, HasLen, 5)
+}
+
+func (EventSuite) TestEventAffiliateFee(c *C) {
+	e := NewEventAffiliateFee(GetRandomTxHash(), GetRandomTHORAddress(), cosmos.NewUint(10), common.NewCoin(common.RuneAsset(), cosmos.NewUint(100)))
+	c.Check(e.Type(), Equals, AffiliateFeeEventType)
+	events, err := e.Events()
+	c.Check(err, IsNil)
+	c.Check(events, HasLen, 1)
+	c.Check(events[0].Attributes, HasLen, 4)
+}
@@ -65,6 +65,9 @@ func (m ObservedTx) Equals(tx2 ObservedTx) bool {
 	if m.FinaliseHeight != tx2.FinaliseHeight {
 		return false
 	}
+	if m.Pending != tx2.Pending {
+		return false
+	}
 	if !strings.EqualFold(m.Aggregator, tx2.Aggregator) {
 		return false
 	}
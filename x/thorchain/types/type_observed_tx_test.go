@@ -431,6 +431,11 @@ func (TypeObservedTxSuite) TestObservedTxEquals(c *C) {
 	targetLimit = cosmos.NewUint(100)
 	tx2.AggregatorTargetLimit = &targetLimit
 	c.Assert(tx1.Equals(tx2), Equals, true)
+
+	tx1.Pending = true
+	c.Assert(tx1.Equals(tx2), Equals, false)
+	tx2.Pending = true
+	c.Assert(tx1.Equals(tx2), Equals, true)
 }
 
 func (TypeObservedTxSuite) TestObservedTxVote(c *C) {
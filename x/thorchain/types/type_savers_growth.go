@@ -0,0 +1,53 @@
+package types
+
+import (
+	cosmos "gitlab.com/thorchain/thornode/common/cosmos"
+)
+
+// saversGrowthPrecision is the scale of the redeem value of a savers unit and
+// of the annualised rate, so the growth of a single interval isn't rounded away
+const saversGrowthPrecision = 1_000_000_000_000
+
+// NewSaversGrowth create a new instance of SaversGrowth without any sample
+func NewSaversGrowth() SaversGrowth {
+	return SaversGrowth{
+		LastValuePerUnit: cosmos.ZeroUint(),
+		Apr:              cosmos.ZeroUint(),
+	}
+}
+
+// SaversValuePerUnit returns the amount of asset redeemed by a unit of the
+// given savers pool, scaled by saversGrowthPrecision
+func SaversValuePerUnit(pool Pool) cosmos.Uint {
+	if pool.LPUnits.IsZero() {
+		return cosmos.ZeroUint()
+	}
+	return pool.BalanceAsset.MulUint64(saversGrowthPrecision).Quo(pool.LPUnits)
+}
+
+// Update samples the redeem value of a savers unit at the given height and
+// folds the annualised growth since the previous sample into the moving
+// average. The average is a plain mean until it has seen the given number of
+// periods, a decrease of the redeem value counts as no growth.
+func (m *SaversGrowth) Update(height int64, valuePerUnit cosmos.Uint, blocksPerYear, periods int64) {
+	if m.LastHeight > 0 && height > m.LastHeight && !m.LastValuePerUnit.IsZero() {
+		sample := cosmos.ZeroUint()
+		if valuePerUnit.GT(m.LastValuePerUnit) {
+			growth := valuePerUnit.Sub(m.LastValuePerUnit).MulUint64(uint64(blocksPerYear)).MulUint64(saversGrowthPrecision)
+			sample = growth.Quo(m.LastValuePerUnit.MulUint64(uint64(height - m.LastHeight)))
+		}
+		m.Samples++
+		n := m.Samples
+		if periods > 0 && n > periods {
+			n = periods
+		}
+		m.Apr = m.Apr.MulUint64(uint64(n - 1)).Add(sample).QuoUint64(uint64(n))
+	}
+	m.LastHeight = height
+	m.LastValuePerUnit = valuePerUnit
+}
+
+// AprBps returns the moving average of the annualised savers yield in basis points
+func (m SaversGrowth) AprBps() int64 {
+	return int64(m.Apr.MulUint64(10_000).QuoUint64(saversGrowthPrecision).Uint64())
+}
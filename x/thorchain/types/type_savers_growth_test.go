@@ -0,0 +1,52 @@
+package types
+
+import (
+	. "gopkg.in/check.v1"
+
+	"gitlab.com/thorchain/thornode/common"
+	cosmos "gitlab.com/thorchain/thornode/common/cosmos"
+)
+
+type SaversGrowthSuite struct{}
+
+var _ = Suite(&SaversGrowthSuite{})
+
+func (s *SaversGrowthSuite) TestSaversValuePerUnit(c *C) {
+	pool := NewPool()
+	pool.Asset = common.BTCAsset.GetSyntheticAsset()
+	c.Check(SaversValuePerUnit(pool).IsZero(), Equals, true)
+
+	pool.BalanceAsset = cosmos.NewUint(150 * common.One)
+	pool.LPUnits = cosmos.NewUint(100 * common.One)
+	c.Check(SaversValuePerUnit(pool).Uint64(), Equals, uint64(1_500_000_000_000))
+}
+
+func (s *SaversGrowthSuite) TestUpdate(c *C) {
+	growth := NewSaversGrowth()
+	c.Check(growth.AprBps(), Equals, int64(0))
+
+	// the first sample only records the value
+	growth.Update(100, cosmos.NewUint(1_000_000_000_000), 1000, 3)
+	c.Check(growth.Samples, Equals, int64(0))
+	c.Check(growth.AprBps(), Equals, int64(0))
+
+	// 1% over a tenth of a year
+	growth.Update(200, cosmos.NewUint(1_010_000_000_000), 1000, 3)
+	c.Check(growth.Samples, Equals, int64(1))
+	c.Check(growth.AprBps(), Equals, int64(1000))
+
+	// no growth
+	growth.Update(300, cosmos.NewUint(1_010_000_000_000), 1000, 3)
+	c.Check(growth.AprBps(), Equals, int64(500))
+
+	// a decrease counts as no growth
+	growth.Update(400, cosmos.NewUint(1_000_000_000_000), 1000, 3)
+	c.Check(growth.AprBps(), Equals, int64(333))
+	c.Check(growth.LastValuePerUnit.Uint64(), Equals, uint64(1_000_000_000_000))
+
+	// past the number of periods the average decays
+	growth.Update(500, cosmos.NewUint(1_000_000_000_000), 1000, 3)
+	c.Check(growth.Samples, Equals, int64(4))
+	c.Check(growth.AprBps(), Equals, int64(222))
+	c.Check(growth.LastHeight, Equals, int64(500))
+}
@@ -0,0 +1,89 @@
+package types
+
+import (
+	"errors"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"gitlab.com/thorchain/thornode/common"
+	"gitlab.com/thorchain/thornode/common/cosmos"
+)
+
+var _ codec.ProtoMarshaler = &StreamingSwap{}
+
+// StreamingSwaps a list of streaming swaps
+type StreamingSwaps []StreamingSwap
+
+// NewStreamingSwap create a new instance of StreamingSwap
+func NewStreamingSwap(hash common.TxID, quantity, interval uint64, target, deposit cosmos.Uint) StreamingSwap {
+	return StreamingSwap{
+		TxID:        hash,
+		Quantity:    quantity,
+		Interval:    interval,
+		TradeTarget: target,
+		Deposit:     deposit,
+		In:          cosmos.ZeroUint(),
+		Out:         cosmos.ZeroUint(),
+	}
+}
+
+// Valid check whether the streaming swap represent valid information
+func (m *StreamingSwap) Valid() error {
+	if m.TxID.IsEmpty() {
+		return errors.New("tx id cannot be empty")
+	}
+	if m.Interval == 0 {
+		return errors.New("interval cannot be zero")
+	}
+	if m.Quantity == 0 {
+		return errors.New("quantity cannot be zero")
+	}
+	if m.Deposit.IsZero() {
+		return errors.New("deposit cannot be zero")
+	}
+	if m.In.GT(m.Deposit) {
+		return errors.New("in cannot be greater than deposit")
+	}
+	return nil
+}
+
+// IsDone returns true when all sub-swaps have been attempted
+func (m *StreamingSwap) IsDone() bool {
+	return m.Count >= m.Quantity
+}
+
+// IsLastSwap returns true when the next sub-swap is the final one
+func (m *StreamingSwap) IsLastSwap() bool {
+	return m.Count+1 >= m.Quantity
+}
+
+// IsReady returns true when the next sub-swap is due at the given height
+func (m *StreamingSwap) IsReady(height int64) bool {
+	if m.IsDone() {
+		return false
+	}
+	if m.Count == 0 {
+		return true
+	}
+	return height >= m.LastHeight+int64(m.Interval)
+}
+
+// NextSize returns the amount of the deposit to swap in the next sub-swap,
+// along with the trade target for that sub-swap. Any amount left unswapped by
+// failed sub-swaps is spread over the remaining ones.
+func (m *StreamingSwap) NextSize() (cosmos.Uint, cosmos.Uint) {
+	if m.IsDone() {
+		return cosmos.ZeroUint(), cosmos.ZeroUint()
+	}
+	remaining := m.Quantity - m.Count
+	size := common.SafeSub(m.Deposit, m.In).QuoUint64(remaining)
+	target := common.SafeSub(m.TradeTarget, m.Out).QuoUint64(remaining)
+	if m.IsLastSwap() {
+		size = common.SafeSub(m.Deposit, m.In)
+	}
+	return size, target
+}
+
+// RemainingIn returns the amount of the deposit that hasn't been swapped
+func (m *StreamingSwap) RemainingIn() cosmos.Uint {
+	return common.SafeSub(m.Deposit, m.In)
+}
@@ -0,0 +1,66 @@
+package types
+
+import (
+	"gitlab.com/thorchain/thornode/common"
+	"gitlab.com/thorchain/thornode/common/cosmos"
+	. "gopkg.in/check.v1"
+)
+
+type TypeStreamingSwapSuite struct{}
+
+var _ = Suite(&TypeStreamingSwapSuite{})
+
+func (TypeStreamingSwapSuite) TestValid(c *C) {
+	swp := NewStreamingSwap(GetRandomTxHash(), 10, 3, cosmos.NewUint(500), cosmos.NewUint(1000))
+	c.Check(swp.Valid(), IsNil)
+
+	swp.TxID = common.TxID("")
+	c.Check(swp.Valid(), NotNil)
+
+	swp = NewStreamingSwap(GetRandomTxHash(), 10, 0, cosmos.NewUint(500), cosmos.NewUint(1000))
+	c.Check(swp.Valid(), NotNil)
+
+	swp = NewStreamingSwap(GetRandomTxHash(), 0, 3, cosmos.NewUint(500), cosmos.NewUint(1000))
+	c.Check(swp.Valid(), NotNil)
+
+	swp = NewStreamingSwap(GetRandomTxHash(), 10, 3, cosmos.NewUint(500), cosmos.ZeroUint())
+	c.Check(swp.Valid(), NotNil)
+
+	swp = NewStreamingSwap(GetRandomTxHash(), 10, 3, cosmos.NewUint(500), cosmos.NewUint(1000))
+	swp.In = cosmos.NewUint(1001)
+	c.Check(swp.Valid(), NotNil)
+}
+
+func (TypeStreamingSwapSuite) TestNextSize(c *C) {
+	swp := NewStreamingSwap(GetRandomTxHash(), 3, 5, cosmos.NewUint(300), cosmos.NewUint(1000))
+	c.Check(swp.IsReady(1), Equals, true)
+
+	size, target := swp.NextSize()
+	c.Check(size.Uint64(), Equals, uint64(333))
+	c.Check(target.Uint64(), Equals, uint64(100))
+
+	// first sub-swap succeeds
+	swp.In = swp.In.Add(size)
+	swp.Out = swp.Out.Add(cosmos.NewUint(110))
+	swp.Count++
+	swp.LastHeight = 10
+	c.Check(swp.IsReady(14), Equals, false)
+	c.Check(swp.IsReady(15), Equals, true)
+
+	// second sub-swap fails, deposit is spread over the remaining swap
+	size, _ = swp.NextSize()
+	c.Check(size.Uint64(), Equals, uint64(333))
+	swp.Count++
+	c.Check(swp.IsLastSwap(), Equals, true)
+
+	size, target = swp.NextSize()
+	c.Check(size.Uint64(), Equals, uint64(667))
+	c.Check(target.Uint64(), Equals, uint64(190))
+	swp.Count++
+
+	c.Check(swp.IsDone(), Equals, true)
+	c.Check(swp.IsReady(100), Equals, false)
+	size, _ = swp.NextSize()
+	c.Check(size.IsZero(), Equals, true)
+	c.Check(swp.RemainingIn().Uint64(), Equals, uint64(667))
+}
@@ -7,6 +7,7 @@ import (
 	b64 "encoding/base64"
 
 	"gitlab.com/thorchain/thornode/common"
+	"gitlab.com/thorchain/thornode/common/cosmos"
 )
 
 // NewTHORName create a new instance of network fee
@@ -56,6 +57,33 @@ func (m *THORName) SetAlias(chain common.Chain, addr common.Address) {
 	m.Aliases = append(m.Aliases, THORNameAlias{Chain: chain, Address: addr})
 }
 
+// IsSubName returns true when the THORName sits under a parent name (e.g.
+// alice.wallet sits under wallet)
+func (m *THORName) IsSubName() bool {
+	return strings.Contains(m.Name, ".")
+}
+
+// GetParent returns the name of the parent of a sub-name, or an empty string
+// for a top level name
+func (m *THORName) GetParent() string {
+	parts := strings.SplitN(m.Name, ".", 2)
+	if len(parts) < 2 {
+		return ""
+	}
+	return parts[1]
+}
+
+// IsManager returns true when the given address may update the aliases of the
+// THORName on behalf of its owner
+func (m *THORName) IsManager(addr cosmos.AccAddress) bool {
+	for _, manager := range m.Managers {
+		if manager.Equals(addr) {
+			return true
+		}
+	}
+	return false
+}
+
 func (m *THORName) Key() string {
 	// key is Base64 endoded
 	return b64.StdEncoding.EncodeToString([]byte(strings.ToLower(m.Name)))
@@ -4,6 +4,7 @@ import (
 	. "gopkg.in/check.v1"
 
 	"gitlab.com/thorchain/thornode/common"
+	"gitlab.com/thorchain/thornode/common/cosmos"
 )
 
 type THORNameSuite struct{}
@@ -23,3 +24,26 @@ func (THORNameSuite) TestTHORName(c *C) {
 	n3 := NewTHORName("hello", 0, []THORNameAlias{{Chain: common.THORChain, Address: common.Address("")}})
 	c.Check(n3.Valid(), NotNil)
 }
+
+func (THORNameSuite) TestSubName(c *C) {
+	n := NewTHORName("wallet", 0, nil)
+	c.Check(n.IsSubName(), Equals, false)
+	c.Check(n.GetParent(), Equals, "")
+
+	n = NewTHORName("alice.wallet", 0, nil)
+	c.Check(n.IsSubName(), Equals, true)
+	c.Check(n.GetParent(), Equals, "wallet")
+
+	n = NewTHORName("bob.alice.wallet", 0, nil)
+	c.Check(n.IsSubName(), Equals, true)
+	c.Check(n.GetParent(), Equals, "alice.wallet")
+}
+
+func (THORNameSuite) TestIsManager(c *C) {
+	manager := GetRandomBech32Addr()
+	n := NewTHORName("iamthewalrus", 0, nil)
+	c.Check(n.IsManager(manager), Equals, false)
+	n.Managers = []cosmos.AccAddress{GetRandomBech32Addr(), manager}
+	c.Check(n.IsManager(manager), Equals, true)
+	c.Check(n.IsManager(GetRandomBech32Addr()), Equals, false)
+}